@@ -4,9 +4,29 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 )
 
+// NewTxCmd returns the transaction commands for IBC clients
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.SubModuleName,
+		Short:                      "IBC client transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		GetCmdBatchCreateClients(),
+		GetCmdFreezeClient(),
+		GetCmdReplayHeaders(),
+	)
+
+	return txCmd
+}
+
 // GetQueryCmd returns the query commands for IBC clients
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
@@ -20,11 +40,46 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
+		GetCmdQueryClientStateProof(),
+		GetCmdQueryClientStateProofs(),
+		GetCmdQueryProofSpecs(),
+		GetCmdDiffProofSpecs(),
+		GetCmdExportProofSpecs(),
+		GetCmdValidateProofSpecs(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusState(),
+		GetCmdQueryConsensusStateBundle(),
+		GetCmdQueryConsensusStateMetadata(),
+		GetCmdQueryRecentConsensusStates(),
+		GetCmdQueryConsensusStatesAround(),
+		GetCmdQueryConsensusStateByHash(),
+		GetCmdExportLatestConsensusState(),
+		GetCmdQueryClientTypeCounts(),
+		GetCmdQueryClientStorageUsage(),
+		GetCmdQueryParams(),
+		GetCmdQueryMaxClientHeight(),
+		GetCmdSnapshotClientHeights(),
+		GetCmdQueryMaxUpdateGap(),
+		GetCmdQueryEpochDistribution(),
+		GetCmdQueryExpiringClients(),
+		GetCmdEstimatePruneSavings(),
 		GetCmdQueryHeader(),
 		GetCmdNodeConsensusState(),
+		GetCmdNodeConsensusStates(),
+		GetCmdGenerateClientCreationData(),
+		GetCmdValidateClientGenesis(),
+		GetCmdCheckConsensusIntegrity(),
+		GetCmdValidateSelfClientState(),
+		GetCmdWatchNewClients(),
+		GetCmdWatchClientFreezes(),
+		GetCmdQueryRegisteredClients(),
+		GetCmdCheckClientCompatibility(),
+		GetCmdTestLocalhostVerification(),
+		GetCmdVerifyPacketAcknowledgement(),
+		GetCmdBenchmarkVerification(),
 	)
 
+	queryCmd.PersistentFlags().Bool(utils.FlagNoProve, false, "disable proofs for all the query results, overriding --prove")
+
 	return queryCmd
 }
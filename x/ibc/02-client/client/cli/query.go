@@ -2,21 +2,41 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectionutils "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/client/utils"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 )
 
-const flagLatestHeight = "latest-height"
+const (
+	flagLatestHeight  = "latest-height"
+	flagRetryInterval = "retry-interval"
+	flagOrderByHeight = "order-by-height"
+	flagHuman         = "human"
+	flagIn            = "in"
+	flagSince         = "since"
+	flagGenesisFile   = "genesis-file"
+	flagPrefix        = "prefix"
+	flagRadius        = "radius"
+	flagIterations    = "iterations"
+)
 
 // GetCmdQueryClientStates defines the command to query all the light clients
 // that this chain mantains.
@@ -41,8 +61,11 @@ func GetCmdQueryClientStates() *cobra.Command {
 				return err
 			}
 
+			orderByHeight, _ := cmd.Flags().GetBool(flagOrderByHeight)
+
 			req := &types.QueryClientStatesRequest{
-				Pagination: pageReq,
+				Pagination:    pageReq,
+				OrderByHeight: orderByHeight,
 			}
 
 			res, err := queryClient.ClientStates(context.Background(), req)
@@ -53,6 +76,7 @@ func GetCmdQueryClientStates() *cobra.Command {
 			return clientCtx.PrintOutput(res)
 		},
 	}
+	cmd.Flags().Bool(flagOrderByHeight, false, "order the client states by descending latest height instead of by client id")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "client states")
 
@@ -76,7 +100,7 @@ func GetCmdQueryClientState() *cobra.Command {
 			}
 
 			clientID := args[0]
-			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+			prove := utils.ReadProveFlag(cmd.Flags())
 
 			clientStateRes, err := utils.QueryClientState(clientCtx, clientID, prove)
 			if err != nil {
@@ -93,6 +117,245 @@ func GetCmdQueryClientState() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientStateProof defines the command to export the state of a client
+// along with its merkle proof at a given height, so that it can be verified
+// independently.
+func GetCmdQueryClientStateProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-proof [client-id] [height]",
+		Short: "Query a client state and its proof at a given height",
+		Long: `Query a client state along with its merkle proof at a given height of the node's store.
+The proof is base64 encoded and the proof height is the height of the store that was queried.
+Heights pruned by the node return an error.`,
+		Example: fmt.Sprintf("%s query %s %s state-proof [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer height, got: %s", args[1])
+			}
+
+			res, err := utils.QueryClientStateProof(clientCtx, clientID, height)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientStateProofs defines the command to export the state of a client
+// along with its merkle proof at several heights in a single response.
+func GetCmdQueryClientStateProofs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-proofs [client-id] [height] [height...]",
+		Short: "Query a client state and its proof at each of the given heights",
+		Long: `Query a client state along with its merkle proof at each of the given heights of the node's store.
+The results are returned in the order of the heights. The heights for which no proof is available,
+eg: because they were pruned by the node, are marked as unavailable along with the error.`,
+		Example: fmt.Sprintf("%s query %s %s state-proofs [client-id] [height] [height...]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			heights := make([]int64, len(args)-1)
+			for i, arg := range args[1:] {
+				heights[i], err = strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("expected integer height, got: %s", arg)
+				}
+			}
+
+			results := utils.QueryClientStateProofs(clientCtx, clientID, heights)
+
+			bz, err := utils.MarshalClientStateProofResults(clientCtx.JSONMarshaler, clientID, results)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProofSpecs defines the command to query the proof specs of a client
+// along with their fingerprint.
+func GetCmdQueryProofSpecs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof-specs [client-id]",
+		Short: "Query the proof specs of a client and their fingerprint",
+		Long: `Query the ICS23 proof specs a client verifies proofs with, along with a short fingerprint of the specs.
+Clients with the same fingerprint verify proofs with identical specs, which allows to compare the specs of
+clients across chains at a glance.`,
+		Example: fmt.Sprintf("%s query %s %s proof-specs [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			specs, err := utils.QueryClientProofSpecs(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(specs, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDiffProofSpecs defines the command to query the proof specs of two
+// clients and report the fields in which they differ.
+func GetCmdDiffProofSpecs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff-proof-specs [client-id-a] [client-id-b]",
+		Short: "Query the proof specs of two clients and report their differences",
+		Long: `Query the ICS23 proof specs of two clients and compare them field by field, such as the hash, prehash
+and length operations of the leaf spec, the inner spec and the depth bounds. Every differing field is reported along
+with the index of its spec, which helps diagnosing connection failures caused by mismatched proof specs.`,
+		Example: fmt.Sprintf("%s query %s %s diff-proof-specs [client-id-a] [client-id-b]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			diff, err := utils.QueryProofSpecsDiff(clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			if len(diff.Differences) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("clients %s and %s have identical proof specs\n", args[0], args[1]))
+			}
+
+			bz, err := json.MarshalIndent(diff, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdExportProofSpecs defines the command to export the proof specs of a
+// client to a file for the creation of another client of the same chain.
+func GetCmdExportProofSpecs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-proof-specs [client-id] [output-file]",
+		Short: "Export the proof specs of a client to a file",
+		Long: `Query the state of a client of the node and export the ICS23 proof specs it verifies the proofs of its
+counterparty chain with to a JSON file, so that they can be reused to create another client of that chain. The
+file can be passed to the --proof-specs flag of the tendermint client creation command or embedded as the
+proof_specs of a client state. The command fails if the client has no proof specs.`,
+		Example: fmt.Sprintf("%s query %s %s export-proof-specs [client-id] [path/to/proof_specs.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			specs, err := utils.QueryExportProofSpecs(clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("exported the proof specs of client %s with fingerprint %s to %s\n", args[0], specs.Fingerprint, args[1]))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdValidateProofSpecs defines the command to check that the proof specs of a
+// client match the proof specs of a reference file.
+func GetCmdValidateProofSpecs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-proof-specs [client-id] [reference-file]",
+		Short: "Check that the proof specs of a client match a reference proof specs file",
+		Long: `Query the ICS23 proof specs of a client and compare them field by field with the proof specs of a
+known-good reference JSON file, as written by the export-proof-specs command. Every mismatching field is reported
+along with the index of its spec, as the value of the client and the value of the reference. The command fails if
+the proof specs don't match.`,
+		Example: fmt.Sprintf("%s query %s %s validate-proof-specs [client-id] [path/to/proof_specs.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			validation, err := utils.QueryValidateProofSpecs(clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			if validation.Matches {
+				return clientCtx.PrintString(fmt.Sprintf("the proof specs of client %s match %s\n", args[0], args[1]))
+			}
+
+			bz, err := json.MarshalIndent(validation, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintString(fmt.Sprintf("%s\n", bz)); err != nil {
+				return err
+			}
+
+			return fmt.Errorf("the proof specs of client %s don't match %s", args[0], args[1])
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
@@ -137,16 +400,17 @@ func GetCmdQueryConsensusStates() *cobra.Command {
 	return cmd
 }
 
-// GetCmdQueryConsensusState defines the command to query the consensus state of
-// the chain as defined in https://github.com/cosmos/ics/tree/master/spec/ics-002-client-semantics#query
-func GetCmdQueryConsensusState() *cobra.Command {
+// GetCmdQueryRecentConsensusStates defines the command to query the consensus
+// states of a client created within a given time window.
+func GetCmdQueryRecentConsensusStates() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "consensus-state [client-id] [height]",
-		Short: "Query the consensus state of a client at a given height",
-		Long: `Query the consensus state for a particular light client at a given height.
-If the '--latest' flag is included, the query returns the latest consensus state, overriding the height argument.`,
-		Example: fmt.Sprintf("%s query %s %s  consensus-state [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
-		Args:    cobra.RangeArgs(1, 2),
+		Use:   "recent-consensus-states [client-id]",
+		Short: "Query the consensus states of a client within a recent time window",
+		Long: `Query the consensus states of a client whose timestamp falls within the time window given by the
+'--since' flag, ending at the current local time. The consensus states are filtered by the node, so the
+pagination applies to the consensus states within the window.`,
+		Example: fmt.Sprintf("%s query %s %s recent-consensus-states [client-id] --%s 6h", version.AppName, host.ModuleName, types.SubModuleName, flagSince),
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
@@ -154,63 +418,123 @@ If the '--latest' flag is included, the query returns the latest consensus state
 				return err
 			}
 
-			clientID := args[0]
+			since, err := cmd.Flags().GetDuration(flagSince)
+			if err != nil {
+				return err
+			}
 
-			queryLatestHeight, _ := cmd.Flags().GetBool(flagLatestHeight)
+			if since <= 0 {
+				return fmt.Errorf("time window must be positive, got: %s", since)
+			}
 
-			var height uint64
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
-			if !queryLatestHeight {
-				if len(args) != 2 {
-					return errors.New("must include a second 'height' argument when '--latest-height' flag is not provided")
-				}
+			queryClient := types.NewQueryClient(clientCtx)
 
-				height, err = strconv.ParseUint(args[1], 10, 64)
-				if err != nil {
-					return fmt.Errorf("expected integer height, got: %s", args[1])
-				}
+			req := &types.QueryRecentConsensusStatesRequest{
+				ClientId:     args[0],
+				MinTimestamp: uint64(time.Now().Add(-since).UnixNano()),
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.RecentConsensusStates(context.Background(), req)
+			if err != nil {
+				return err
 			}
 
-			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+			return clientCtx.PrintOutput(res)
+		},
+	}
 
-			csRes, err := utils.QueryConsensusState(clientCtx, clientID, height, prove, queryLatestHeight)
+	cmd.Flags().Duration(flagSince, 24*time.Hour, "time window ending at the current time")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recent consensus states")
+
+	return cmd
+}
+
+// GetCmdQueryConsensusStatesAround defines the command to query the consensus
+// states of a client around a pivot height.
+func GetCmdQueryConsensusStatesAround() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-states-around [client-id] [pivot-height]",
+		Short: "Query the consensus states of a client around a pivot height",
+		Long: `Query the consensus state of a client at a pivot height, in the format {epoch number}-{epoch height},
+if it exists, along with up to '--radius' consensus states below and above the pivot height, ordered by height.`,
+		Example: fmt.Sprintf("%s query %s %s consensus-states-around [client-id] 0-100 --%s 3", version.AppName, host.ModuleName, types.SubModuleName, flagRadius),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintOutput(csRes)
+			pivot, err := types.ParseHeightShort(args[1])
+			if err != nil {
+				return err
+			}
+
+			radius, err := cmd.Flags().GetUint64(flagRadius)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsensusStatesAroundRequest{
+				ClientId: args[0],
+				Pivot:    pivot,
+				Radius:   radius,
+			}
+
+			res, err := queryClient.ConsensusStatesAround(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
 		},
 	}
 
-	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
-	cmd.Flags().Bool(flagLatestHeight, false, "return latest stored consensus state")
+	cmd.Flags().Uint64(flagRadius, 2, "maximum number of consensus states on each side of the pivot height")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// GetCmdQueryHeader defines the command to query the latest header on the chain
-func GetCmdQueryHeader() *cobra.Command {
+// GetCmdQueryConsensusStateByHash defines the command to query the consensus
+// state of a client with a given commitment root hash.
+func GetCmdQueryConsensusStateByHash() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "header",
-		Short:   "Query the latest header of the running chain",
-		Long:    "Query the latest Tendermint header of the running chain",
-		Example: fmt.Sprintf("%s query %s %s  header", version.AppName, host.ModuleName, types.SubModuleName),
-		Args:    cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Use:   "consensus-state-by-hash [client-id] [root-hash]",
+		Short: "Query the consensus state of a client with a given commitment root hash",
+		Long: `Query the consensus state of a client whose commitment root hash matches the given hex encoded hash,
+such as the app hash of a block of the counterparty chain. The consensus states of the client are scanned
+linearly, which can take a while for clients with many consensus states.`,
+		Example: fmt.Sprintf("%s query %s %s consensus-state-by-hash [client-id] [root-hash]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			header, height, err := utils.QueryTendermintHeader(clientCtx)
+			rootHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("expected hex encoded root hash, got: %s", args[1])
+			}
+
+			consensusState, err := utils.QueryConsensusStateByRoot(clientCtx, args[0], rootHash)
 			if err != nil {
 				return err
 			}
 
-			clientCtx = clientCtx.WithHeight(height)
-			return clientCtx.PrintOutputLegacy(header)
+			return clientCtx.PrintOutput(consensusState)
 		},
 	}
 
@@ -219,32 +543,1203 @@ func GetCmdQueryHeader() *cobra.Command {
 	return cmd
 }
 
-// GetCmdNodeConsensusState defines the command to query the latest consensus state of a node
-// The result is feed to client creation
-func GetCmdNodeConsensusState() *cobra.Command {
+// GetCmdExportLatestConsensusState defines the command to export the latest
+// consensus state of a client to a file for a counterparty client update.
+func GetCmdExportLatestConsensusState() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "node-state",
-		Short:   "Query a node consensus state",
-		Long:    "Query a node consensus state. This result is feed to the client creation transaction.",
-		Example: fmt.Sprintf("%s query %s %s node-state", version.AppName, host.ModuleName, types.SubModuleName),
-		Args:    cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Use:   "export-consensus-state [client-id] [output-file]",
+		Short: "Export the latest consensus state of a client to a file",
+		Long: `Export the latest consensus state of a client to a JSON file, along with the client identifier and
+the consensus state height, so that it can be handed to the counterparty chain.`,
+		Example: fmt.Sprintf("%s query %s %s export-consensus-state [client-id] [path/to/consensus_state.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
 			if err != nil {
 				return err
 			}
 
-			state, height, err := utils.QueryNodeConsensusState(clientCtx)
+			ecs, err := utils.QueryLatestExportedConsensusState(clientCtx, args[0])
 			if err != nil {
 				return err
 			}
 
-			clientCtx = clientCtx.WithHeight(height)
-			return clientCtx.PrintOutput(state)
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			if err := utils.WriteConsensusStateFile(cdc, args[1], ecs); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("exported consensus state of client %s at height %s to %s\n", ecs.ClientID, ecs.Height, args[1]))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConsensusState defines the command to query the consensus state of
+// the chain as defined in https://github.com/cosmos/ics/tree/master/spec/ics-002-client-semantics#query
+func GetCmdQueryConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state [client-id] [height]",
+		Short: "Query the consensus state of a client at a given height",
+		Long: `Query the consensus state for a particular light client at a given height.
+If the '--latest' flag is included, the query returns the latest consensus state, overriding the height argument.
+The '--epoch' flag sets the epoch number of the height argument, heights of a non-zero epoch are looked up in
+the index of consensus states ordered by epoch-aware height.
+If the '--infer-epoch' flag is included and the '--epoch' flag is not, the epoch number is inferred from the latest
+height of the client. Inference assumes that the height belongs to the latest epoch of the client, heights of
+earlier epochs must be queried with the '--epoch' flag.`,
+		Example: fmt.Sprintf("%s query %s %s  consensus-state [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+
+			queryLatestHeight, _ := cmd.Flags().GetBool(flagLatestHeight)
+
+			var height uint64
+
+			if !queryLatestHeight {
+				if len(args) != 2 {
+					return errors.New("must include a second 'height' argument when '--latest-height' flag is not provided")
+				}
+
+				height, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("expected integer height, got: %s", args[1])
+				}
+
+				epoch, err := utils.ReadEpochFlag(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				inferEpoch, _ := cmd.Flags().GetBool(utils.FlagInferEpoch)
+				inferEpoch = inferEpoch && !cmd.Flags().Changed(utils.FlagEpoch)
+				if inferEpoch {
+					epoch, err = utils.QueryInferEpoch(clientCtx, clientID, height)
+					if err != nil {
+						return err
+					}
+				}
+
+				// consensus states of epoch 0 are stored under their epoch height
+				if epoch != 0 {
+					height, err = utils.QueryConsensusStateLegacyHeight(clientCtx, clientID, types.NewHeight(epoch, height))
+					if err != nil {
+						if inferEpoch {
+							return fmt.Errorf("%w: the height may belong to an earlier epoch, set it with the --%s flag", err, utils.FlagEpoch)
+						}
+						return err
+					}
+				}
+			}
+
+			prove := utils.ReadProveFlag(cmd.Flags())
+
+			csRes, err := utils.QueryConsensusState(clientCtx, clientID, height, prove, queryLatestHeight)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(csRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, true, "show proofs for the query results")
+	cmd.Flags().Bool(flagLatestHeight, false, "return latest stored consensus state")
+	cmd.Flags().String(utils.FlagEpoch, "0", "epoch number of the height argument")
+	cmd.Flags().Bool(utils.FlagInferEpoch, false, "infer the epoch number of the height argument from the latest client height if --epoch is not set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConsensusStateBundle defines the command to query a consensus state of
+// a client along with its proof and proof height as a single JSON bundle.
+func GetCmdQueryConsensusStateBundle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state-bundle [client-id] [height]",
+		Short: "Query a consensus state along with its proof and proof height as a single bundle",
+		Long: `Query the consensus state of a client at a given height along with its merkle proof and the height
+of the store the proof was retrieved at, and print the three of them as a self-contained JSON bundle that can be
+handed over to the counterparty chain. The consensus state is encoded as a JSON Any and the proof as base64.`,
+		Example: fmt.Sprintf("%s query %s %s consensus-state-bundle [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer height, got: %s", args[1])
+			}
+
+			bundle, err := utils.QueryConsensusStateBundle(clientCtx, args[0], height)
+			if err != nil {
+				return err
+			}
+
+			bz, err := utils.MarshalConsensusStateBundle(clientCtx.JSONMarshaler, bundle)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientTypeCounts defines the command to query the number of light
+// clients of each client type that this chain maintains.
+func GetCmdQueryClientTypeCounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "type-counts",
+		Short:   "Query the number of light clients of each client type",
+		Long:    "Query the number of light clients of each client type",
+		Example: fmt.Sprintf("%s query %s %s type-counts", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientTypeCounts(context.Background(), &types.QueryClientTypeCountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryParams defines the command to query the settings of the client
+// submodule.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the settings of the client submodule",
+		Long:    "Query whether clients can be frozen without misbehaviour and the minimum trusting period of the clients created through MsgCreateClient",
+		Example: fmt.Sprintf("%s query %s %s params", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryConsensusStateMetadata defines the command to query the height,
+// timestamp and root hash of a consensus state.
+func GetCmdQueryConsensusStateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "consensus-state-metadata [client-id] [height]",
+		Short:   "Query the metadata of the consensus state of a client at a given height",
+		Long:    "Query the height, timestamp and root hash of the consensus state of a client at a given height, without the rest of its data",
+		Example: fmt.Sprintf("%s query %s %s consensus-state-metadata [client-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer height, got: %s", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsensusStateMetadataRequest{
+				ClientId: args[0],
+				Height:   height,
+			}
+
+			res, err := queryClient.ConsensusStateMetadata(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClientStorageUsage defines the command to query the number of
+// consensus states stored for a client and their total size in bytes.
+func GetCmdQueryClientStorageUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "storage-usage [client-id]",
+		Short:   "Query the storage used by the consensus states of a client",
+		Long:    "Query the number of consensus states stored for a client and their total size in bytes",
+		Example: fmt.Sprintf("%s query %s %s storage-usage [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientStorageUsage(context.Background(), &types.QueryClientStorageUsageRequest{ClientId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMaxClientHeight defines the command to query the highest latest
+// height among all the clients of the chain.
+func GetCmdQueryMaxClientHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-height",
+		Short: "Query the highest latest height among all the clients",
+		Long: `Query the highest latest height among all the light clients that this chain maintains,
+along with the identifier of the client at that height.`,
+		Example: fmt.Sprintf("%s query %s %s max-height", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientHeight, found, err := utils.QueryMaxClientHeight(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			if !found {
+				return clientCtx.PrintString("no clients found\n")
+			}
+
+			human, _ := cmd.Flags().GetBool(flagHuman)
+
+			out, err := clientHeight.Format(human)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", out))
+		},
+	}
+
+	cmd.Flags().Bool(flagHuman, false, "render the height with thousands separators instead of as JSON")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSnapshotClientHeights defines the command to write the latest height of
+// every client to a file.
+func GetCmdSnapshotClientHeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot-heights [output-file]",
+		Short: "Write the latest height of every client to a snapshot file",
+		Long: `Query the states of all the clients and write a JSON map of client identifier to latest height,
+sorted by client identifier. Diffing the snapshots taken on two nodes compares the progress of their clients.`,
+		Example: fmt.Sprintf("%s query %s %s snapshot-heights [path/to/client_heights.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			snapshot, err := utils.QueryClientHeightsSnapshot(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			if err := utils.WriteClientHeightsSnapshot(args[0], snapshot); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("wrote the latest heights of %d client(s) to %s\n", len(snapshot), args[0]))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMaxUpdateGap defines the command to query the largest time delta
+// between consecutive consensus states of a client.
+func GetCmdQueryMaxUpdateGap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-update-gap [client-id]",
+		Short: "Query the largest time gap between consecutive updates of a client",
+		Long: `Query all the consensus states of a client and report the largest time delta between consecutive
+consensus states, ordered by height. The trusting period of the client must be longer than this gap for the
+client to be updated at the same frequency without expiring.`,
+		Example: fmt.Sprintf("%s query %s %s max-update-gap [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			gap, found, err := utils.QueryMaxUpdateGap(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !found {
+				return clientCtx.PrintString("less than two consensus states found\n")
+			}
+
+			human, _ := cmd.Flags().GetBool(flagHuman)
+
+			out, err := gap.Format(human)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", out))
+		},
+	}
+
+	cmd.Flags().Bool(flagHuman, false, "render the gap as a duration instead of as JSON")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEpochDistribution defines the command to query the number of
+// consensus states of a client in each epoch.
+func GetCmdQueryEpochDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-distribution [client-id]",
+		Short: "Query the number of consensus states of a client in each epoch",
+		Long: `Query all the consensus states of a client, group their heights by epoch number and report the number
+of consensus states in each epoch. Consensus states of clients that don't track epochs are counted in epoch 0.`,
+		Example: fmt.Sprintf("%s query %s %s epoch-distribution [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			distribution, err := utils.QueryEpochDistribution(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(distribution) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("no consensus states found for client %s\n", args[0]))
+			}
+
+			for _, epochCount := range distribution {
+				if err := clientCtx.PrintString(fmt.Sprintf("%s\n", epochCount)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExpiringClients defines the command to query the clients that will
+// have expired by a given time.
+func GetCmdQueryExpiringClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring [future-timestamp]",
+		Short: "Query the clients that will have expired by a given time",
+		Long: `Query the clients whose trusting period will have elapsed since the timestamp of their latest
+consensus state by the given RFC3339 timestamp. The time can also be given as an interval from now with
+the '--in' flag instead. Clients without a trusting period are ignored.`,
+		Example: fmt.Sprintf("%s query %s %s expiring 2020-12-31T00:00:00Z\n%s query %s %s expiring --in 24h",
+			version.AppName, host.ModuleName, types.SubModuleName, version.AppName, host.ModuleName, types.SubModuleName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var timestamp string
+			if len(args) == 1 {
+				timestamp = args[0]
+			}
+
+			in, _ := cmd.Flags().GetDuration(flagIn)
+
+			at, err := utils.ParseExpiryTime(timestamp, in, time.Now())
+			if err != nil {
+				return err
+			}
+
+			expiring, err := utils.QueryExpiringClients(clientCtx, at)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(expiring, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	cmd.Flags().Duration(flagIn, 0, "interval from now at which to check the client expiries, instead of a timestamp")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimatePruneSavings defines the command to estimate how many consensus
+// states of a client a prune of its expired consensus states would delete.
+func GetCmdEstimatePruneSavings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-prune-savings [client-id]",
+		Short: "Estimate the consensus states a prune of the expired ones would delete and the bytes it would reclaim",
+		Long: `Estimate the number of consensus states of a client whose trusting period has elapsed since their
+timestamp, and the approximate number of bytes their deletion would reclaim. Expired consensus states which
+are unsafe to prune, such as the latest one or those a channel with in-flight packets may still need, are
+reported along with the reason instead. Nothing is deleted.`,
+		Example: fmt.Sprintf("%s query %s %s estimate-prune-savings [client-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			savings, err := utils.QueryPruneSavings(clientCtx, args[0], time.Now())
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(savings, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdNodeConsensusStates defines the command to query the consensus states of
+// a node at multiple heights. Each result can be fed to client creation.
+func GetCmdNodeConsensusStates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-states [height] [height...]",
+		Short: "Query the node consensus states at the given heights",
+		Long: `Query the node consensus states at the given heights from the historical info kept by the node.
+Each consensus state can be fed to the client creation transaction to create a client trusting the node at
+that height. Heights whose historical info has been pruned by the node return an error.`,
+		Example: fmt.Sprintf("%s query %s %s node-states 100 200", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			heights := make([]int64, len(args))
+			for i, arg := range args {
+				heights[i], err = strconv.ParseInt(arg, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid height %s: %w", arg, err)
+				}
+			}
+
+			states, err := utils.QueryNodeConsensusStates(clientCtx, heights)
+			if err != nil {
+				return err
+			}
+
+			out := make([]json.RawMessage, len(states))
+			for i, state := range states {
+				out[i], err = clientCtx.JSONMarshaler.MarshalJSON(state)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdValidateClientGenesis defines the command to validate an exported client
+// genesis file offline. It doesn't query the node nor touch any store.
+func GetCmdValidateClientGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-genesis [file]",
+		Short: "Validate an exported client genesis file",
+		Long: `Validate an exported client genesis JSON file before importing it. Every client state
+and consensus state is validated, the consensus state heights of each client must be
+strictly increasing and all the client types must be registered. All the errors found are reported.`,
+		Example: fmt.Sprintf("%s query %s %s validate-genesis [path/to/client_genesis.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			errs, err := utils.ValidateClientGenesisFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(errs) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("client genesis file %s is valid\n", args[0]))
+			}
+
+			for _, err := range errs {
+				if err := clientCtx.PrintString(fmt.Sprintf("%s\n", err)); err != nil {
+					return err
+				}
+			}
+
+			return fmt.Errorf("client genesis file %s is invalid: found %d error(s)", args[0], len(errs))
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdCheckConsensusIntegrity defines the command to report the heights at
+// which a client has more than one consensus state.
+func GetCmdCheckConsensusIntegrity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-consensus-integrity [client-id]",
+		Short: "Report the heights at which a client has duplicate consensus states",
+		Long: `Scan all the consensus states of a client and report the heights at which more than one consensus state
+is found, which is impossible in a healthy store but useful to diagnose exported data. If the '--genesis-file' flag
+is set, the consensus states are read from an exported client genesis JSON file instead of being queried, and the
+content of the consensus states at a duplicate height is compared.`,
+		Example: fmt.Sprintf("%s query %s %s check-consensus-integrity [client-id] --genesis-file [path/to/client_genesis.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientID := args[0]
+
+			var duplicates []utils.DuplicateHeight
+
+			genesisFile, _ := cmd.Flags().GetString(flagGenesisFile)
+			if genesisFile != "" {
+				cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+				consensusStates, err := utils.ReadGenesisConsensusStates(cdc, genesisFile, clientID)
+				if err != nil {
+					return err
+				}
+
+				duplicates, err = utils.FindDuplicateConsensusHeights(consensusStates)
+				if err != nil {
+					return err
+				}
+			} else {
+				clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				duplicates, err = utils.QueryDuplicateConsensusHeights(clientCtx, clientID)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(duplicates) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("no duplicate consensus state heights found for client %s\n", clientID))
+			}
+
+			for _, duplicate := range duplicates {
+				if err := clientCtx.PrintString(fmt.Sprintf("%s\n", duplicate)); err != nil {
+					return err
+				}
+			}
+
+			return fmt.Errorf("client %s has duplicate consensus states at %d height(s)", clientID, len(duplicates))
+		},
+	}
+
+	cmd.Flags().String(flagGenesisFile, "", "read the consensus states from an exported client genesis JSON file instead of querying them")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdValidateSelfClientState defines the command to validate a client state of
+// this chain against the node before creating a client of this chain on a counterparty.
+func GetCmdValidateSelfClientState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-self [file]",
+		Short: "Validate a client state of this chain against the node",
+		Long: `Validate a Tendermint client state JSON file of this chain against the node before submitting it
+to a counterparty. The chain ID and the proof specs must match the node and the latest height must not be
+greater than the node height. All the mismatches found are reported.`,
+		Example: fmt.Sprintf("%s query %s %s validate-self [path/to/client_state.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			clientState, err := utils.ReadSelfClientStateFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			errs, err := utils.ValidateSelfClientState(clientCtx, clientState)
+			if err != nil {
+				return err
+			}
+
+			if len(errs) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("client state %s matches the node\n", args[0]))
+			}
+
+			for _, err := range errs {
+				if err := clientCtx.PrintString(fmt.Sprintf("%s\n", err)); err != nil {
+					return err
+				}
+			}
+
+			return fmt.Errorf("client state %s doesn't match the node: found %d mismatch(es)", args[0], len(errs))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryHeader defines the command to query the latest header on the chain
+func GetCmdQueryHeader() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "header",
+		Short:   "Query the latest header of the running chain",
+		Long:    "Query the latest Tendermint header of the running chain",
+		Example: fmt.Sprintf("%s query %s %s  header", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			header, height, err := utils.QueryTendermintHeader(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
+			return clientCtx.PrintOutputLegacy(header)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdNodeConsensusState defines the command to query the latest consensus state of a node
+// The result is feed to client creation
+func GetCmdNodeConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-state",
+		Short: "Query a node consensus state",
+		Long: `Query a node consensus state. This result is feed to the client creation transaction.
+With the '--height' flag, the consensus state as of that past height is built from the historical info kept
+by the node instead, to create a client trusting the node at that height. Heights whose historical info has
+been pruned by the node return an error.`,
+		Example: fmt.Sprintf("%s query %s %s node-state\n%s query %s %s node-state --%s 100",
+			version.AppName, host.ModuleName, types.SubModuleName, version.AppName, host.ModuleName, types.SubModuleName, flags.FlagHeight),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Height > 0 {
+				state, err := utils.QueryNodeHistoricalConsensusState(clientCtx, clientCtx.Height)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintOutput(state)
+			}
+
+			state, height, err := utils.QueryNodeConsensusState(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(height)
+			return clientCtx.PrintOutput(state)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdGenerateClientCreationData defines the command to write the latest header
+// of a node along with its consensus state to a single file, to be handed to the
+// counterparty chain to create a client of this chain.
+func GetCmdGenerateClientCreationData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-creation-data [output-file]",
+		Short: "Write the latest header and consensus state of a node to a client creation file",
+		Long: `Query the latest header of a node and its consensus state at the same height, and write both to a
+single JSON file along with their chain-id and height. The header is encoded as expected by the tendermint client
+creation command and the consensus state is encoded as in a MsgCreateClient, so that the file can be handed to the
+counterparty chain to create a client of this chain.`,
+		Example: fmt.Sprintf("%s query %s %s generate-creation-data [path/to/client_creation.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			ccd, err := utils.QueryClientCreationData(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+			if err := utils.WriteClientCreationFile(cdc, args[0], ccd); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("wrote the client creation data of chain %s at height %s to %s\n", ccd.ChainID, ccd.Height, args[0]))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdWatchNewClients defines the command to subscribe to the client creation
+// events of a node and print every new client as it is created.
+func GetCmdWatchNewClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-new-clients",
+		Short: "Print the clients created on the chain as they appear",
+		Long: `Subscribe to the client creation events of the node and print the identifier, type and
+initial consensus height of every new client. The subscription is renewed whenever the event stream is dropped.`,
+		Example: fmt.Sprintf("%s query %s %s watch-new-clients", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			retryInterval, err := cmd.Flags().GetDuration(flagRetryInterval)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			if !node.IsRunning() {
+				if err := node.Start(); err != nil {
+					return err
+				}
+				defer node.Stop() // nolint: errcheck
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			return utils.WatchNewClients(ctx, node, retryInterval, func(newClient utils.NewClientEvent) error {
+				return clientCtx.PrintOutputLegacy(newClient)
+			})
+		},
+	}
+
+	cmd.Flags().Duration(flagRetryInterval, 5*time.Second, "interval to wait before resubscribing when the event stream is dropped")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdWatchClientFreezes defines the command to subscribe to the client freeze
+// events of a node and print every client as it is frozen.
+func GetCmdWatchClientFreezes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch-client-freezes",
+		Short: "Print the clients frozen on the chain as they are frozen",
+		Long: `Subscribe to the misbehaviour and manual freeze events of the node and print the identifier and
+frozen height of every client frozen. The subscriptions are renewed whenever an event stream is dropped.`,
+		Example: fmt.Sprintf("%s query %s %s watch-client-freezes", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			retryInterval, err := cmd.Flags().GetDuration(flagRetryInterval)
+			if err != nil {
+				return err
+			}
+
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			if !node.IsRunning() {
+				if err := node.Start(); err != nil {
+					return err
+				}
+				defer node.Stop() // nolint: errcheck
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				cancel()
+			}()
+
+			return utils.WatchClientFreezes(ctx, node, retryInterval, func(freeze utils.ClientFreezeEvent) error {
+				return clientCtx.PrintOutputLegacy(freeze)
+			})
+		},
+	}
+
+	cmd.Flags().Duration(flagRetryInterval, 5*time.Second, "interval to wait before resubscribing when an event stream is dropped")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRegisteredClients defines the command to list the client
+// implementations registered in the application interface registry.
+func GetCmdQueryRegisteredClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registered-clients",
+		Short: "List the client types registered in the application codec",
+		Long: `List the ClientState, ConsensusState and Header implementations registered in the
+interface registry of the application along with their proto type URLs.`,
+		Example: fmt.Sprintf("%s query %s %s registered-clients", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.InterfaceRegistry == nil {
+				return errors.New("the client context has no interface registry")
+			}
+
+			return clientCtx.PrintOutputLegacy(utils.QueryRegisteredClients(clientCtx.InterfaceRegistry))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCheckClientCompatibility defines the command to check whether a connection
+// can be established between clients of the given types.
+func GetCmdCheckClientCompatibility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-compatibility [self-client-type] [counterparty-client-type]",
+		Short: "Check whether two client types can be used to connect two chains",
+		Long: `Check that the type of the client tracking the counterparty chain on this chain (self-client-type)
+and the type of the client tracking this chain on the counterparty chain (counterparty-client-type) are known
+and can be used to establish a connection. Prints 'go' if they are compatible and 'no-go' with the reason otherwise.`,
+		Example: fmt.Sprintf("%s query %s %s check-compatibility tendermint tendermint", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			if err := types.CheckClientCompatibility(args[0], args[1]); err != nil {
+				return clientCtx.PrintString(fmt.Sprintf("no-go: %s\n", err))
+			}
+
+			return clientCtx.PrintString("go\n")
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdTestLocalhostVerification defines the command to run the localhost client
+// verification of a value against the IBC store of the node.
+func GetCmdTestLocalhostVerification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test-localhost-verification [store-key] [expected-value]",
+		Short: "Verify a value of the IBC store as the localhost client does",
+		Long: `Read the value stored at a key of the IBC store of the node and verify it against the expected value,
+hex encoded, with the local store verification of the localhost client. The result reports both values and
+the verification error on mismatch.`,
+		Example: fmt.Sprintf("%s query %s %s test-localhost-verification commitments/ports/transfer/channels/channel-0/packets/1 [hex-value]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			expected, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("expected hex encoded value, got: %s", args[1])
+			}
+
+			verification, err := utils.QueryLocalhostVerification(clientCtx, args[0], expected)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutputLegacy(verification)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdVerifyPacketAcknowledgement defines the command to verify a packet
+// acknowledgement proof with a client, without broadcasting any transaction.
+func GetCmdVerifyPacketAcknowledgement() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-packet-acknowledgement [client-id] [proof-height] [port-id] [channel-id] [sequence] [acknowledgement] [/path/to/proof.json]",
+		Short: "Verify a packet acknowledgement proof with a client",
+		Long: `Verify the proof of a packet acknowledgement written on the counterparty chain with the client state
+and the consensus state at the proof height of a client of the node, as the acknowledgement relay does.
+The acknowledgement is hex encoded and the port and channel are the destination ones of the packet.
+The result reports the verification error, if any. No transaction is broadcast.`,
+		Example: fmt.Sprintf("%s query %s %s verify-packet-acknowledgement [client-id] [proof-height] transfer channel-0 1 [hex-ack] [/path/to/proof.json]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer height, got: %s", args[1])
+			}
+
+			portID := args[2]
+			channelID := args[3]
+			sequence, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer sequence, got: %s", args[4])
+			}
+
+			acknowledgement, err := hex.DecodeString(args[5])
+			if err != nil {
+				return fmt.Errorf("expected hex encoded acknowledgement, got: %s", args[5])
+			}
+
+			proof, err := connectionutils.ParseProof(clientCtx.LegacyAmino, args[6])
+			if err != nil {
+				return err
+			}
+
+			prefixStr, _ := cmd.Flags().GetString(flagPrefix)
+			prefix := commitmenttypes.NewMerklePrefix([]byte(prefixStr))
+
+			verification, err := utils.QueryVerifyPacketAcknowledgement(
+				clientCtx, clientID, height, &prefix, proof, portID, channelID, sequence, acknowledgement,
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutputLegacy(verification)
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, host.StoreKey, "commitment prefix of the store of the counterparty chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBenchmarkVerification defines the command to measure the packet commitment
+// verification throughput of a client.
+func GetCmdBenchmarkVerification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "benchmark-verification [client-id] [proof-height] [port-id] [channel-id] [sequence] [commitment] [/path/to/proof.json]",
+		Short: "Benchmark the packet commitment verification throughput of a client",
+		Long: `Run the verification of a packet commitment proof written on the counterparty chain '--iterations'
+times with the client state and the consensus state at the proof height of a client of the node, and report
+the throughput and latency percentiles of the verifications. The commitment is hex encoded and the port and
+channel are the source ones of the packet. Failed verifications are reported but don't stop the benchmark.`,
+		Example: fmt.Sprintf("%s query %s %s benchmark-verification [client-id] [proof-height] transfer channel-0 1 [hex-commitment] [/path/to/proof.json] --%s 1000", version.AppName, host.ModuleName, types.SubModuleName, flagIterations),
+		Args:    cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer height, got: %s", args[1])
+			}
+
+			portID := args[2]
+			channelID := args[3]
+			sequence, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer sequence, got: %s", args[4])
+			}
+
+			commitment, err := hex.DecodeString(args[5])
+			if err != nil {
+				return fmt.Errorf("expected hex encoded commitment, got: %s", args[5])
+			}
+
+			proof, err := connectionutils.ParseProof(clientCtx.LegacyAmino, args[6])
+			if err != nil {
+				return err
+			}
+
+			prefixStr, _ := cmd.Flags().GetString(flagPrefix)
+			prefix := commitmenttypes.NewMerklePrefix([]byte(prefixStr))
+
+			iterations, err := cmd.Flags().GetInt(flagIterations)
+			if err != nil {
+				return err
+			}
+
+			benchmark, err := utils.QueryBenchmarkPacketCommitmentVerification(
+				clientCtx, clientID, height, &prefix, proof, portID, channelID, sequence, commitment, iterations,
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutputLegacy(benchmark)
 		},
 	}
 
+	cmd.Flags().String(flagPrefix, host.StoreKey, "commitment prefix of the store of the counterparty chain")
+	cmd.Flags().Int(flagIterations, 100, "number of verifications to run")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
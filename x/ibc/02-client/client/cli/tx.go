@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// GetCmdBatchCreateClients defines the command to create a client for every
+// client creation spec of a directory in a single transaction.
+func GetCmdBatchCreateClients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-batch [dir]",
+		Short: "Create a client for every client creation spec of a directory",
+		Long: `Create a client for every *.json client creation spec of a directory in a single transaction.
+Each spec is a MsgCreateClient in JSON format without the signer, which is set to the --from address.
+The result of the validation of each spec is reported and the invalid specs are skipped.`,
+		Example: fmt.Sprintf("%s tx %s %s create-batch [path/to/specs] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			specs, err := utils.ReadCreateClientSpecs(cdc, args[0], clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			// the report is written to stderr so that it doesn't mix with the transaction output
+			if err := utils.WriteCreateClientSpecsReport(cmd.ErrOrStderr(), specs); err != nil {
+				return err
+			}
+
+			msgs := utils.ValidCreateClientMsgs(specs)
+			if len(msgs) == 0 {
+				return fmt.Errorf("none of the %d client creation specs in %s is valid", len(specs), args[0])
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFreezeClient defines the command to freeze a client at a given height
+// without misbehaviour, on test networks allowing it.
+func GetCmdFreezeClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [client-id] [frozen-height]",
+		Short: "Freeze a client at a given height without misbehaviour",
+		Long: `Freeze a Tendermint or solo machine client at a given height, in the format {epoch number}-{epoch height},
+to simulate a frozen client. The transaction is refused unless manual freezing is allowed in the client params
+of the chain, which must only be the case on test networks, and it is signed by the freeze authority.`,
+		Example: fmt.Sprintf("%s tx %s %s freeze [client-id] 0-100 --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			frozenHeight, err := types.ParseHeightShort(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeClient(args[0], frozenHeight, clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdReplayHeaders defines the command to rebuild the history of a client by
+// submitting the headers of an archive as sequential updates.
+func GetCmdReplayHeaders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay-headers [client-id] [headers-file]",
+		Short: "Update a client with every header of an archive, in order",
+		Long: `Update a client with every header of a headers file, in order, to rebuild its history.
+The file is a JSON array of headers, each encoded as a protobuf Any. Every header is submitted in its own
+transaction, broadcast in block mode, and must advance the height reached by the previous ones. A header
+equal to the previous one is skipped. The replay stops on the first header that fails verification and the
+height reached is reported.`,
+		Example: fmt.Sprintf("%s tx %s %s replay-headers [client-id] [path/to/headers.json] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.GenerateOnly {
+				return errors.New("headers cannot be replayed in generate only mode")
+			}
+
+			// every update must be committed before the next header is verified against it
+			clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastBlock).WithSkipConfirmation(true)
+
+			clientID := args[0]
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			headers, err := utils.ReadHeaders(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := utils.QueryClientState(clientCtx, clientID, false)
+			if err != nil {
+				return err
+			}
+
+			clientState, err := types.UnpackClientState(res.ClientState)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.PrepareFactory(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			height, err := utils.ReplayHeaders(clientState.GetLatestHeight(), headers, func(header exported.Header) error {
+				msg, err := types.NewMsgUpdateClient(clientID, header, clientCtx.GetFromAddress())
+				if err != nil {
+					return err
+				}
+
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				if err := broadcastUpdate(clientCtx, txf, msg); err != nil {
+					return err
+				}
+
+				txf = txf.WithSequence(txf.Sequence() + 1)
+				return nil
+			})
+
+			// the report is written to stderr so that it doesn't mix with the transaction output
+			fmt.Fprintf(cmd.ErrOrStderr(), "client %s updated up to height %d\n", clientID, height)
+
+			return err
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// broadcastUpdate signs and broadcasts a client update in its own transaction
+// and returns an error if the transaction failed to be delivered.
+func broadcastUpdate(clientCtx client.Context, txf tx.Factory, msg sdk.Msg) error {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx.QueryWithData, txf, msg)
+		if err != nil {
+			return err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	txBuilder, err := tx.BuildUnsignedTx(txf, msg)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder); err != nil {
+		return err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	if res.Code != 0 {
+		return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return nil
+}
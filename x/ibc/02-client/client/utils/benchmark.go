@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// VerificationBenchmark is the result of running the same packet commitment
+// verification repeatedly with a client.
+type VerificationBenchmark struct {
+	ClientID   string        `json:"client_id" yaml:"client_id"`
+	Height     uint64        `json:"height" yaml:"height"`
+	Iterations int           `json:"iterations" yaml:"iterations"`
+	Failures   int           `json:"failures" yaml:"failures"`
+	Total      time.Duration `json:"total" yaml:"total"`
+	// Throughput is the number of verifications per second
+	Throughput float64       `json:"throughput" yaml:"throughput"`
+	LatencyP50 time.Duration `json:"latency_p50" yaml:"latency_p50"`
+	LatencyP90 time.Duration `json:"latency_p90" yaml:"latency_p90"`
+	LatencyP99 time.Duration `json:"latency_p99" yaml:"latency_p99"`
+	LatencyMax time.Duration `json:"latency_max" yaml:"latency_max"`
+	// Error is the error of the first failed verification, if any
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// String implements the Stringer interface.
+func (vb VerificationBenchmark) String() string {
+	return fmt.Sprintf(
+		"%d verification(s) of client %s at height %d in %s (%d failed): %.2f/s, p50 %s, p90 %s, p99 %s, max %s",
+		vb.Iterations, vb.ClientID, vb.Height, vb.Total, vb.Failures, vb.Throughput,
+		vb.LatencyP50, vb.LatencyP90, vb.LatencyP99, vb.LatencyMax,
+	)
+}
+
+// BenchmarkPacketCommitmentVerification runs the packet commitment verification
+// of the client state the given number of times against a client store holding
+// only the given consensus state at the proof height, and returns the throughput
+// and latency percentiles of the verifications. Failed verifications are timed
+// as well and counted as failures.
+func BenchmarkPacketCommitmentVerification(
+	cdc codec.BinaryMarshaler, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState,
+	height uint64, prefix exported.Prefix, proof []byte, portID, channelID string, sequence uint64, commitmentBytes []byte,
+	iterations int,
+) (VerificationBenchmark, error) {
+	if iterations <= 0 {
+		return VerificationBenchmark{}, fmt.Errorf("iterations must be positive, got %d", iterations)
+	}
+
+	bz, err := types.MarshalConsensusState(cdc, consensusState)
+	if err != nil {
+		return VerificationBenchmark{}, err
+	}
+
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	store.Set(host.KeyConsensusState(height), bz)
+
+	benchmark := VerificationBenchmark{
+		ClientID:   clientID,
+		Height:     height,
+		Iterations: iterations,
+	}
+
+	latencies := make([]time.Duration, iterations)
+	for i := range latencies {
+		start := time.Now()
+		err := clientState.VerifyPacketCommitment(
+			store, cdc, height, prefix, proof, portID, channelID, sequence, commitmentBytes,
+		)
+		latencies[i] = time.Since(start)
+
+		if err != nil {
+			if benchmark.Failures == 0 {
+				benchmark.Error = err.Error()
+			}
+			benchmark.Failures++
+		}
+
+		benchmark.Total += latencies[i]
+	}
+
+	if benchmark.Total > 0 {
+		benchmark.Throughput = float64(iterations) / benchmark.Total.Seconds()
+	}
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	benchmark.LatencyP50 = latencyPercentile(latencies, 50)
+	benchmark.LatencyP90 = latencyPercentile(latencies, 90)
+	benchmark.LatencyP99 = latencyPercentile(latencies, 99)
+	benchmark.LatencyMax = latencies[len(latencies)-1]
+
+	return benchmark, nil
+}
+
+// latencyPercentile returns the nearest-rank percentile of the latencies, which
+// must be sorted in ascending order and non empty.
+func latencyPercentile(latencies []time.Duration, percentile int) time.Duration {
+	rank := (percentile*len(latencies) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return latencies[rank-1]
+}
+
+// QueryBenchmarkPacketCommitmentVerification queries the state of a client along
+// with its consensus state at the proof height and benchmarks the verification
+// of the packet commitment proof by the client, as described in
+// BenchmarkPacketCommitmentVerification.
+func QueryBenchmarkPacketCommitmentVerification(
+	clientCtx client.Context, clientID string, height uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, sequence uint64, commitmentBytes []byte, iterations int,
+) (VerificationBenchmark, error) {
+	// the ABCI queries return the client and consensus states already unpacked
+	clientStateRes, err := QueryClientState(clientCtx, clientID, true)
+	if err != nil {
+		return VerificationBenchmark{}, err
+	}
+
+	clientState, err := types.UnpackClientState(clientStateRes.ClientState)
+	if err != nil {
+		return VerificationBenchmark{}, err
+	}
+
+	consensusStateRes, err := QueryConsensusState(clientCtx, clientID, height, true, false)
+	if err != nil {
+		return VerificationBenchmark{}, err
+	}
+
+	consensusState, err := types.UnpackConsensusState(consensusStateRes.ConsensusState)
+	if err != nil {
+		return VerificationBenchmark{}, err
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	return BenchmarkPacketCommitmentVerification(
+		cdc, clientID, clientState, consensusState, height, prefix, proof, portID, channelID, sequence, commitmentBytes, iterations,
+	)
+}
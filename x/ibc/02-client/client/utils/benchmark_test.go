@@ -0,0 +1,94 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+// mockVerificationClient is a client whose packet commitment verification takes
+// a fixed time and fails every failEvery calls, if set.
+type mockVerificationClient struct {
+	*ibctmtypes.ClientState
+
+	delay     time.Duration
+	failEvery int
+	calls     int
+}
+
+func (m *mockVerificationClient) VerifyPacketCommitment(
+	_ sdk.KVStore, _ codec.BinaryMarshaler, _ uint64, _ exported.Prefix, _ []byte, _, _ string, _ uint64, _ []byte,
+) error {
+	m.calls++
+	time.Sleep(m.delay)
+	if m.failEvery > 0 && m.calls%m.failEvery == 0 {
+		return errors.New("invalid proof")
+	}
+	return nil
+}
+
+func TestBenchmarkPacketCommitmentVerification(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+	consensusState := newConsensusState(10, time.Now().UTC())
+
+	newClient := func(failEvery int) *mockVerificationClient {
+		return &mockVerificationClient{
+			ClientState: ibctmtypes.NewClientState(
+				chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+				types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+			),
+			delay:     time.Millisecond,
+			failEvery: failEvery,
+		}
+	}
+
+	run := func(clientState exported.ClientState, iterations int) (utils.VerificationBenchmark, error) {
+		return utils.BenchmarkPacketCommitmentVerification(
+			cdc, clientID, clientState, consensusState, 10, &prefix, []byte("proof"),
+			"transfer", "channel-0", 1, []byte("commitment"), iterations,
+		)
+	}
+
+	mock := newClient(0)
+	benchmark, err := run(mock, 20)
+	require.NoError(t, err)
+	require.Equal(t, 20, mock.calls)
+	require.Equal(t, 20, benchmark.Iterations)
+	require.Equal(t, clientID, benchmark.ClientID)
+	require.Equal(t, uint64(10), benchmark.Height)
+	require.Zero(t, benchmark.Failures)
+	require.Empty(t, benchmark.Error)
+
+	// every verification takes at least the delay of the mock
+	require.GreaterOrEqual(t, int64(benchmark.LatencyP50), int64(time.Millisecond))
+	require.LessOrEqual(t, int64(benchmark.LatencyP50), int64(benchmark.LatencyP90))
+	require.LessOrEqual(t, int64(benchmark.LatencyP90), int64(benchmark.LatencyP99))
+	require.LessOrEqual(t, int64(benchmark.LatencyP99), int64(benchmark.LatencyMax))
+	require.GreaterOrEqual(t, int64(benchmark.Total), int64(20*time.Millisecond))
+	require.Greater(t, benchmark.Throughput, 0.0)
+	require.LessOrEqual(t, benchmark.Throughput, 1000.0)
+
+	// failed verifications are counted without stopping the benchmark
+	mock = newClient(4)
+	benchmark, err = run(mock, 10)
+	require.NoError(t, err)
+	require.Equal(t, 10, mock.calls)
+	require.Equal(t, 2, benchmark.Failures)
+	require.Equal(t, "invalid proof", benchmark.Error)
+
+	_, err = run(newClient(0), 0)
+	require.Error(t, err)
+}
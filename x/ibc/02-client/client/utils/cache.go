@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// defaultClientStateCache is the cache used by QueryClientStateCached.
+var defaultClientStateCache = NewClientStateCache(QueryClientState)
+
+// ClientStateQuerier defines the function used by a ClientStateCache to query a
+// client state from a node.
+type ClientStateQuerier func(clientCtx client.Context, clientID string, prove bool) (*types.QueryClientStateResponse, error)
+
+type clientStateCacheEntry struct {
+	res      *types.QueryClientStateResponse
+	cachedAt time.Time
+}
+
+// ClientStateCache is an in-memory cache of client state query responses indexed
+// by client identifier. Responses including a proof are never cached as proofs are
+// only valid for the height at which they were queried.
+type ClientStateCache struct {
+	mtx     sync.Mutex
+	query   ClientStateQuerier
+	entries map[string]clientStateCacheEntry
+}
+
+// NewClientStateCache creates a new ClientStateCache that queries the client
+// states not found in the cache with the given querier.
+func NewClientStateCache(query ClientStateQuerier) *ClientStateCache {
+	return &ClientStateCache{
+		query:   query,
+		entries: make(map[string]clientStateCacheEntry),
+	}
+}
+
+// Query returns the client state of the given client. If prove is false and the
+// client state was cached less than ttl ago, the cached response is returned.
+// Otherwise the node is queried and, if prove is false, the response is cached.
+func (c *ClientStateCache) Query(
+	clientCtx client.Context, clientID string, prove bool, ttl time.Duration,
+) (*types.QueryClientStateResponse, error) {
+	if prove {
+		return c.query(clientCtx, clientID, true)
+	}
+
+	c.mtx.Lock()
+	entry, ok := c.entries[clientID]
+	c.mtx.Unlock()
+
+	if ok && time.Since(entry.cachedAt) < ttl {
+		res := *entry.res
+		return &res, nil
+	}
+
+	res, err := c.query(clientCtx, clientID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	cached := *res
+	cached.Proof = nil
+
+	c.mtx.Lock()
+	c.entries[clientID] = clientStateCacheEntry{res: &cached, cachedAt: time.Now()}
+	c.mtx.Unlock()
+
+	return res, nil
+}
+
+// Invalidate removes the client state of the given client from the cache.
+func (c *ClientStateCache) Invalidate(clientID string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.entries, clientID)
+}
+
+// InvalidateFromEvent removes from the cache the client states of all the clients
+// updated or frozen by the transaction of the given Tendermint event.
+func (c *ClientStateCache) InvalidateFromEvent(event ctypes.ResultEvent) {
+	for _, eventType := range []string{types.EventTypeUpdateClient, types.EventTypeSubmitMisbehaviour} {
+		for _, clientID := range event.Events[fmt.Sprintf("%s.%s", eventType, types.AttributeKeyClientID)] {
+			c.Invalidate(clientID)
+		}
+	}
+}
+
+// QueryClientStateCached returns a client state using a process wide cache. The
+// cached client state is returned if it is more recent than the given ttl.
+// Queries with prove set to true always hit the node.
+func QueryClientStateCached(
+	clientCtx client.Context, clientID string, prove bool, ttl time.Duration,
+) (*types.QueryClientStateResponse, error) {
+	return defaultClientStateCache.Query(clientCtx, clientID, prove, ttl)
+}
+
+// InvalidateCachedClientState removes the given client from the cache used by
+// QueryClientStateCached.
+func InvalidateCachedClientState(clientID string) {
+	defaultClientStateCache.Invalidate(clientID)
+}
+
+// InvalidateCachedClientStatesFromEvent removes the clients updated or frozen by
+// the transaction of the given event from the cache used by QueryClientStateCached.
+func InvalidateCachedClientStatesFromEvent(event ctypes.ResultEvent) {
+	defaultClientStateCache.InvalidateFromEvent(event)
+}
@@ -0,0 +1,67 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+type mockClientStateQuerier struct {
+	queries int
+}
+
+func (m *mockClientStateQuerier) query(_ client.Context, _ string, prove bool) (*types.QueryClientStateResponse, error) {
+	m.queries++
+
+	res := &types.QueryClientStateResponse{ProofHeight: uint64(m.queries)}
+	if prove {
+		res.Proof = []byte("proof")
+	}
+	return res, nil
+}
+
+func TestClientStateCache(t *testing.T) {
+	querier := &mockClientStateQuerier{}
+	cache := utils.NewClientStateCache(querier.query)
+	clientCtx := client.Context{}
+
+	// first query misses the cache
+	res, err := cache.Query(clientCtx, clientID, false, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.ProofHeight)
+
+	// cache hit within the ttl
+	res, err = cache.Query(clientCtx, clientID, false, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.ProofHeight)
+	require.Equal(t, 1, querier.queries)
+
+	// proofs are never served from the cache
+	res, err = cache.Query(clientCtx, clientID, true, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.ProofHeight)
+	require.NotNil(t, res.Proof)
+
+	// refresh after expiry
+	time.Sleep(2 * time.Millisecond)
+	res, err = cache.Query(clientCtx, clientID, false, time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.ProofHeight)
+
+	// refresh after an update event of the client
+	cache.InvalidateFromEvent(ctypes.ResultEvent{
+		Events: map[string][]string{
+			types.EventTypeUpdateClient + "." + types.AttributeKeyClientID: {clientID},
+		},
+	})
+	res, err = cache.Query(clientCtx, clientID, false, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.ProofHeight)
+	require.Equal(t, 4, querier.queries)
+}
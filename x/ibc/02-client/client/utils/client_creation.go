@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ClientCreationData is a header of the chain along with the consensus state of
+// the node at the height of the header, as needed by a counterparty chain to
+// create a tendermint client of the chain.
+type ClientCreationData struct {
+	ChainID        string
+	Height         types.Height
+	Header         *ibctmtypes.Header
+	ConsensusState *ibctmtypes.ConsensusState
+}
+
+// clientCreationDataJSON is the file format of the client creation data. The
+// header is encoded as expected by the tendermint client creation command and
+// the consensus state is encoded as a JSON Any, as in a MsgCreateClient.
+type clientCreationDataJSON struct {
+	ChainID        string          `json:"chain_id"`
+	Height         types.Height    `json:"height"`
+	Header         json.RawMessage `json:"header"`
+	ConsensusState json.RawMessage `json:"consensus_state"`
+}
+
+// NewClientCreationData returns the client creation data of a header and the
+// consensus state of the node at the height of the header.
+func NewClientCreationData(header *ibctmtypes.Header, consensusState *ibctmtypes.ConsensusState) ClientCreationData {
+	return ClientCreationData{
+		ChainID: header.GetChainID(),
+		// for now, assume the epoch number of the header is zero
+		Height:         types.NewHeight(0, header.GetHeight()),
+		Header:         header,
+		ConsensusState: consensusState,
+	}
+}
+
+// ValidateBasic checks that the header and the consensus state are valid and
+// that the chain-id and height of the header, the height of the consensus state
+// and the data match one another.
+func (ccd ClientCreationData) ValidateBasic() error {
+	if ccd.Header == nil {
+		return fmt.Errorf("header cannot be empty")
+	}
+
+	if err := ccd.Header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if ccd.ConsensusState == nil {
+		return fmt.Errorf("consensus state cannot be empty")
+	}
+
+	if err := ccd.ConsensusState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if chainID := ccd.Header.GetChainID(); chainID != ccd.ChainID {
+		return fmt.Errorf("chain-id %s doesn't match the header chain-id %s", ccd.ChainID, chainID)
+	}
+
+	if height := types.NewHeight(0, ccd.Header.GetHeight()); !ccd.Height.EQ(height) {
+		return fmt.Errorf("height %s doesn't match the header height %s", ccd.Height, height)
+	}
+
+	if !ccd.Height.EQ(ccd.ConsensusState.Height) {
+		return fmt.Errorf("height %s doesn't match the consensus state height %s", ccd.Height, ccd.ConsensusState.Height)
+	}
+
+	if !bytes.Equal(ccd.ConsensusState.GetRoot().GetHash(), ccd.Header.Header.GetAppHash()) {
+		return fmt.Errorf("consensus state root doesn't match the header app hash")
+	}
+
+	return nil
+}
+
+// WriteClientCreationFile writes the client creation data in JSON format to the
+// file at the given path.
+func WriteClientCreationFile(cdc codec.JSONMarshaler, path string, ccd ClientCreationData) error {
+	if err := ccd.ValidateBasic(); err != nil {
+		return err
+	}
+
+	headerBz, err := cdc.MarshalJSON(ccd.Header)
+	if err != nil {
+		return err
+	}
+
+	any, err := types.PackConsensusState(ccd.ConsensusState)
+	if err != nil {
+		return err
+	}
+
+	anyBz, err := cdc.MarshalJSON(any)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(clientCreationDataJSON{
+		ChainID:        ccd.ChainID,
+		Height:         ccd.Height,
+		Header:         headerBz,
+		ConsensusState: anyBz,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// ReadClientCreationFile reads the client creation data from the JSON file at
+// the given path and validates it.
+func ReadClientCreationFile(cdc codec.Marshaler, path string) (ClientCreationData, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ClientCreationData{}, err
+	}
+
+	var ccdJSON clientCreationDataJSON
+	if err := json.Unmarshal(bz, &ccdJSON); err != nil {
+		return ClientCreationData{}, fmt.Errorf("failed to decode client creation file %s: %w", path, err)
+	}
+
+	header := &ibctmtypes.Header{}
+	if err := cdc.UnmarshalJSON(ccdJSON.Header, header); err != nil {
+		return ClientCreationData{}, fmt.Errorf("failed to decode header in file %s: %w", path, err)
+	}
+
+	any := &codectypes.Any{}
+	if err := cdc.UnmarshalJSON(ccdJSON.ConsensusState, any); err != nil {
+		return ClientCreationData{}, fmt.Errorf("failed to decode consensus state in file %s: %w", path, err)
+	}
+
+	var consensusState exported.ConsensusState
+	if err := cdc.UnpackAny(any, &consensusState); err != nil {
+		return ClientCreationData{}, err
+	}
+
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return ClientCreationData{}, fmt.Errorf("consensus state in file %s is not a tendermint consensus state: %T", path, consensusState)
+	}
+
+	ccd := ClientCreationData{
+		ChainID:        ccdJSON.ChainID,
+		Height:         ccdJSON.Height,
+		Header:         header,
+		ConsensusState: tmConsensusState,
+	}
+
+	if err := ccd.ValidateBasic(); err != nil {
+		return ClientCreationData{}, err
+	}
+
+	return ccd, nil
+}
+
+// QueryClientCreationData queries the latest header of the node along with its
+// consensus state at the same height and returns their client creation data.
+func QueryClientCreationData(clientCtx client.Context) (ClientCreationData, error) {
+	header, height, err := QueryTendermintHeader(clientCtx)
+	if err != nil {
+		return ClientCreationData{}, err
+	}
+
+	consensusState, err := QueryNodeConsensusStateAtHeight(clientCtx, height)
+	if err != nil {
+		return ClientCreationData{}, err
+	}
+
+	return NewClientCreationData(&header, consensusState), nil
+}
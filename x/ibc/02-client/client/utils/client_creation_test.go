@@ -0,0 +1,112 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestClientCreationFile(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(0))
+	coordinator.CommitNBlocks(chain, 2)
+
+	header := chain.LastHeader
+	ccd := utils.NewClientCreationData(header, header.ConsensusState())
+	require.Equal(t, chain.ChainID, ccd.ChainID)
+	require.Equal(t, types.NewHeight(0, header.GetHeight()), ccd.Height)
+
+	path := filepath.Join(t.TempDir(), "client_creation.json")
+	require.NoError(t, utils.WriteClientCreationFile(cdc, path, ccd))
+
+	read, err := utils.ReadClientCreationFile(cdc, path)
+	require.NoError(t, err)
+	require.Equal(t, ccd.ChainID, read.ChainID)
+	require.Equal(t, ccd.Height, read.Height)
+	require.Equal(t, ccd.ConsensusState, read.ConsensusState)
+	require.Equal(t, ccd.Header.GetHeight(), read.Header.GetHeight())
+
+	// the chain-id and heights of the file are consistent with one another
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	var file struct {
+		ChainID string       `json:"chain_id"`
+		Height  types.Height `json:"height"`
+		Header  struct {
+			SignedHeader struct {
+				Header struct {
+					ChainID string `json:"chain_id"`
+					Height  string `json:"height"`
+				} `json:"header"`
+			} `json:"signed_header"`
+		} `json:"header"`
+		ConsensusState struct {
+			Type   string `json:"@type"`
+			Height struct {
+				EpochHeight string `json:"epoch_height"`
+			} `json:"height"`
+		} `json:"consensus_state"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &file))
+
+	expHeight := strconv.FormatUint(header.GetHeight(), 10)
+	require.Equal(t, chain.ChainID, file.ChainID)
+	require.Equal(t, chain.ChainID, file.Header.SignedHeader.Header.ChainID)
+	require.Equal(t, header.GetHeight(), file.Height.EpochHeight)
+	require.Equal(t, expHeight, file.Header.SignedHeader.Header.Height)
+	require.Equal(t, expHeight, file.ConsensusState.Height.EpochHeight)
+	require.Equal(t, "/ibc.tendermint.ConsensusState", file.ConsensusState.Type)
+}
+
+func TestWriteClientCreationFileInconsistent(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+	coordinator.CommitNBlocks(chainA, 2)
+	coordinator.CommitNBlocks(chainB, 2)
+
+	header := chainA.LastHeader
+	path := filepath.Join(t.TempDir(), "client_creation.json")
+
+	testCases := []struct {
+		name     string
+		malleate func(*utils.ClientCreationData)
+	}{
+		{"chain-id mismatch", func(ccd *utils.ClientCreationData) { ccd.ChainID = chainB.ChainID }},
+		{"height mismatch", func(ccd *utils.ClientCreationData) { ccd.Height = types.NewHeight(0, header.GetHeight()+1) }},
+		{"consensus state height mismatch", func(ccd *utils.ClientCreationData) {
+			consensusState := header.ConsensusState()
+			consensusState.Height = types.NewHeight(0, header.GetHeight()+1)
+			ccd.ConsensusState = consensusState
+		}},
+		{"consensus state of another chain", func(ccd *utils.ClientCreationData) {
+			consensusState := chainB.LastHeader.ConsensusState()
+			consensusState.Height = types.NewHeight(0, header.GetHeight())
+			ccd.ConsensusState = consensusState
+		}},
+		{"empty header", func(ccd *utils.ClientCreationData) { ccd.Header = nil }},
+		{"empty consensus state", func(ccd *utils.ClientCreationData) { ccd.ConsensusState = nil }},
+	}
+
+	for _, tc := range testCases {
+		ccd := utils.NewClientCreationData(header, header.ConsensusState())
+		tc.malleate(&ccd)
+
+		require.Error(t, utils.WriteClientCreationFile(cdc, path, ccd), tc.name)
+
+		_, err := ioutil.ReadFile(path)
+		require.Error(t, err, "no file should be written for inconsistent data: %s", tc.name)
+	}
+}
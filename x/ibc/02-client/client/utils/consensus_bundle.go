@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ConsensusStateBundle is a consensus state of a client along with its merkle
+// proof and the height of the store the proof was retrieved at: all a relayer
+// needs to hand the consensus state over to the counterparty chain.
+type ConsensusStateBundle struct {
+	ClientID       string
+	Height         uint64
+	ConsensusState exported.ConsensusState
+	Proof          []byte
+	ProofHeight    uint64
+}
+
+// consensusStateBundleJSON is the JSON format of a consensus state bundle. The
+// consensus state is encoded as a JSON Any, including its type URL, and the proof
+// as base64.
+type consensusStateBundleJSON struct {
+	ClientID       string          `json:"client_id"`
+	Height         uint64          `json:"height"`
+	ConsensusState json.RawMessage `json:"consensus_state"`
+	Proof          []byte          `json:"proof"`
+	ProofHeight    uint64          `json:"proof_height"`
+}
+
+// ValidateBasic checks that the consensus state, the proof and the proof height
+// of the bundle are all set, and that the consensus state height matches the
+// height of the bundle.
+func (csb ConsensusStateBundle) ValidateBasic() error {
+	if csb.ConsensusState == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidConsensus, "consensus state of client %s cannot be empty", csb.ClientID)
+	}
+
+	if csb.ConsensusState.GetHeight() != csb.Height {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidConsensus, "consensus state height %d doesn't match the height %d", csb.ConsensusState.GetHeight(), csb.Height,
+		)
+	}
+
+	if len(csb.Proof) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "proof of the consensus state of client %s cannot be empty", csb.ClientID)
+	}
+
+	if csb.ProofHeight == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidHeight, "proof height cannot be zero")
+	}
+
+	return nil
+}
+
+// MarshalConsensusStateBundle returns the indented JSON encoding of the given
+// consensus state bundle.
+func MarshalConsensusStateBundle(cdc codec.JSONMarshaler, csb ConsensusStateBundle) ([]byte, error) {
+	any, err := types.PackConsensusState(csb.ConsensusState)
+	if err != nil {
+		return nil, err
+	}
+
+	anyBz, err := cdc.MarshalJSON(any)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(consensusStateBundleJSON{
+		ClientID:       csb.ClientID,
+		Height:         csb.Height,
+		ConsensusState: anyBz,
+		Proof:          csb.Proof,
+		ProofHeight:    csb.ProofHeight,
+	}, "", "  ")
+}
+
+// QueryConsensusStateBundle queries the consensus state of a client at the given
+// height along with its merkle proof at the latest height of the node's store.
+func QueryConsensusStateBundle(clientCtx client.Context, clientID string, height uint64) (ConsensusStateBundle, error) {
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	return QueryConsensusStateBundleWithQuerier(clientCtx.QueryABCI, cdc, clientID, height)
+}
+
+// QueryConsensusStateBundleWithQuerier queries the consensus state of a client at
+// the given height and its merkle proof using the provided ABCI querier, and
+// returns them as a bundle. An error is returned if the consensus state doesn't
+// exist or if any part of the bundle is missing.
+func QueryConsensusStateBundleWithQuerier(
+	query ABCIQuerier, cdc codec.Marshaler, clientID string, height uint64,
+) (ConsensusStateBundle, error) {
+	if height == 0 {
+		return ConsensusStateBundle{}, sdkerrors.Wrap(types.ErrInvalidHeight, "consensus state height cannot be zero")
+	}
+
+	res, err := query(abci.RequestQuery{
+		Path:  "store/ibc/key",
+		Data:  host.FullKeyClientPath(clientID, host.KeyConsensusState(height)),
+		Prove: true,
+	})
+	if err != nil {
+		return ConsensusStateBundle{}, err
+	}
+	if len(res.Value) == 0 {
+		return ConsensusStateBundle{}, sdkerrors.Wrapf(
+			types.ErrConsensusStateNotFound, "client-id: %s, height: %d", clientID, height,
+		)
+	}
+
+	consensusState, err := types.UnmarshalConsensusState(cdc, res.Value)
+	if err != nil {
+		return ConsensusStateBundle{}, err
+	}
+
+	proofBz, err := cdc.MarshalBinaryBare(res.ProofOps)
+	if err != nil {
+		return ConsensusStateBundle{}, err
+	}
+
+	bundle := ConsensusStateBundle{
+		ClientID:       clientID,
+		Height:         height,
+		ConsensusState: consensusState,
+		Proof:          proofBz,
+		ProofHeight:    uint64(res.Height),
+	}
+
+	if err := bundle.ValidateBasic(); err != nil {
+		return ConsensusStateBundle{}, err
+	}
+
+	return bundle, nil
+}
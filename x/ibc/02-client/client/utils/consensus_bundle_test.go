@@ -0,0 +1,81 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestQueryConsensusStateBundle(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	clientA, _ := coordinator.SetupClients(chainA, chainB, exported.Tendermint)
+
+	query := func(req abci.RequestQuery) (abci.ResponseQuery, error) {
+		res := chainA.App.Query(req)
+		if !res.IsOK() {
+			return res, errors.New(res.Log)
+		}
+		return res, nil
+	}
+	cdc := chainA.App.AppCodec()
+
+	clientState := chainA.GetClientState(clientA)
+	height := clientState.GetLatestHeight()
+	expConsensusState, found := chainA.GetConsensusState(clientA, height)
+	require.True(t, found)
+
+	bundle, err := utils.QueryConsensusStateBundleWithQuerier(query, cdc, clientA, height)
+	require.NoError(t, err)
+	require.Equal(t, clientA, bundle.ClientID)
+	require.Equal(t, height, bundle.Height)
+	require.Equal(t, expConsensusState, bundle.ConsensusState)
+	require.NotEmpty(t, bundle.Proof)
+	require.Equal(t, uint64(chainA.App.LastBlockHeight()), bundle.ProofHeight)
+
+	// the JSON bundle is self-contained
+	bz, err := utils.MarshalConsensusStateBundle(cdc, bundle)
+	require.NoError(t, err)
+
+	var bundleJSON struct {
+		ClientID       string `json:"client_id"`
+		ConsensusState struct {
+			Type string `json:"@type"`
+		} `json:"consensus_state"`
+		Proof       []byte `json:"proof"`
+		ProofHeight uint64 `json:"proof_height"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &bundleJSON))
+	require.Equal(t, clientA, bundleJSON.ClientID)
+	require.Equal(t, "/ibc.tendermint.ConsensusState", bundleJSON.ConsensusState.Type)
+	require.Equal(t, bundle.Proof, bundleJSON.Proof)
+	require.Equal(t, bundle.ProofHeight, bundleJSON.ProofHeight)
+
+	// no consensus state at the height
+	_, err = utils.QueryConsensusStateBundleWithQuerier(query, cdc, clientA, height+100)
+	require.Error(t, err)
+
+	_, err = utils.QueryConsensusStateBundleWithQuerier(query, cdc, clientA, 0)
+	require.Error(t, err)
+
+	// bundles missing any of their components are invalid
+	for _, malleate := range []func(*utils.ConsensusStateBundle){
+		func(b *utils.ConsensusStateBundle) { b.ConsensusState = nil },
+		func(b *utils.ConsensusStateBundle) { b.Proof = nil },
+		func(b *utils.ConsensusStateBundle) { b.ProofHeight = 0 },
+		func(b *utils.ConsensusStateBundle) { b.Height++ },
+	} {
+		invalid := bundle
+		malleate(&invalid)
+		require.Error(t, invalid.ValidateBasic())
+	}
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ConsensusStateCache is a bounded in-memory cache of consensus states indexed by
+// client identifier and height. Once full, the least recently used consensus
+// state is evicted. It is safe for concurrent use.
+type ConsensusStateCache struct {
+	cache *lru.Cache
+}
+
+// NewConsensusStateCache creates a new ConsensusStateCache holding at most size
+// consensus states. An error is returned if the size isn't positive.
+func NewConsensusStateCache(size int) (*ConsensusStateCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConsensusStateCache{cache: cache}, nil
+}
+
+// consensusStateCacheKey returns the cache key of the consensus state of a client
+// at a height. Client identifiers can't contain a '/', so keys never collide.
+func consensusStateCacheKey(clientID string, height types.Height) string {
+	return clientID + "/" + string(height.Bytes())
+}
+
+// Get returns the cached consensus state of the client at the given height, if
+// any, and marks it as the most recently used one.
+func (c *ConsensusStateCache) Get(clientID string, height types.Height) (exported.ConsensusState, bool) {
+	value, ok := c.cache.Get(consensusStateCacheKey(clientID, height))
+	if !ok {
+		return nil, false
+	}
+
+	return value.(exported.ConsensusState), true
+}
+
+// Put caches the consensus state of the client at the given height, evicting the
+// least recently used consensus state if the cache is full.
+func (c *ConsensusStateCache) Put(clientID string, height types.Height, consensusState exported.ConsensusState) {
+	c.cache.Add(consensusStateCacheKey(clientID, height), consensusState)
+}
+
+// Len returns the number of cached consensus states.
+func (c *ConsensusStateCache) Len() int {
+	return c.cache.Len()
+}
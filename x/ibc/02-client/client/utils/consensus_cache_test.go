@@ -0,0 +1,73 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+func TestConsensusStateCache(t *testing.T) {
+	_, err := utils.NewConsensusStateCache(0)
+	require.Error(t, err)
+
+	cache, err := utils.NewConsensusStateCache(2)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	consensusState1 := newConsensusState(1, now)
+	consensusState2 := newConsensusState(2, now.Add(time.Second))
+	consensusState3 := newConsensusState(3, now.Add(2*time.Second))
+
+	// miss on an empty cache
+	_, found := cache.Get("clientA", types.NewHeight(0, 1))
+	require.False(t, found)
+
+	cache.Put("clientA", types.NewHeight(0, 1), consensusState1)
+	cache.Put("clientA", types.NewHeight(0, 2), consensusState2)
+	require.Equal(t, 2, cache.Len())
+
+	// hit
+	consensusState, found := cache.Get("clientA", types.NewHeight(0, 1))
+	require.True(t, found)
+	require.Equal(t, consensusState1, consensusState)
+
+	// misses on another client, epoch or height
+	_, found = cache.Get("clientB", types.NewHeight(0, 1))
+	require.False(t, found)
+	_, found = cache.Get("clientA", types.NewHeight(1, 1))
+	require.False(t, found)
+	_, found = cache.Get("clientA", types.NewHeight(0, 3))
+	require.False(t, found)
+
+	// height 1 was used last, so height 2 is evicted
+	cache.Put("clientA", types.NewHeight(0, 3), consensusState3)
+	require.Equal(t, 2, cache.Len())
+
+	_, found = cache.Get("clientA", types.NewHeight(0, 2))
+	require.False(t, found)
+	consensusState, found = cache.Get("clientA", types.NewHeight(0, 1))
+	require.True(t, found)
+	require.Equal(t, consensusState1, consensusState)
+	consensusState, found = cache.Get("clientA", types.NewHeight(0, 3))
+	require.True(t, found)
+	require.Equal(t, consensusState3, consensusState)
+
+	// height 1 is now the least recently used, so adding another client evicts it
+	cache.Put("clientB", types.NewHeight(0, 1), consensusState2)
+	_, found = cache.Get("clientA", types.NewHeight(0, 1))
+	require.False(t, found)
+	consensusState, found = cache.Get("clientB", types.NewHeight(0, 1))
+	require.True(t, found)
+	require.Equal(t, consensusState2, consensusState)
+
+	// putting an existing key replaces it without growing the cache
+	cache.Put("clientB", types.NewHeight(0, 1), consensusState1)
+	require.Equal(t, 2, cache.Len())
+	consensusState, found = cache.Get("clientB", types.NewHeight(0, 1))
+	require.True(t, found)
+	require.Equal(t, consensusState1, consensusState)
+}
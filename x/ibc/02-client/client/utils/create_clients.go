@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// CreateClientSpec is the result of reading a client creation spec file. Msg is
+// only set if the spec is valid, otherwise Err describes why it was rejected.
+type CreateClientSpec struct {
+	File string
+	Msg  *types.MsgCreateClient
+	Err  error
+}
+
+// ReadCreateClientSpecs reads every *.json file in the given directory as a
+// MsgCreateClient in JSON format, sets its signer and validates it. The files are
+// read in lexical order and a failure on one file doesn't prevent the following
+// ones from being read. Specs creating a client already created by a previous spec
+// are rejected. An error is only returned if the directory doesn't contain any
+// spec.
+func ReadCreateClientSpecs(cdc codec.JSONMarshaler, dir string, signer sdk.AccAddress) ([]CreateClientSpec, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no client creation spec found in %s", dir)
+	}
+
+	specs := make([]CreateClientSpec, len(files))
+	clientIDs := make(map[string]string)
+
+	for i, file := range files {
+		specs[i].File = file
+
+		msg, err := readCreateClientSpec(cdc, file, signer)
+		if err != nil {
+			specs[i].Err = err
+			continue
+		}
+
+		if prev, ok := clientIDs[msg.ClientId]; ok {
+			specs[i].Err = fmt.Errorf("client %s is already created by %s", msg.ClientId, prev)
+			continue
+		}
+
+		clientIDs[msg.ClientId] = file
+		specs[i].Msg = msg
+	}
+
+	return specs, nil
+}
+
+func readCreateClientSpec(cdc codec.JSONMarshaler, file string, signer sdk.AccAddress) (*types.MsgCreateClient, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.MsgCreateClient{}
+	if err := cdc.UnmarshalJSON(bz, msg); err != nil {
+		return nil, fmt.Errorf("failed to decode client creation spec: %w", err)
+	}
+
+	msg.Signer = signer
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ValidCreateClientMsgs returns the messages of the valid client creation specs.
+func ValidCreateClientMsgs(specs []CreateClientSpec) []sdk.Msg {
+	var msgs []sdk.Msg
+	for _, spec := range specs {
+		if spec.Err == nil {
+			msgs = append(msgs, spec.Msg)
+		}
+	}
+
+	return msgs
+}
+
+// WriteCreateClientSpecsReport writes one line per client creation spec to w,
+// stating whether the spec is valid, followed by the number of valid specs.
+func WriteCreateClientSpecsReport(w io.Writer, specs []CreateClientSpec) error {
+	var valid int
+	for _, spec := range specs {
+		var err error
+		if spec.Err != nil {
+			_, err = fmt.Fprintf(w, "%s: FAILED: %s\n", spec.File, spec.Err)
+		} else {
+			valid++
+			_, err = fmt.Fprintf(w, "%s: OK: client %s\n", spec.File, spec.Msg.ClientId)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "%d/%d client creation specs are valid\n", valid, len(specs))
+	return err
+}
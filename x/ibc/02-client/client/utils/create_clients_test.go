@@ -0,0 +1,77 @@
+package utils_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestReadCreateClientSpecs(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	signer := sdk.AccAddress("signer")
+	dir := t.TempDir()
+
+	writeSpec := func(name, id, specChainID string) {
+		clientState := ibctmtypes.NewClientState(
+			specChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+			types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+		)
+		msg, err := types.NewMsgCreateClient(id, clientState, newConsensusState(10, time.Now().UTC()), nil)
+		require.NoError(t, err)
+
+		bz, err := cdc.MarshalJSON(msg)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), bz, 0600))
+	}
+
+	writeSpec("a.json", clientID, chainID)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b.json"), []byte("{not json"), 0600))
+	writeSpec("c.json", "otherclient", "") // invalid client state
+	writeSpec("d.json", clientID, chainID) // duplicate client identifier
+	writeSpec("e.json", "thirdclient", chainID)
+	// files without the .json extension are ignored
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("specs"), 0600))
+
+	specs, err := utils.ReadCreateClientSpecs(cdc, dir, signer)
+	require.NoError(t, err)
+	require.Len(t, specs, 5)
+
+	for i, expValid := range []bool{true, false, false, false, true} {
+		if expValid {
+			require.NoError(t, specs[i].Err, specs[i].File)
+			require.NotNil(t, specs[i].Msg)
+			require.Equal(t, signer, specs[i].Msg.Signer)
+		} else {
+			require.Error(t, specs[i].Err, specs[i].File)
+			require.Nil(t, specs[i].Msg)
+		}
+	}
+
+	msgs := utils.ValidCreateClientMsgs(specs)
+	require.Len(t, msgs, 2)
+	require.Equal(t, specs[0].Msg, msgs[0])
+	require.Equal(t, specs[4].Msg, msgs[1])
+
+	var report bytes.Buffer
+	require.NoError(t, utils.WriteCreateClientSpecsReport(&report, specs))
+	require.Contains(t, report.String(), filepath.Join(dir, "a.json")+": OK: client "+clientID)
+	require.Contains(t, report.String(), filepath.Join(dir, "b.json")+": FAILED")
+	require.Contains(t, report.String(), filepath.Join(dir, "d.json")+": FAILED: client "+clientID+" is already created")
+	require.Contains(t, report.String(), "2/5 client creation specs are valid")
+
+	// a directory without specs is an error
+	_, err = utils.ReadCreateClientSpecs(cdc, t.TempDir(), signer)
+	require.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// InferEpoch returns the epoch number of a height given without epoch, assuming
+// that the height belongs to the latest epoch of the client. Clients that don't
+// track epochs are always in epoch 0. An error is returned if the height is greater
+// than the latest height of a client past its first epoch, as the height must then
+// belong to an earlier epoch that can't be inferred.
+func InferEpoch(clientState exported.ClientState, height uint64) (uint64, error) {
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return 0, nil
+	}
+
+	latestHeight := tmClientState.LatestHeight
+	if latestHeight.EpochNumber != 0 && height > latestHeight.EpochHeight {
+		return 0, fmt.Errorf(
+			"height %d is greater than the latest client height %s and belongs to an earlier epoch, set it with the --%s flag",
+			height, latestHeight, FlagEpoch,
+		)
+	}
+
+	return latestHeight.EpochNumber, nil
+}
+
+// QueryInferEpoch queries the state of a client and infers the epoch number of a
+// height given without epoch, as described in InferEpoch.
+func QueryInferEpoch(clientCtx client.Context, clientID string, height uint64) (uint64, error) {
+	res, err := QueryClientState(clientCtx, clientID, false)
+	if err != nil {
+		return 0, err
+	}
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	if err != nil {
+		return 0, err
+	}
+
+	return InferEpoch(clientState, height)
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+)
+
+// EpochCount is the number of consensus states of a client in an epoch.
+type EpochCount struct {
+	EpochNumber uint64 `json:"epoch_number" yaml:"epoch_number"`
+	Count       int    `json:"count" yaml:"count"`
+}
+
+// String implements fmt.Stringer.
+func (ec EpochCount) String() string {
+	return fmt.Sprintf("epoch %d: %d consensus state(s)", ec.EpochNumber, ec.Count)
+}
+
+// EpochDistribution groups the given consensus states by the epoch number of their
+// height and returns the number of consensus states per epoch, ordered by epoch.
+// Consensus states that don't track epochs are counted in epoch 0.
+func EpochDistribution(anyConsensusStates []*codectypes.Any) ([]EpochCount, error) {
+	counts := make(map[uint64]int)
+	for _, anyConsensusState := range anyConsensusStates {
+		consensusState, err := types.UnpackConsensusState(anyConsensusState)
+		if err != nil {
+			return nil, err
+		}
+
+		var epoch uint64
+		if tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState); ok {
+			epoch = tmConsensusState.Height.EpochNumber
+		}
+		counts[epoch]++
+	}
+
+	distribution := make([]EpochCount, 0, len(counts))
+	for epoch, count := range counts {
+		distribution = append(distribution, EpochCount{EpochNumber: epoch, Count: count})
+	}
+
+	sort.Slice(distribution, func(i, j int) bool {
+		return distribution[i].EpochNumber < distribution[j].EpochNumber
+	})
+
+	return distribution, nil
+}
+
+// QueryEpochDistribution queries all the consensus states of a client and returns
+// the number of consensus states per epoch, as described in EpochDistribution.
+func QueryEpochDistribution(clientCtx client.Context, clientID string) ([]EpochCount, error) {
+	consensusStates, err := queryAllConsensusStates(clientCtx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return EpochDistribution(consensusStates)
+}
@@ -0,0 +1,79 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+)
+
+func TestEpochDistribution(t *testing.T) {
+	now := time.Now().UTC()
+
+	// packConsensusStates returns consensus states of a client at the given heights
+	packConsensusStates := func(heights []types.Height) []*codectypes.Any {
+		var consensusStates []*codectypes.Any
+		for _, height := range heights {
+			any, err := types.PackConsensusState(ibctmtypes.NewConsensusState(
+				now, commitmenttypes.NewMerkleRoot([]byte("root")), height, nil,
+			))
+			require.NoError(t, err)
+			consensusStates = append(consensusStates, any)
+		}
+		return consensusStates
+	}
+
+	testCases := []struct {
+		name            string
+		heights         []types.Height
+		expDistribution []utils.EpochCount
+	}{
+		{"no consensus states", nil, []utils.EpochCount{}},
+		{
+			"single epoch",
+			[]types.Height{types.NewHeight(0, 1), types.NewHeight(0, 2), types.NewHeight(0, 5)},
+			[]utils.EpochCount{{EpochNumber: 0, Count: 3}},
+		},
+		{
+			"several epochs",
+			[]types.Height{
+				types.NewHeight(0, 1), types.NewHeight(0, 2),
+				types.NewHeight(1, 1), types.NewHeight(1, 3), types.NewHeight(1, 7),
+				types.NewHeight(3, 2),
+			},
+			[]utils.EpochCount{{EpochNumber: 0, Count: 2}, {EpochNumber: 1, Count: 3}, {EpochNumber: 3, Count: 1}},
+		},
+		{
+			"epochs ordered by number",
+			[]types.Height{types.NewHeight(4, 1), types.NewHeight(2, 8), types.NewHeight(4, 2), types.NewHeight(2, 1)},
+			[]utils.EpochCount{{EpochNumber: 2, Count: 2}, {EpochNumber: 4, Count: 2}},
+		},
+	}
+
+	for _, tc := range testCases {
+		distribution, err := utils.EpochDistribution(packConsensusStates(tc.heights))
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expDistribution, distribution, tc.name)
+	}
+
+	// consensus states that don't track epochs are in epoch 0
+	any, err := types.PackConsensusState(&solomachinetypes.ConsensusState{Sequence: 10, Timestamp: 1})
+	require.NoError(t, err)
+
+	distribution, err := utils.EpochDistribution([]*codectypes.Any{any})
+	require.NoError(t, err)
+	require.Equal(t, []utils.EpochCount{{EpochNumber: 0, Count: 1}}, distribution)
+
+	// consensus states that can't be unpacked are rejected
+	_, err = utils.EpochDistribution([]*codectypes.Any{{}})
+	require.Error(t, err)
+
+	require.Equal(t, "epoch 1: 3 consensus state(s)", utils.EpochCount{EpochNumber: 1, Count: 3}.String())
+}
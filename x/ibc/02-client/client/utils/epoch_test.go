@@ -0,0 +1,60 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestInferEpoch(t *testing.T) {
+	newClientState := func(latestHeight types.Height) *ibctmtypes.ClientState {
+		return ibctmtypes.NewClientState(
+			chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+			latestHeight, commitmenttypes.GetSDKSpecs(),
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		clientState exported.ClientState
+		height      uint64
+		expEpoch    uint64
+		expPass     bool
+	}{
+		{"first epoch", newClientState(types.NewHeight(0, 10)), 5, 0, true},
+		{"first epoch above latest height", newClientState(types.NewHeight(0, 10)), 15, 0, true},
+		{"inferred latest epoch", newClientState(types.NewHeight(2, 10)), 5, 2, true},
+		{"inferred latest epoch at latest height", newClientState(types.NewHeight(2, 10)), 10, 2, true},
+		{"multi-epoch height above latest height", newClientState(types.NewHeight(2, 10)), 11, 0, false},
+		{"client without epochs", localhosttypes.NewClientState(chainID, types.NewHeight(0, 10)), 5, 0, true},
+	}
+
+	for _, tc := range testCases {
+		epoch, err := utils.InferEpoch(tc.clientState, tc.height)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expEpoch, epoch, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	// inference is opt-in on the consensus state query
+	cmd, _, err := cli.GetQueryCmd().Find([]string{"consensus-state"})
+	require.NoError(t, err)
+	require.NoError(t, cmd.ParseFlags(nil))
+
+	inferEpoch, err := cmd.Flags().GetBool(utils.FlagInferEpoch)
+	require.NoError(t, err)
+	require.False(t, inferEpoch)
+}
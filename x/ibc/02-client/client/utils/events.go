@@ -0,0 +1,221 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// NewClientsSubscriber is the subscriber name used when subscribing to client
+// creation events.
+const NewClientsSubscriber = "ibc-client-watcher"
+
+// NewClientsQuery is the Tendermint event query matching the transactions that
+// create IBC clients.
+var NewClientsQuery = fmt.Sprintf(
+	"%s='%s' AND %s.%s EXISTS",
+	tmtypes.EventTypeKey, tmtypes.EventTx, types.EventTypeCreateClient, types.AttributeKeyClientID,
+)
+
+// NewClientEvent defines the data emitted by the chain when a client is created.
+type NewClientEvent struct {
+	ClientID        string `json:"client_id" yaml:"client_id"`
+	ClientType      string `json:"client_type" yaml:"client_type"`
+	ConsensusHeight string `json:"consensus_height" yaml:"consensus_height"`
+}
+
+// String implements the fmt.Stringer interface.
+func (e NewClientEvent) String() string {
+	return fmt.Sprintf("client_id: %s, client_type: %s, consensus_height: %s", e.ClientID, e.ClientType, e.ConsensusHeight)
+}
+
+// ParseNewClientEvents returns all the client creation events contained in the
+// given Tendermint event. A single transaction may create several clients.
+func ParseNewClientEvents(event ctypes.ResultEvent) []NewClientEvent {
+	attr := func(key string) []string {
+		return event.Events[fmt.Sprintf("%s.%s", types.EventTypeCreateClient, key)]
+	}
+
+	clientIDs := attr(types.AttributeKeyClientID)
+	clientTypes := attr(types.AttributeKeyClientType)
+	heights := attr(types.AttributeKeyConsensusHeight)
+
+	newClients := make([]NewClientEvent, len(clientIDs))
+	for i, clientID := range clientIDs {
+		newClients[i] = NewClientEvent{ClientID: clientID}
+		if i < len(clientTypes) {
+			newClients[i].ClientType = clientTypes[i]
+		}
+		if i < len(heights) {
+			newClients[i].ConsensusHeight = heights[i]
+		}
+	}
+
+	return newClients
+}
+
+// WatchNewClients subscribes to the client creation events of the node and calls
+// the callback for every client created. If the subscription fails or the event
+// stream is closed, it resubscribes after the given retry interval. It only
+// returns once the context is done.
+func WatchNewClients(
+	ctx context.Context, eventsClient rpcclient.EventsClient, retryInterval time.Duration, cb func(NewClientEvent) error,
+) error {
+	return watchEvents(ctx, eventsClient, NewClientsSubscriber, []string{NewClientsQuery}, retryInterval, func(event ctypes.ResultEvent) error {
+		for _, newClient := range ParseNewClientEvents(event) {
+			if err := cb(newClient); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ClientFreezesSubscriber is the subscriber name used when subscribing to client
+// freeze events.
+const ClientFreezesSubscriber = "ibc-client-freeze-watcher"
+
+// ClientFreezesQueries are the Tendermint event queries matching the transactions
+// that freeze IBC clients, either on misbehaviour or manually. The event query
+// language has no disjunction, so each event type is subscribed to separately.
+var ClientFreezesQueries = []string{
+	fmt.Sprintf(
+		"%s='%s' AND %s.%s EXISTS",
+		tmtypes.EventTypeKey, tmtypes.EventTx, types.EventTypeSubmitMisbehaviour, types.AttributeKeyClientID,
+	),
+	fmt.Sprintf(
+		"%s='%s' AND %s.%s EXISTS",
+		tmtypes.EventTypeKey, tmtypes.EventTx, types.EventTypeFreezeClient, types.AttributeKeyClientID,
+	),
+}
+
+// ClientFreezeEvent defines the data emitted by the chain when a client is frozen.
+// The event type tells whether the client was frozen on misbehaviour or manually.
+type ClientFreezeEvent struct {
+	ClientID     string `json:"client_id" yaml:"client_id"`
+	FrozenHeight string `json:"frozen_height" yaml:"frozen_height"`
+	EventType    string `json:"event_type" yaml:"event_type"`
+}
+
+// String implements the fmt.Stringer interface.
+func (e ClientFreezeEvent) String() string {
+	return fmt.Sprintf("client_id: %s, frozen_height: %s, event_type: %s", e.ClientID, e.FrozenHeight, e.EventType)
+}
+
+// ParseClientFreezeEvents returns all the client freeze events contained in the
+// given Tendermint event, misbehaviour events first. The frozen height is the
+// height of the misbehaviour, or the height given to the manual freeze.
+func ParseClientFreezeEvents(event ctypes.ResultEvent) []ClientFreezeEvent {
+	var freezes []ClientFreezeEvent
+	for _, eventType := range []string{types.EventTypeSubmitMisbehaviour, types.EventTypeFreezeClient} {
+		clientIDs := event.Events[fmt.Sprintf("%s.%s", eventType, types.AttributeKeyClientID)]
+		heights := event.Events[fmt.Sprintf("%s.%s", eventType, types.AttributeKeyConsensusHeight)]
+
+		for i, clientID := range clientIDs {
+			freeze := ClientFreezeEvent{ClientID: clientID, EventType: eventType}
+			if i < len(heights) {
+				freeze.FrozenHeight = heights[i]
+			}
+			freezes = append(freezes, freeze)
+		}
+	}
+
+	return freezes
+}
+
+// WatchClientFreezes subscribes to the client freeze events of the node and calls
+// the callback for every client frozen. If a subscription fails or an event
+// stream is closed, it resubscribes after the given retry interval. It only
+// returns once the context is done.
+func WatchClientFreezes(
+	ctx context.Context, eventsClient rpcclient.EventsClient, retryInterval time.Duration, cb func(ClientFreezeEvent) error,
+) error {
+	return watchEvents(ctx, eventsClient, ClientFreezesSubscriber, ClientFreezesQueries, retryInterval, func(event ctypes.ResultEvent) error {
+		for _, freeze := range ParseClientFreezeEvents(event) {
+			if err := cb(freeze); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// watchEvents subscribes to the events of the node matching the given queries and
+// handles every event received. If a subscription fails or an event stream is
+// closed, all the queries are resubscribed to after the given retry interval. It
+// only returns once the context is done or the handler fails.
+func watchEvents(
+	ctx context.Context, eventsClient rpcclient.EventsClient, subscriber string, queries []string,
+	retryInterval time.Duration, handle func(ctypes.ResultEvent) error,
+) error {
+	for {
+		streams, err := subscribeEvents(ctx, eventsClient, subscriber, queries)
+		if err == nil {
+			err = consumeEvents(ctx, streams, handle)
+			unsubscribeEvents(eventsClient, subscriber, queries)
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// subscribeEvents subscribes to all the given queries. If a subscription fails,
+// the queries already subscribed to are unsubscribed from.
+func subscribeEvents(
+	ctx context.Context, eventsClient rpcclient.EventsClient, subscriber string, queries []string,
+) ([]<-chan ctypes.ResultEvent, error) {
+	streams := make([]<-chan ctypes.ResultEvent, len(queries))
+	for i, query := range queries {
+		events, err := eventsClient.Subscribe(ctx, subscriber, query)
+		if err != nil {
+			unsubscribeEvents(eventsClient, subscriber, queries[:i])
+			return nil, err
+		}
+		streams[i] = events
+	}
+
+	return streams, nil
+}
+
+// unsubscribeEvents unsubscribes from all the given queries.
+func unsubscribeEvents(eventsClient rpcclient.EventsClient, subscriber string, queries []string) {
+	for _, query := range queries {
+		// ignore the error as the subscription might already be gone on stream drop
+		_ = eventsClient.Unsubscribe(context.Background(), subscriber, query)
+	}
+}
+
+// consumeEvents reads the event streams until one of them is closed or the
+// context is done. Only handler errors are returned.
+func consumeEvents(ctx context.Context, streams []<-chan ctypes.ResultEvent, handle func(ctypes.ResultEvent) error) error {
+	cases := make([]reflect.SelectCase, len(streams)+1)
+	cases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+	for i, events := range streams {
+		cases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(events)}
+	}
+
+	for {
+		chosen, value, ok := reflect.Select(cases)
+		if chosen == 0 || !ok {
+			return nil
+		}
+
+		if err := handle(value.Interface().(ctypes.ResultEvent)); err != nil {
+			return err
+		}
+	}
+}
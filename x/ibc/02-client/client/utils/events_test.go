@@ -0,0 +1,259 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// mockEventStream replays a batch of events per subscription and closes the
+// stream afterwards to simulate a dropped connection.
+type mockEventStream struct {
+	mtx           sync.Mutex
+	batches       [][]ctypes.ResultEvent
+	subscriptions int
+	failures      int
+}
+
+func (m *mockEventStream) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if query != utils.NewClientsQuery {
+		return nil, fmt.Errorf("unexpected query %s", query)
+	}
+
+	if m.failures > 0 {
+		m.failures--
+		return nil, errors.New("connection refused")
+	}
+
+	out := make(chan ctypes.ResultEvent)
+	if m.subscriptions >= len(m.batches) {
+		// keep the stream open without emitting anything
+		return out, nil
+	}
+
+	batch := m.batches[m.subscriptions]
+	m.subscriptions++
+
+	go func() {
+		for _, event := range batch {
+			out <- event
+		}
+		close(out)
+	}()
+
+	return out, nil
+}
+
+func (m *mockEventStream) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (m *mockEventStream) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func newCreateClientEvent(clientIDs ...string) ctypes.ResultEvent {
+	key := func(attr string) string {
+		return types.EventTypeCreateClient + "." + attr
+	}
+
+	events := make(map[string][]string)
+	for i, clientID := range clientIDs {
+		events[key(types.AttributeKeyClientID)] = append(events[key(types.AttributeKeyClientID)], clientID)
+		events[key(types.AttributeKeyClientType)] = append(events[key(types.AttributeKeyClientType)], "tendermint")
+		events[key(types.AttributeKeyConsensusHeight)] = append(events[key(types.AttributeKeyConsensusHeight)], fmt.Sprintf("%d", i+1))
+	}
+
+	return ctypes.ResultEvent{Query: utils.NewClientsQuery, Events: events}
+}
+
+func TestWatchNewClients(t *testing.T) {
+	stream := &mockEventStream{
+		batches: [][]ctypes.ResultEvent{
+			{newCreateClientEvent("clienta"), newCreateClientEvent("clientb", "clientc")},
+			{newCreateClientEvent("clientd")},
+		},
+		failures: 1,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var newClients []utils.NewClientEvent
+	err := utils.WatchNewClients(ctx, stream, time.Millisecond, func(newClient utils.NewClientEvent) error {
+		newClients = append(newClients, newClient)
+		if len(newClients) == 4 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []utils.NewClientEvent{
+		{ClientID: "clienta", ClientType: "tendermint", ConsensusHeight: "1"},
+		{ClientID: "clientb", ClientType: "tendermint", ConsensusHeight: "1"},
+		{ClientID: "clientc", ClientType: "tendermint", ConsensusHeight: "2"},
+		{ClientID: "clientd", ClientType: "tendermint", ConsensusHeight: "1"},
+	}, newClients)
+	require.Equal(t, 2, stream.subscriptions)
+}
+
+func TestWatchNewClientsCallbackError(t *testing.T) {
+	stream := &mockEventStream{
+		batches: [][]ctypes.ResultEvent{{newCreateClientEvent("clienta")}},
+	}
+
+	err := utils.WatchNewClients(context.Background(), stream, time.Millisecond, func(utils.NewClientEvent) error {
+		return errors.New("failed to print")
+	})
+	require.Error(t, err)
+}
+
+// mockQueryEventStream replays a batch of events per subscription of each query
+// and closes the stream afterwards to simulate a dropped connection. The stream
+// of a query stays open without emitting anything once its batches are exhausted
+// or when its batch is nil.
+type mockQueryEventStream struct {
+	mtx           sync.Mutex
+	batches       map[string][][]ctypes.ResultEvent
+	subscriptions map[string]int
+	failures      int
+}
+
+func (m *mockQueryEventStream) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	batches, ok := m.batches[query]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %s", query)
+	}
+
+	if m.failures > 0 {
+		m.failures--
+		return nil, errors.New("connection refused")
+	}
+
+	out := make(chan ctypes.ResultEvent)
+	n := m.subscriptions[query]
+	m.subscriptions[query]++
+
+	if n >= len(batches) || batches[n] == nil {
+		return out, nil
+	}
+
+	go func() {
+		for _, event := range batches[n] {
+			out <- event
+		}
+		close(out)
+	}()
+
+	return out, nil
+}
+
+func (m *mockQueryEventStream) Unsubscribe(context.Context, string, string) error {
+	return nil
+}
+
+func (m *mockQueryEventStream) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func newFreezeEvent(eventType string, clientIDs ...string) ctypes.ResultEvent {
+	key := func(attr string) string {
+		return eventType + "." + attr
+	}
+
+	events := make(map[string][]string)
+	for i, clientID := range clientIDs {
+		events[key(types.AttributeKeyClientID)] = append(events[key(types.AttributeKeyClientID)], clientID)
+		events[key(types.AttributeKeyConsensusHeight)] = append(events[key(types.AttributeKeyConsensusHeight)], fmt.Sprintf("%d", i+10))
+	}
+
+	return ctypes.ResultEvent{Events: events}
+}
+
+func TestParseClientFreezeEvents(t *testing.T) {
+	event := newFreezeEvent(types.EventTypeSubmitMisbehaviour, "clienta", "clientb")
+	for key, values := range newFreezeEvent(types.EventTypeFreezeClient, "clientc").Events {
+		event.Events[key] = values
+	}
+
+	require.Equal(t, []utils.ClientFreezeEvent{
+		{ClientID: "clienta", FrozenHeight: "10", EventType: types.EventTypeSubmitMisbehaviour},
+		{ClientID: "clientb", FrozenHeight: "11", EventType: types.EventTypeSubmitMisbehaviour},
+		{ClientID: "clientc", FrozenHeight: "10", EventType: types.EventTypeFreezeClient},
+	}, utils.ParseClientFreezeEvents(event))
+
+	// client creations aren't freezes
+	require.Empty(t, utils.ParseClientFreezeEvents(newCreateClientEvent("clienta")))
+}
+
+func TestWatchClientFreezes(t *testing.T) {
+	misbehaviourQuery, freezeQuery := utils.ClientFreezesQueries[0], utils.ClientFreezesQueries[1]
+
+	// the misbehaviour stream is dropped first, then the freeze stream
+	stream := &mockQueryEventStream{
+		batches: map[string][][]ctypes.ResultEvent{
+			misbehaviourQuery: {
+				{newFreezeEvent(types.EventTypeSubmitMisbehaviour, "clienta"), newFreezeEvent(types.EventTypeSubmitMisbehaviour, "clientb")},
+			},
+			freezeQuery: {
+				nil,
+				{newFreezeEvent(types.EventTypeFreezeClient, "clientc")},
+			},
+		},
+		subscriptions: make(map[string]int),
+		failures:      1,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var freezes []utils.ClientFreezeEvent
+	err := utils.WatchClientFreezes(ctx, stream, time.Millisecond, func(freeze utils.ClientFreezeEvent) error {
+		freezes = append(freezes, freeze)
+		if len(freezes) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []utils.ClientFreezeEvent{
+		{ClientID: "clienta", FrozenHeight: "10", EventType: types.EventTypeSubmitMisbehaviour},
+		{ClientID: "clientb", FrozenHeight: "10", EventType: types.EventTypeSubmitMisbehaviour},
+		{ClientID: "clientc", FrozenHeight: "10", EventType: types.EventTypeFreezeClient},
+	}, freezes)
+
+	// both queries are resubscribed to after each drop
+	require.Equal(t, map[string]int{misbehaviourQuery: 2, freezeQuery: 2}, stream.subscriptions)
+}
+
+func TestWatchClientFreezesCallbackError(t *testing.T) {
+	stream := &mockQueryEventStream{
+		batches: map[string][][]ctypes.ResultEvent{
+			utils.ClientFreezesQueries[0]: {{newFreezeEvent(types.EventTypeSubmitMisbehaviour, "clienta")}},
+			utils.ClientFreezesQueries[1]: nil,
+		},
+		subscriptions: make(map[string]int),
+	}
+
+	err := utils.WatchClientFreezes(context.Background(), stream, time.Millisecond, func(utils.ClientFreezeEvent) error {
+		return errors.New("failed to print")
+	})
+	require.Error(t, err)
+}
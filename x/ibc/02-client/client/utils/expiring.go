@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ClientExpiry is the time at which the trusting period of a client elapses
+// since the timestamp of its latest consensus state.
+type ClientExpiry struct {
+	ClientID  string    `json:"client_id" yaml:"client_id"`
+	ExpiresAt time.Time `json:"expires_at" yaml:"expires_at"`
+}
+
+// NewClientExpiry returns the expiry of a client given its latest consensus
+// state. It returns false if the client has no trusting period.
+func NewClientExpiry(clientID string, clientState exported.ClientState, latest exported.ConsensusState) (ClientExpiry, bool) {
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return ClientExpiry{}, false
+	}
+
+	return ClientExpiry{
+		ClientID:  clientID,
+		ExpiresAt: time.Unix(0, int64(latest.GetTimestamp())).Add(tmClientState.TrustingPeriod).UTC(),
+	}, true
+}
+
+// ExpiringClients returns the clients that will have expired by the given time,
+// ordered by expiry time.
+func ExpiringClients(expiries []ClientExpiry, at time.Time) []ClientExpiry {
+	expiring := []ClientExpiry{}
+	for _, expiry := range expiries {
+		if !expiry.ExpiresAt.After(at) {
+			expiring = append(expiring, expiry)
+		}
+	}
+
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ExpiresAt.Before(expiring[j].ExpiresAt)
+	})
+
+	return expiring
+}
+
+// ParseExpiryTime returns the time at which to check the client expiries, given
+// either an RFC3339 timestamp or an interval from now. Exactly one of them must
+// be provided, a zero interval meaning that it's not set.
+func ParseExpiryTime(timestamp string, in time.Duration, now time.Time) (time.Time, error) {
+	switch {
+	case timestamp != "" && in != 0:
+		return time.Time{}, errors.New("a timestamp and an interval cannot be both provided")
+	case timestamp != "":
+		at, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("expected RFC3339 timestamp, got: %s", timestamp)
+		}
+		return at, nil
+	case in < 0:
+		return time.Time{}, fmt.Errorf("interval cannot be negative, got: %s", in)
+	case in > 0:
+		return now.Add(in), nil
+	default:
+		return time.Time{}, errors.New("either a timestamp or an interval must be provided")
+	}
+}
+
+// QueryExpiringClients queries the states of all the clients of the chain along
+// with their latest consensus state and returns the clients that will have
+// expired by the given time. Clients without a trusting period are ignored.
+func QueryExpiringClients(clientCtx client.Context, at time.Time) ([]ClientExpiry, error) {
+	clientStates, err := queryAllClientStates(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiries []ClientExpiry
+	for _, identifiedClientState := range clientStates {
+		clientState, err := types.UnpackClientState(identifiedClientState.ClientState)
+		if err != nil {
+			return nil, err
+		}
+
+		if clientState.ClientType() != exported.Tendermint {
+			continue
+		}
+
+		res, err := QueryConsensusState(clientCtx, identifiedClientState.ClientId, 0, false, true)
+		if err != nil {
+			return nil, err
+		}
+
+		latest, err := types.UnpackConsensusState(res.ConsensusState)
+		if err != nil {
+			return nil, err
+		}
+
+		if expiry, ok := NewClientExpiry(identifiedClientState.ClientId, clientState, latest); ok {
+			expiries = append(expiries, expiry)
+		}
+	}
+
+	return ExpiringClients(expiries, at), nil
+}
@@ -0,0 +1,78 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestExpiringClients(t *testing.T) {
+	at := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+	)
+
+	newExpiry := func(id string, expiresAt time.Time) utils.ClientExpiry {
+		// the latest consensus state was stored a trusting period before the expiry
+		expiry, ok := utils.NewClientExpiry(id, clientState, newConsensusState(10, expiresAt.Add(-ibctesting.TrustingPeriod)))
+		require.True(t, ok)
+		require.Equal(t, expiresAt, expiry.ExpiresAt)
+		return expiry
+	}
+
+	expiries := []utils.ClientExpiry{
+		newExpiry("clientida", at.Add(time.Hour)),       // still valid
+		newExpiry("clientidb", at),                      // expires exactly at the time
+		newExpiry("clientidc", at.Add(-24*time.Hour)),   // already expired
+		newExpiry("clientidd", at.Add(-time.Minute)),    // expires just before the time
+		newExpiry("clientide", at.Add(30*24*time.Hour)), // valid for a long time
+	}
+
+	expiring := utils.ExpiringClients(expiries, at)
+	require.Equal(t, []utils.ClientExpiry{expiries[2], expiries[3], expiries[1]}, expiring)
+
+	require.Empty(t, utils.ExpiringClients(expiries, at.Add(-48*time.Hour)))
+	require.Len(t, utils.ExpiringClients(expiries, at.Add(365*24*time.Hour)), len(expiries))
+
+	// clients without a trusting period never expire
+	_, ok := utils.NewClientExpiry("localhost", localhosttypes.NewClientState(chainID, types.NewHeight(0, 10)), newConsensusState(10, at))
+	require.False(t, ok)
+}
+
+func TestParseExpiryTime(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		timestamp string
+		in        time.Duration
+		expTime   time.Time
+		expPass   bool
+	}{
+		{"timestamp", "2020-06-02T12:00:00Z", 0, time.Date(2020, 6, 2, 12, 0, 0, 0, time.UTC), true},
+		{"interval", "", 24 * time.Hour, now.Add(24 * time.Hour), true},
+		{"invalid timestamp", "tomorrow", 0, time.Time{}, false},
+		{"negative interval", "", -time.Hour, time.Time{}, false},
+		{"both timestamp and interval", "2020-06-02T12:00:00Z", time.Hour, time.Time{}, false},
+		{"neither timestamp nor interval", "", 0, time.Time{}, false},
+	}
+
+	for _, tc := range testCases {
+		at, err := utils.ParseExpiryTime(tc.timestamp, tc.in, now)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.True(t, tc.expTime.Equal(at), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
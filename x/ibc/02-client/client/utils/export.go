@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ExportedConsensusState is a consensus state of a client exported to a file, so
+// that it can be handed to the counterparty chain.
+type ExportedConsensusState struct {
+	ClientID       string
+	Height         types.Height
+	ConsensusState exported.ConsensusState
+}
+
+// exportedConsensusStateJSON is the file format of an exported consensus state.
+// The consensus state is encoded as a JSON Any, including its type URL.
+type exportedConsensusStateJSON struct {
+	ClientID       string          `json:"client_id"`
+	Height         types.Height    `json:"height"`
+	ConsensusState json.RawMessage `json:"consensus_state"`
+}
+
+// NewExportedConsensusState returns the export of a client consensus state. The
+// height is the height of the consensus state.
+func NewExportedConsensusState(clientID string, consensusState exported.ConsensusState) ExportedConsensusState {
+	return ExportedConsensusState{
+		ClientID: clientID,
+		// for now, assume the epoch number of the consensus state is zero
+		Height:         types.NewHeight(0, consensusState.GetHeight()),
+		ConsensusState: consensusState,
+	}
+}
+
+// ValidateBasic checks that the client identifier is valid, that the consensus
+// state is valid and that its height matches the exported height.
+func (ecs ExportedConsensusState) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(ecs.ClientID); err != nil {
+		return err
+	}
+
+	if ecs.ConsensusState == nil {
+		return fmt.Errorf("consensus state of client %s cannot be empty", ecs.ClientID)
+	}
+
+	if err := ecs.ConsensusState.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if height := types.NewHeight(0, ecs.ConsensusState.GetHeight()); !ecs.Height.EQ(height) {
+		return fmt.Errorf("height %s doesn't match the consensus state height %s", ecs.Height, height)
+	}
+
+	return nil
+}
+
+// WriteConsensusStateFile writes the exported consensus state in JSON format to
+// the file at the given path.
+func WriteConsensusStateFile(cdc codec.JSONMarshaler, path string, ecs ExportedConsensusState) error {
+	if err := ecs.ValidateBasic(); err != nil {
+		return err
+	}
+
+	any, err := types.PackConsensusState(ecs.ConsensusState)
+	if err != nil {
+		return err
+	}
+
+	anyBz, err := cdc.MarshalJSON(any)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(exportedConsensusStateJSON{
+		ClientID:       ecs.ClientID,
+		Height:         ecs.Height,
+		ConsensusState: anyBz,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// ReadConsensusStateFile reads an exported consensus state from the JSON file at
+// the given path and validates it.
+func ReadConsensusStateFile(cdc codec.Marshaler, path string) (ExportedConsensusState, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ExportedConsensusState{}, err
+	}
+
+	var ecsJSON exportedConsensusStateJSON
+	if err := json.Unmarshal(bz, &ecsJSON); err != nil {
+		return ExportedConsensusState{}, fmt.Errorf("failed to decode consensus state file %s: %w", path, err)
+	}
+
+	any := &codectypes.Any{}
+	if err := cdc.UnmarshalJSON(ecsJSON.ConsensusState, any); err != nil {
+		return ExportedConsensusState{}, fmt.Errorf("failed to decode consensus state in file %s: %w", path, err)
+	}
+
+	var consensusState exported.ConsensusState
+	if err := cdc.UnpackAny(any, &consensusState); err != nil {
+		return ExportedConsensusState{}, err
+	}
+
+	ecs := ExportedConsensusState{
+		ClientID:       ecsJSON.ClientID,
+		Height:         ecsJSON.Height,
+		ConsensusState: consensusState,
+	}
+
+	if err := ecs.ValidateBasic(); err != nil {
+		return ExportedConsensusState{}, err
+	}
+
+	return ecs, nil
+}
+
+// QueryLatestExportedConsensusState queries the latest consensus state of a
+// client and returns its export.
+func QueryLatestExportedConsensusState(clientCtx client.Context, clientID string) (ExportedConsensusState, error) {
+	res, err := QueryConsensusState(clientCtx, clientID, 0, false, true)
+	if err != nil {
+		return ExportedConsensusState{}, err
+	}
+
+	consensusState, err := types.UnpackConsensusState(res.ConsensusState)
+	if err != nil {
+		return ExportedConsensusState{}, err
+	}
+
+	return NewExportedConsensusState(clientID, consensusState), nil
+}
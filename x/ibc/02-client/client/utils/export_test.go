@@ -0,0 +1,93 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestConsensusStateFileRoundTrip(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	solomachine := ibctesting.NewSolomachine(t, "solomachine")
+
+	testCases := []struct {
+		name string
+		ecs  utils.ExportedConsensusState
+	}{
+		{"tendermint consensus state", utils.NewExportedConsensusState(clientID, newConsensusState(10, time.Now().UTC()))},
+		{"solo machine consensus state", utils.NewExportedConsensusState(clientID, solomachine.ConsensusState())},
+	}
+
+	for _, tc := range testCases {
+		path := filepath.Join(t.TempDir(), "consensus_state.json")
+
+		require.NoError(t, utils.WriteConsensusStateFile(cdc, path, tc.ecs), tc.name)
+
+		ecs, err := utils.ReadConsensusStateFile(cdc, path)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.ecs, ecs, tc.name)
+	}
+}
+
+func TestWriteConsensusStateFileInvalid(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	path := filepath.Join(t.TempDir(), "consensus_state.json")
+
+	ecs := utils.NewExportedConsensusState("id", newConsensusState(10, time.Now().UTC()))
+	require.Error(t, utils.WriteConsensusStateFile(cdc, path, ecs))
+
+	ecs = utils.NewExportedConsensusState(clientID, newConsensusState(10, time.Now().UTC()))
+	ecs.Height = types.NewHeight(0, 11)
+	require.Error(t, utils.WriteConsensusStateFile(cdc, path, ecs))
+
+	_, err := ioutil.ReadFile(path)
+	require.Error(t, err, "no file should be written for an invalid export")
+}
+
+func TestReadConsensusStateFileInvalid(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "consensus_state.json")
+	ecs := utils.NewExportedConsensusState(clientID, newConsensusState(10, time.Now().UTC()))
+	require.NoError(t, utils.WriteConsensusStateFile(cdc, path, ecs))
+
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	// change the exported height so that it doesn't match the consensus state
+	var raw map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &raw))
+	raw["height"] = json.RawMessage(`"0-11"`)
+	bz, err = json.Marshal(raw)
+	require.NoError(t, err)
+
+	mismatchPath := filepath.Join(dir, "mismatch.json")
+	require.NoError(t, ioutil.WriteFile(mismatchPath, bz, 0600))
+
+	malformedPath := filepath.Join(dir, "malformed.json")
+	require.NoError(t, ioutil.WriteFile(malformedPath, []byte(`{"client_id":`), 0600))
+
+	testCases := []struct {
+		name string
+		path string
+	}{
+		{"file not found", filepath.Join(dir, "missing.json")},
+		{"malformed file", malformedPath},
+		{"height mismatch", mismatchPath},
+	}
+
+	for _, tc := range testCases {
+		_, err := utils.ReadConsensusStateFile(cdc, tc.path)
+		require.Error(t, err, tc.name)
+	}
+}
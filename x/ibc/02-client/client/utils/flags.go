@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	// FlagNoProve defines the flag that disables proofs for all the client queries,
+	// overriding the value of their --prove flag.
+	FlagNoProve = "no-prove"
+	// FlagEpoch defines the flag setting the epoch number of a height argument.
+	FlagEpoch = "epoch"
+	// FlagInferEpoch defines the flag inferring the epoch number of a height argument
+	// from the latest height of the client when the --epoch flag is not set.
+	FlagInferEpoch = "infer-epoch"
+)
+
+// ReadProveFlag returns whether a query should fetch proofs. It returns false if
+// the --no-prove flag is set, regardless of the --prove flag. Otherwise it returns
+// the value of the --prove flag, or false if the command doesn't define it.
+func ReadProveFlag(flagSet *pflag.FlagSet) bool {
+	if noProve, _ := flagSet.GetBool(FlagNoProve); noProve {
+		return false
+	}
+
+	prove, _ := flagSet.GetBool(flags.FlagProve)
+	return prove
+}
+
+// ReadEpochFlag returns the epoch number set with the --epoch flag, or 0 if the
+// command doesn't define it. The flag is parsed as an unsigned integer so that
+// negative epochs are rejected instead of wrapping around to huge epoch numbers.
+func ReadEpochFlag(flagSet *pflag.FlagSet) (uint64, error) {
+	epochStr, _ := flagSet.GetString(FlagEpoch)
+	if epochStr == "" {
+		return 0, nil
+	}
+
+	if strings.HasPrefix(epochStr, "-") {
+		return 0, fmt.Errorf("epoch number cannot be negative, got: %s", epochStr)
+	}
+
+	epoch, err := strconv.ParseUint(epochStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("expected unsigned integer epoch number, got: %s", epochStr)
+	}
+
+	return epoch, nil
+}
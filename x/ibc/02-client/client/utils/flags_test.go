@@ -0,0 +1,74 @@
+package utils_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+)
+
+func TestReadProveFlag(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expProve bool
+	}{
+		{"default proves", []string{}, true},
+		{"prove disabled", []string{"--prove=false"}, false},
+		{"no-prove set", []string{"--no-prove"}, false},
+		{"no-prove overrides prove", []string{"--prove=true", "--no-prove"}, false},
+		{"no-prove unset", []string{"--no-prove=false"}, true},
+	}
+
+	for _, use := range []string{"state", "consensus-state"} {
+		for _, tc := range testCases {
+			cmd, _, err := cli.GetQueryCmd().Find([]string{use})
+			require.NoError(t, err)
+			require.Equal(t, use, cmd.Name())
+
+			require.NoError(t, cmd.ParseFlags(tc.args), "%s: %s", use, tc.name)
+			require.Equal(t, tc.expProve, utils.ReadProveFlag(cmd.Flags()), "%s: %s", use, tc.name)
+		}
+	}
+
+	// commands without a --prove flag never prove
+	cmd, _, err := cli.GetQueryCmd().Find([]string{"states"})
+	require.NoError(t, err)
+	require.NoError(t, cmd.ParseFlags(nil))
+	require.False(t, utils.ReadProveFlag(cmd.Flags()))
+}
+
+func TestReadEpochFlag(t *testing.T) {
+	testCases := []struct {
+		name     string
+		args     []string
+		expEpoch uint64
+		expPass  bool
+	}{
+		{"default epoch", []string{}, 0, true},
+		{"zero epoch", []string{"--epoch=0"}, 0, true},
+		{"positive epoch", []string{"--epoch", "3"}, 3, true},
+		{"max epoch", []string{"--epoch=18446744073709551615"}, math.MaxUint64, true},
+		{"negative epoch", []string{"--epoch", "-1"}, 0, false},
+		{"negative zero epoch", []string{"--epoch=-0"}, 0, false},
+		{"overflowing epoch", []string{"--epoch=18446744073709551616"}, 0, false},
+		{"non numeric epoch", []string{"--epoch=one"}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		cmd, _, err := cli.GetQueryCmd().Find([]string{"consensus-state"})
+		require.NoError(t, err)
+		require.NoError(t, cmd.ParseFlags(tc.args), tc.name)
+
+		epoch, err := utils.ReadEpochFlag(cmd.Flags())
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expEpoch, epoch, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
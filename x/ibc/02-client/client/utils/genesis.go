@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ValidateClientGenesisFile reads an exported client genesis state from the JSON
+// file at the given path and validates it with ValidateClientGenesis. An error is
+// only returned if the file cannot be read or decoded. Decoding fails if any of
+// the client or consensus states has a type that is not registered on the codec.
+func ValidateClientGenesisFile(cdc codec.JSONMarshaler, path string) ([]error, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return nil, fmt.Errorf("failed to decode client genesis file %s: %w", path, err)
+	}
+
+	return ValidateClientGenesis(genState), nil
+}
+
+// ValidateClientGenesis performs a stateless validation of every client state and
+// consensus state of the client genesis state. The consensus states of each client
+// must be ordered by strictly increasing heights. Unlike GenesisState.Validate, it
+// doesn't stop on the first failure and returns all the errors found instead.
+func ValidateClientGenesis(gs types.GenesisState) []error {
+	var errs []error
+
+	for i, client := range gs.Clients {
+		if err := host.ClientIdentifierValidator(client.ClientId); err != nil {
+			errs = append(errs, fmt.Errorf("client %d: invalid client identifier %s: %w", i, client.ClientId, err))
+		}
+
+		clientState, ok := client.ClientState.GetCachedValue().(exported.ClientState)
+		if !ok {
+			errs = append(errs, fmt.Errorf("client %d (%s): invalid client state", i, client.ClientId))
+			continue
+		}
+
+		if clientState.ClientType().String() == "" {
+			errs = append(errs, fmt.Errorf("client %d (%s): unknown client type %d", i, client.ClientId, clientState.ClientType()))
+		}
+
+		if err := clientState.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("client %d (%s): %w", i, client.ClientId, err))
+		}
+	}
+
+	for i, clientConsensus := range gs.ClientsConsensus {
+		if err := host.ClientIdentifierValidator(clientConsensus.ClientId); err != nil {
+			errs = append(errs, fmt.Errorf("client consensus states %d: invalid client identifier %s: %w", i, clientConsensus.ClientId, err))
+		}
+
+		var prevHeight uint64
+		for j, anyConsensusState := range clientConsensus.ConsensusStates {
+			consensusState, ok := anyConsensusState.GetCachedValue().(exported.ConsensusState)
+			if !ok {
+				errs = append(errs, fmt.Errorf("client %s consensus state %d: invalid consensus state", clientConsensus.ClientId, j))
+				continue
+			}
+
+			if consensusState.ClientType().String() == "" {
+				errs = append(errs, fmt.Errorf("client %s consensus state %d: unknown client type %d", clientConsensus.ClientId, j, consensusState.ClientType()))
+			}
+
+			if err := consensusState.ValidateBasic(); err != nil {
+				errs = append(errs, fmt.Errorf("client %s consensus state %d: %w", clientConsensus.ClientId, j, err))
+			}
+
+			height := consensusState.GetHeight()
+			if j > 0 && height <= prevHeight {
+				errs = append(errs, fmt.Errorf(
+					"client %s consensus state %d: height %d is not greater than the previous consensus state height %d",
+					clientConsensus.ClientId, j, height, prevHeight,
+				))
+			}
+			prevHeight = height
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,124 @@
+package utils_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const (
+	chainID  = "testchain"
+	clientID = "ethbridge"
+)
+
+func newConsensusState(height uint64, timestamp time.Time) exported.ConsensusState {
+	return ibctmtypes.NewConsensusState(
+		timestamp, commitmenttypes.NewMerkleRoot([]byte("app_hash")), types.NewHeight(0, height), tmhash.Sum([]byte("next_vals")),
+	)
+}
+
+func TestValidateClientGenesisFile(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	now := time.Now().UTC()
+
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+	)
+	clients := []types.IdentifiedClientState{
+		types.NewIdentifiedClientState(clientID, clientState),
+		types.NewIdentifiedClientState(exported.ClientTypeLocalHost, localhosttypes.NewClientState(chainID, types.NewHeight(0, 10))),
+	}
+
+	testCases := []struct {
+		name      string
+		genState  types.GenesisState
+		malleate  func(json string) string
+		expErrs   int
+		expDecode bool
+	}{
+		{
+			"valid genesis",
+			types.NewGenesisState(clients, types.ClientsConsensusStates{
+				types.NewClientConsensusStates(clientID, []exported.ConsensusState{
+					newConsensusState(9, now), newConsensusState(10, now.Add(time.Minute)),
+				}),
+			}, false),
+			nil, 0, true,
+		},
+		{
+			"invalid consensus state",
+			types.NewGenesisState(clients, types.ClientsConsensusStates{
+				types.NewClientConsensusStates(clientID, []exported.ConsensusState{
+					newConsensusState(10, time.Time{}),
+				}),
+			}, false),
+			nil, 1, true,
+		},
+		{
+			"consensus state heights not increasing",
+			types.NewGenesisState(clients, types.ClientsConsensusStates{
+				types.NewClientConsensusStates(clientID, []exported.ConsensusState{
+					newConsensusState(10, now), newConsensusState(9, now), newConsensusState(9, now),
+				}),
+			}, false),
+			nil, 2, true,
+		},
+		{
+			"invalid client identifiers",
+			types.NewGenesisState(
+				[]types.IdentifiedClientState{types.NewIdentifiedClientState("/~@$*", clientState)},
+				types.ClientsConsensusStates{
+					types.NewClientConsensusStates("/~@$*", []exported.ConsensusState{newConsensusState(10, now)}),
+				}, false),
+			nil, 2, true,
+		},
+		{
+			"unregistered client type",
+			types.NewGenesisState(clients, types.ClientsConsensusStates{}, false),
+			func(json string) string {
+				return strings.Replace(json, "/ibc.tendermint.ClientState", "/ibc.unknown.ClientState", 1)
+			},
+			0, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := cdc.MarshalJSON(&tc.genState)
+			require.NoError(t, err)
+
+			json := string(bz)
+			if tc.malleate != nil {
+				json = tc.malleate(json)
+			}
+
+			file, cleanup := testutil.WriteToNewTempFile(t, json)
+			defer cleanup()
+
+			errs, err := utils.ValidateClientGenesisFile(cdc, file.Name())
+			if !tc.expDecode {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, errs, tc.expErrs, "%v", errs)
+		})
+	}
+}
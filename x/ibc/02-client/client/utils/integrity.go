@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// DuplicateHeight is a height at which a client has more than one consensus
+// state. Conflicting is true if the consensus states at that height differ.
+type DuplicateHeight struct {
+	Height      uint64 `json:"height" yaml:"height"`
+	Count       int    `json:"count" yaml:"count"`
+	Conflicting bool   `json:"conflicting" yaml:"conflicting"`
+}
+
+// String implements fmt.Stringer.
+func (dh DuplicateHeight) String() string {
+	if dh.Conflicting {
+		return fmt.Sprintf("height %d: %d consensus states with differing content", dh.Height, dh.Count)
+	}
+	return fmt.Sprintf("height %d: %d identical consensus states", dh.Height, dh.Count)
+}
+
+// FindDuplicateConsensusHeights returns the heights at which more than one of the
+// given consensus states is found, ordered by height. A healthy store holds a
+// single consensus state per height, so duplicates can only be found in exported
+// data.
+func FindDuplicateConsensusHeights(anyConsensusStates []*codectypes.Any) ([]DuplicateHeight, error) {
+	byHeight := make(map[uint64][]exported.ConsensusState)
+	for _, anyConsensusState := range anyConsensusStates {
+		consensusState, err := types.UnpackConsensusState(anyConsensusState)
+		if err != nil {
+			return nil, err
+		}
+
+		height := consensusState.GetHeight()
+		byHeight[height] = append(byHeight[height], consensusState)
+	}
+
+	duplicates := []DuplicateHeight{}
+	for height, consensusStates := range byHeight {
+		if len(consensusStates) < 2 {
+			continue
+		}
+
+		duplicate := DuplicateHeight{Height: height, Count: len(consensusStates)}
+		for _, consensusState := range consensusStates[1:] {
+			if !types.ConsensusStateEqual(consensusStates[0], consensusState) {
+				duplicate.Conflicting = true
+				break
+			}
+		}
+
+		duplicates = append(duplicates, duplicate)
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		return duplicates[i].Height < duplicates[j].Height
+	})
+
+	return duplicates, nil
+}
+
+// ReadGenesisConsensusStates reads an exported client genesis state from the JSON
+// file at the given path and returns all the consensus states of the given client,
+// across every entry of the client in the file.
+func ReadGenesisConsensusStates(cdc codec.JSONMarshaler, path, clientID string) ([]*codectypes.Any, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return nil, fmt.Errorf("failed to decode client genesis file %s: %w", path, err)
+	}
+
+	var consensusStates []*codectypes.Any
+	for _, clientConsensus := range genState.ClientsConsensus {
+		if clientConsensus.ClientId == clientID {
+			consensusStates = append(consensusStates, clientConsensus.ConsensusStates...)
+		}
+	}
+
+	return consensusStates, nil
+}
+
+// QueryDuplicateConsensusHeights queries all the consensus states of a client and
+// returns the heights at which more than one consensus state is found.
+func QueryDuplicateConsensusHeights(clientCtx client.Context, clientID string) ([]DuplicateHeight, error) {
+	consensusStates, err := queryAllConsensusStates(clientCtx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindDuplicateConsensusHeights(consensusStates)
+}
@@ -0,0 +1,74 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+func TestFindDuplicateConsensusHeights(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	now := time.Now().UTC()
+
+	clean := []exported.ConsensusState{
+		newConsensusState(2, now), newConsensusState(5, now.Add(time.Minute)), newConsensusState(12, now.Add(time.Hour)),
+	}
+	duplicated := []exported.ConsensusState{
+		newConsensusState(12, now.Add(time.Hour)),
+		newConsensusState(5, now.Add(time.Minute)), newConsensusState(5, now.Add(time.Minute)),
+		newConsensusState(2, now), newConsensusState(2, now.Add(time.Second)), newConsensusState(2, now),
+	}
+
+	testCases := []struct {
+		name            string
+		consensusStates []exported.ConsensusState
+		expDuplicates   []utils.DuplicateHeight
+	}{
+		{"no consensus states", nil, []utils.DuplicateHeight{}},
+		{"clean set", clean, []utils.DuplicateHeight{}},
+		{
+			"duplicated set",
+			duplicated,
+			[]utils.DuplicateHeight{
+				{Height: 2, Count: 3, Conflicting: true},
+				{Height: 5, Count: 2, Conflicting: false},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		clientConsensus := types.NewClientConsensusStates(clientID, tc.consensusStates)
+
+		duplicates, err := utils.FindDuplicateConsensusHeights(clientConsensus.ConsensusStates)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expDuplicates, duplicates, tc.name)
+	}
+
+	// consensus states of a client are gathered across all its entries of an
+	// exported genesis file
+	genState := types.NewGenesisState(nil, types.ClientsConsensusStates{
+		types.NewClientConsensusStates(clientID, clean),
+		types.NewClientConsensusStates("otherclient", duplicated),
+		types.NewClientConsensusStates(clientID, clean[:1]),
+	}, false)
+
+	file, cleanup := testutil.WriteToNewTempFile(t, string(cdc.MustMarshalJSON(&genState)))
+	defer cleanup()
+
+	consensusStates, err := utils.ReadGenesisConsensusStates(cdc, file.Name(), clientID)
+	require.NoError(t, err)
+	require.Len(t, consensusStates, len(clean)+1)
+
+	duplicates, err := utils.FindDuplicateConsensusHeights(consensusStates)
+	require.NoError(t, err)
+	require.Equal(t, []utils.DuplicateHeight{{Height: 2, Count: 2, Conflicting: false}}, duplicates)
+
+	require.Equal(t, "height 2: 3 consensus states with differing content", utils.DuplicateHeight{Height: 2, Count: 3, Conflicting: true}.String())
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"encoding/hex"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+)
+
+// LocalhostVerification is the result of the localhost client verification of the
+// value expected at a key of the IBC store. Values are hex encoded.
+type LocalhostVerification struct {
+	Key      string `json:"key" yaml:"key"`
+	Height   int64  `json:"height" yaml:"height"`
+	Expected string `json:"expected" yaml:"expected"`
+	Stored   string `json:"stored" yaml:"stored"`
+	Verified bool   `json:"verified" yaml:"verified"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// VerifyLocalhostValue runs the localhost client verification of the expected
+// value against a store holding the value stored at the key, or nothing if the
+// stored value is empty, and returns its result. The height is the height at
+// which the stored value was read.
+func VerifyLocalhostValue(key string, expected, stored []byte, height int64) LocalhostVerification {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	if len(stored) != 0 {
+		store.Set([]byte(key), stored)
+	}
+
+	verification := LocalhostVerification{
+		Key:      key,
+		Height:   height,
+		Expected: hex.EncodeToString(expected),
+		Stored:   hex.EncodeToString(stored),
+		Verified: true,
+	}
+
+	if err := localhosttypes.VerifyStoreValue(store, []byte(key), expected); err != nil {
+		verification.Verified = false
+		verification.Error = err.Error()
+	}
+
+	return verification
+}
+
+// QueryLocalhostVerification reads the value stored at the given key of the IBC
+// store of the node and returns the result of its localhost client verification
+// against the expected value.
+func QueryLocalhostVerification(clientCtx client.Context, key string, expected []byte) (LocalhostVerification, error) {
+	req := abci.RequestQuery{
+		Path: "store/ibc/key",
+		Data: []byte(key),
+	}
+
+	res, err := clientCtx.QueryABCI(req)
+	if err != nil {
+		return LocalhostVerification{}, err
+	}
+
+	return VerifyLocalhostValue(key, expected, res.Value, res.Height), nil
+}
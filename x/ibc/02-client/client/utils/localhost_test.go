@@ -0,0 +1,41 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+)
+
+func TestVerifyLocalhostValue(t *testing.T) {
+	key := "commitments/ports/transfer/channels/channel-0/packets/1"
+
+	testCases := []struct {
+		name        string
+		expected    []byte
+		stored      []byte
+		expVerified bool
+	}{
+		{"matching value", []byte{0x01, 0x02}, []byte{0x01, 0x02}, true},
+		{"mismatched value", []byte{0x01, 0x02}, []byte{0x01, 0x03}, false},
+		{"no value stored", []byte{0x01, 0x02}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		verification := utils.VerifyLocalhostValue(key, tc.expected, tc.stored, 10)
+		require.Equal(t, key, verification.Key, tc.name)
+		require.Equal(t, int64(10), verification.Height, tc.name)
+		require.Equal(t, tc.expVerified, verification.Verified, tc.name)
+
+		if tc.expVerified {
+			require.Empty(t, verification.Error, tc.name)
+		} else {
+			require.NotEmpty(t, verification.Error, tc.name)
+		}
+	}
+
+	verification := utils.VerifyLocalhostValue(key, []byte{0xab}, []byte{0xcd}, 10)
+	require.Equal(t, "ab", verification.Expected)
+	require.Equal(t, "cd", verification.Stored)
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// ClientHeight is the latest height of a client.
+type ClientHeight struct {
+	ClientID string       `json:"client_id" yaml:"client_id"`
+	Height   types.Height `json:"height" yaml:"height"`
+}
+
+// Format returns the JSON encoding of the client height. If human is true, it
+// returns a single line with the height formatted for display instead.
+func (ch ClientHeight) Format(human bool) (string, error) {
+	if human {
+		return fmt.Sprintf("%s: %s", ch.ClientID, ch.Height.HumanString()), nil
+	}
+
+	bz, err := json.MarshalIndent(ch, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// MaxClientHeight returns the client with the highest latest height. If several
+// clients share the highest height, the first one is returned. It returns false
+// if there are no client states.
+func MaxClientHeight(clientStates []*types.IdentifiedClientState) (ClientHeight, bool, error) {
+	var (
+		max   ClientHeight
+		found bool
+	)
+
+	for _, identifiedClientState := range clientStates {
+		clientState, err := types.UnpackClientState(identifiedClientState.ClientState)
+		if err != nil {
+			return ClientHeight{}, false, err
+		}
+
+		height := types.NewHeight(0, clientState.GetLatestHeight())
+		if !found || max.Height.LT(height) {
+			max = ClientHeight{ClientID: identifiedClientState.ClientId, Height: height}
+			found = true
+		}
+	}
+
+	return max, found, nil
+}
+
+// QueryMaxClientHeight queries the states of all the clients of the chain and
+// returns the client with the highest latest height. It returns false if the chain
+// has no clients.
+func QueryMaxClientHeight(clientCtx client.Context) (ClientHeight, bool, error) {
+	clientStates, err := queryAllClientStates(clientCtx)
+	if err != nil {
+		return ClientHeight{}, false, err
+	}
+
+	return MaxClientHeight(clientStates)
+}
+
+// queryAllClientStates queries the states of all the clients of the chain, page
+// by page.
+func queryAllClientStates(clientCtx client.Context) ([]*types.IdentifiedClientState, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var (
+		clientStates []*types.IdentifiedClientState
+		pageReq      = &query.PageRequest{}
+	)
+
+	for {
+		res, err := queryClient.ClientStates(context.Background(), &types.QueryClientStatesRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+
+		clientStates = append(clientStates, res.ClientStates...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return clientStates, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
@@ -0,0 +1,90 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestMaxClientHeight(t *testing.T) {
+	newClientState := func(id string, height uint64) *types.IdentifiedClientState {
+		clientState := ibctmtypes.NewClientState(
+			chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+			types.NewHeight(0, height), commitmenttypes.GetSDKSpecs(),
+		)
+		identifiedClientState := types.NewIdentifiedClientState(id, clientState)
+		return &identifiedClientState
+	}
+
+	localhost := types.NewIdentifiedClientState("localhost", localhosttypes.NewClientState(chainID, types.NewHeight(0, 30)))
+
+	testCases := []struct {
+		name         string
+		clientStates []*types.IdentifiedClientState
+		expClient    utils.ClientHeight
+		expFound     bool
+	}{
+		{"no clients", nil, utils.ClientHeight{}, false},
+		{
+			"single client",
+			[]*types.IdentifiedClientState{newClientState("clientida", 10)},
+			utils.ClientHeight{ClientID: "clientida", Height: types.NewHeight(0, 10)},
+			true,
+		},
+		{
+			"clients at differing heights",
+			[]*types.IdentifiedClientState{newClientState("clientida", 10), newClientState("clientidb", 25), newClientState("clientidc", 7)},
+			utils.ClientHeight{ClientID: "clientidb", Height: types.NewHeight(0, 25)},
+			true,
+		},
+		{
+			"first client wins a tie",
+			[]*types.IdentifiedClientState{newClientState("clientida", 25), newClientState("clientidb", 25)},
+			utils.ClientHeight{ClientID: "clientida", Height: types.NewHeight(0, 25)},
+			true,
+		},
+		{
+			"all client types are compared",
+			[]*types.IdentifiedClientState{newClientState("clientida", 25), &localhost},
+			utils.ClientHeight{ClientID: "localhost", Height: types.NewHeight(0, 30)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		clientHeight, found, err := utils.MaxClientHeight(tc.clientStates)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expClient, clientHeight, tc.name)
+	}
+
+	// client states that can't be unpacked are rejected
+	_, _, err := utils.MaxClientHeight([]*types.IdentifiedClientState{{ClientId: "clientida"}})
+	require.Error(t, err)
+}
+
+func TestClientHeightFormat(t *testing.T) {
+	clientHeight := utils.ClientHeight{ClientID: clientID, Height: types.NewHeight(1, 1234567)}
+
+	out, err := clientHeight.Format(true)
+	require.NoError(t, err)
+	require.Equal(t, "ethbridge: epoch-1-height-1,234,567", out)
+
+	// the default output is machine readable
+	out, err = clientHeight.Format(false)
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "client_id": "ethbridge",
+  "height": {
+    "epoch_number": 1,
+    "epoch_height": 1234567
+  }
+}`, out)
+}
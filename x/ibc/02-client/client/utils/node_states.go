@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// QueryNodeConsensusStates takes a client context and returns the tendermint
+// consensus states of the node at each of the given heights. The consensus states
+// are built from the historical info stored by the staking module, so that each
+// of them can be used to create a client trusting the node at that height.
+func QueryNodeConsensusStates(clientCtx client.Context, heights []int64) ([]*ibctmtypes.ConsensusState, error) {
+	return QueryHistoricalConsensusStates(stakingtypes.NewQueryClient(clientCtx), heights)
+}
+
+// QueryNodeHistoricalConsensusState takes a client context and returns the
+// tendermint consensus state of the node as of a past height, built from the
+// historical info stored by the staking module. The historical info is queried
+// from the latest state of the node, so that heights whose state has been pruned
+// but whose historical info is still kept can be queried.
+func QueryNodeHistoricalConsensusState(clientCtx client.Context, height int64) (*ibctmtypes.ConsensusState, error) {
+	return QueryHistoricalConsensusState(stakingtypes.NewQueryClient(clientCtx.WithHeight(0)), height)
+}
+
+// QueryHistoricalConsensusState returns the tendermint consensus state at the
+// given height using the historical info returned by the staking query client,
+// as described in QueryHistoricalConsensusStates.
+func QueryHistoricalConsensusState(queryClient stakingtypes.QueryClient, height int64) (*ibctmtypes.ConsensusState, error) {
+	consensusStates, err := QueryHistoricalConsensusStates(queryClient, []int64{height})
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusStates[0], nil
+}
+
+// QueryHistoricalConsensusStates returns the tendermint consensus states at the
+// given heights using the historical info returned by the staking query client.
+// An error is returned if the historical info of any height is not available,
+// which happens when it has been pruned by the node.
+func QueryHistoricalConsensusStates(queryClient stakingtypes.QueryClient, heights []int64) ([]*ibctmtypes.ConsensusState, error) {
+	consensusStates := make([]*ibctmtypes.ConsensusState, len(heights))
+	for i, height := range heights {
+		if height <= 0 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidHeight, "height must be positive, got %d", height)
+		}
+
+		res, err := queryClient.HistoricalInfo(context.Background(), &stakingtypes.QueryHistoricalInfoRequest{Height: height})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, sdkerrors.Wrapf(
+					types.ErrConsensusStateNotFound,
+					"historical info at height %d is not available on the node, it has been pruned or the height is not committed yet", height,
+				)
+			}
+			return nil, err
+		}
+
+		header := res.Hist.Header
+		consensusStates[i] = &ibctmtypes.ConsensusState{
+			Height:             types.NewHeight(0, uint64(height)),
+			Timestamp:          header.Time,
+			Root:               commitmenttypes.NewMerkleRoot(header.GetAppHash()),
+			NextValidatorsHash: header.NextValidatorsHash,
+		}
+	}
+
+	return consensusStates, nil
+}
@@ -0,0 +1,90 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestQueryHistoricalConsensusStates(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	stakingtypes.RegisterQueryServer(queryHelper, stakingkeeper.Querier{Keeper: app.StakingKeeper})
+	queryClient := stakingtypes.NewQueryClient(queryHelper)
+
+	now := time.Now().UTC()
+	for _, height := range []int64{5, 6, 7} {
+		header := tmproto.Header{
+			ChainID:            chainID,
+			Height:             height,
+			Time:               now.Add(time.Duration(height) * time.Second),
+			AppHash:            []byte("app_hash"),
+			NextValidatorsHash: []byte("next_vals_hash"),
+		}
+		app.StakingKeeper.SetHistoricalInfo(ctx, height, stakingtypes.NewHistoricalInfo(header, nil))
+	}
+
+	consensusStates, err := utils.QueryHistoricalConsensusStates(queryClient, []int64{5, 7})
+	require.NoError(t, err)
+	require.Len(t, consensusStates, 2)
+
+	for i, height := range []uint64{5, 7} {
+		require.Equal(t, types.NewHeight(0, height), consensusStates[i].Height)
+		require.Equal(t, now.Add(time.Duration(height)*time.Second), consensusStates[i].Timestamp)
+		require.Equal(t, []byte("app_hash"), consensusStates[i].Root.GetHash())
+		require.Equal(t, []byte("next_vals_hash"), consensusStates[i].NextValidatorsHash.Bytes())
+	}
+
+	// the historical info of height 4 is missing, as if it had been pruned
+	_, err = utils.QueryHistoricalConsensusStates(queryClient, []int64{5, 4})
+	require.Error(t, err)
+
+	_, err = utils.QueryHistoricalConsensusStates(queryClient, []int64{0})
+	require.Error(t, err)
+}
+
+func TestQueryHistoricalConsensusState(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	stakingtypes.RegisterQueryServer(queryHelper, stakingkeeper.Querier{Keeper: app.StakingKeeper})
+	queryClient := stakingtypes.NewQueryClient(queryHelper)
+
+	now := time.Now().UTC()
+	header := tmproto.Header{
+		ChainID:            chainID,
+		Height:             10,
+		Time:               now,
+		AppHash:            []byte("app_hash"),
+		NextValidatorsHash: []byte("next_vals_hash"),
+	}
+	app.StakingKeeper.SetHistoricalInfo(ctx, 10, stakingtypes.NewHistoricalInfo(header, nil))
+
+	consensusState, err := utils.QueryHistoricalConsensusState(queryClient, 10)
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeight(0, 10), consensusState.Height)
+	require.Equal(t, now, consensusState.Timestamp)
+	require.Equal(t, []byte("app_hash"), consensusState.Root.GetHash())
+
+	// the historical info of height 9 is pruned
+	app.StakingKeeper.SetHistoricalInfo(ctx, 9, stakingtypes.NewHistoricalInfo(header, nil))
+	app.StakingKeeper.DeleteHistoricalInfo(ctx, 9)
+
+	_, err = utils.QueryHistoricalConsensusState(queryClient, 9)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrConsensusStateNotFound))
+	require.Contains(t, err.Error(), "pruned")
+}
@@ -0,0 +1,104 @@
+package utils
+
+import (
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// PacketAcknowledgementVerification is the result of the local verification of a
+// packet acknowledgement proof by a client.
+type PacketAcknowledgementVerification struct {
+	ClientID  string `json:"client_id" yaml:"client_id"`
+	Height    uint64 `json:"height" yaml:"height"`
+	PortID    string `json:"port_id" yaml:"port_id"`
+	ChannelID string `json:"channel_id" yaml:"channel_id"`
+	Sequence  uint64 `json:"sequence" yaml:"sequence"`
+	Verified  bool   `json:"verified" yaml:"verified"`
+	Error     string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// VerifyPacketAcknowledgement runs the packet acknowledgement verification of the
+// client state against a client store holding only the given consensus state at
+// the proof height, and returns its result. The verification is skipped if the
+// same proof of the same acknowledgement was successfully verified by the client
+// at that height within the TTL of the given cache, which may be nil.
+func VerifyPacketAcknowledgement(
+	cache *VerificationCache, cdc codec.BinaryMarshaler, clientID string, clientState exported.ClientState, consensusState exported.ConsensusState,
+	height uint64, prefix exported.Prefix, proof []byte, portID, channelID string, sequence uint64, acknowledgement []byte,
+) PacketAcknowledgementVerification {
+	verification := PacketAcknowledgementVerification{
+		ClientID:  clientID,
+		Height:    height,
+		PortID:    portID,
+		ChannelID: channelID,
+		Sequence:  sequence,
+		Verified:  true,
+	}
+
+	key := VerificationKey{
+		ClientID: clientID,
+		Height:   height,
+		Proof:    proof,
+		Target:   NewVerificationTarget(prefix.Bytes(), host.KeyPacketAcknowledgement(portID, channelID, sequence), acknowledgement),
+	}
+
+	if err := cache.Verify(key, func() error {
+		bz, err := types.MarshalConsensusState(cdc, consensusState)
+		if err != nil {
+			return err
+		}
+
+		store := dbadapter.Store{DB: dbm.NewMemDB()}
+		store.Set(host.KeyConsensusState(height), bz)
+
+		return clientState.VerifyPacketAcknowledgement(
+			store, cdc, height, prefix, proof, portID, channelID, sequence, acknowledgement,
+		)
+	}); err != nil {
+		verification.Verified = false
+		verification.Error = err.Error()
+	}
+
+	return verification
+}
+
+// QueryVerifyPacketAcknowledgement queries the state of a client along with its
+// consensus state at the proof height and returns the result of the verification
+// of the packet acknowledgement proof by the client. Successful verifications are
+// remembered by a process wide cache for DefaultVerificationCacheTTL.
+func QueryVerifyPacketAcknowledgement(
+	clientCtx client.Context, clientID string, height uint64, prefix exported.Prefix, proof []byte,
+	portID, channelID string, sequence uint64, acknowledgement []byte,
+) (PacketAcknowledgementVerification, error) {
+	// the ABCI queries return the client and consensus states already unpacked
+	clientStateRes, err := QueryClientState(clientCtx, clientID, true)
+	if err != nil {
+		return PacketAcknowledgementVerification{}, err
+	}
+
+	clientState, err := types.UnpackClientState(clientStateRes.ClientState)
+	if err != nil {
+		return PacketAcknowledgementVerification{}, err
+	}
+
+	consensusStateRes, err := QueryConsensusState(clientCtx, clientID, height, true, false)
+	if err != nil {
+		return PacketAcknowledgementVerification{}, err
+	}
+
+	consensusState, err := types.UnpackConsensusState(consensusStateRes.ConsensusState)
+	if err != nil {
+		return PacketAcknowledgementVerification{}, err
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	return VerifyPacketAcknowledgement(
+		defaultVerificationCache, cdc, clientID, clientState, consensusState, height, prefix, proof, portID, channelID, sequence, acknowledgement,
+	), nil
+}
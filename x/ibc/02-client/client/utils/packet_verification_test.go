@@ -0,0 +1,93 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestVerifyPacketAcknowledgement(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	clientA, clientB, _, _, channelA, channelB := coordinator.Setup(chainA, chainB, channeltypes.UNORDERED)
+	packet := channeltypes.NewPacket(ibctesting.TestHash, 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, 100, 0)
+
+	// send the packet and write its acknowledgement on chainB
+	require.NoError(t, coordinator.SendPacket(chainA, chainB, packet, clientB))
+	require.NoError(t, coordinator.ReceiveExecuted(chainB, chainA, packet, clientA))
+
+	proof, proofHeight := chainB.QueryProof(host.KeyPacketAcknowledgement(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	prefix := chainB.GetPrefix()
+
+	clientState := chainA.GetClientState(clientA)
+	consensusState, found := chainA.GetConsensusState(clientA, proofHeight)
+	require.True(t, found)
+
+	testCases := []struct {
+		name            string
+		acknowledgement []byte
+		proof           []byte
+		expVerified     bool
+	}{
+		{"valid proof", ibctesting.TestHash, proof, true},
+		{"tampered acknowledgement", []byte("tampered acknowledgement"), proof, false},
+		{"empty proof", ibctesting.TestHash, nil, false},
+	}
+
+	for _, tc := range testCases {
+		verification := utils.VerifyPacketAcknowledgement(
+			nil, chainA.Codec, clientA, clientState, consensusState, proofHeight, &prefix, tc.proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), tc.acknowledgement,
+		)
+
+		require.Equal(t, clientA, verification.ClientID, tc.name)
+		require.Equal(t, proofHeight, verification.Height, tc.name)
+		require.Equal(t, packet.GetDestPort(), verification.PortID, tc.name)
+		require.Equal(t, packet.GetDestChannel(), verification.ChannelID, tc.name)
+		require.Equal(t, packet.GetSequence(), verification.Sequence, tc.name)
+		require.Equal(t, tc.expVerified, verification.Verified, tc.name)
+
+		if tc.expVerified {
+			require.Empty(t, verification.Error, tc.name)
+		} else {
+			require.NotEmpty(t, verification.Error, tc.name)
+		}
+	}
+
+	// a successful verification is cached, a failed one isn't
+	cache, err := utils.NewVerificationCache(10, time.Hour)
+	require.NoError(t, err)
+
+	verify := func(acknowledgement []byte) utils.PacketAcknowledgementVerification {
+		return utils.VerifyPacketAcknowledgement(
+			cache, chainA.Codec, clientA, clientState, consensusState, proofHeight, &prefix, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), acknowledgement,
+		)
+	}
+
+	require.False(t, verify([]byte("tampered acknowledgement")).Verified)
+	require.Zero(t, cache.Len())
+
+	require.True(t, verify(ibctesting.TestHash).Verified)
+	require.Equal(t, 1, cache.Len())
+
+	// the cached verification is reused without verifying the proof again, even
+	// against a consensus state for which it wouldn't verify
+	tmConsensusState := *consensusState.(*ibctmtypes.ConsensusState)
+	tmConsensusState.Root = commitmenttypes.NewMerkleRoot([]byte("invalid root"))
+	consensusState = &tmConsensusState
+	require.True(t, verify(ibctesting.TestHash).Verified)
+
+	// a different acknowledgement misses the cache
+	require.False(t, verify([]byte("other acknowledgement")).Verified)
+}
@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// ABCIQuerier defines the function used to perform ABCI store queries, such as
+// client.Context.QueryABCI.
+type ABCIQuerier func(req abci.RequestQuery) (abci.ResponseQuery, error)
+
+// QueryClientStateProof returns the client state of the given client along with
+// its merkle proof at the given height of the node's store.
+func QueryClientStateProof(
+	clientCtx client.Context, clientID string, height int64,
+) (*types.QueryClientStateResponse, error) {
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	return QueryClientStateProofWithQuerier(clientCtx.WithHeight(height).QueryABCI, cdc, clientID, height)
+}
+
+// QueryClientStateProofWithQuerier queries the client state of the given client
+// and its merkle proof at the given height using the provided ABCI querier. An
+// error is returned if the store at that height is not available, which happens
+// when it has been pruned by the node.
+func QueryClientStateProofWithQuerier(
+	query ABCIQuerier, cdc codec.Marshaler, clientID string, height int64,
+) (*types.QueryClientStateResponse, error) {
+	if height <= 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHeight, "height must be positive, got %d", height)
+	}
+
+	res, err := query(abci.RequestQuery{
+		Path:   "store/ibc/key",
+		Data:   host.FullKeyClientPath(clientID, host.KeyClientState()),
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "client state proof at height %d is not available, the height may have been pruned", height)
+	}
+	if !res.IsOK() {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ABCIError(res.Codespace, res.Code, res.Log),
+			"client state proof at height %d is not available, the height may have been pruned", height,
+		)
+	}
+	if res.Height != height {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidHeight, "expected proof at height %d, got %d", height, res.Height)
+	}
+	if len(res.Value) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "client %s not found at height %d", clientID, height)
+	}
+
+	clientState, err := types.UnmarshalClientState(cdc, res.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	anyClientState, err := types.PackClientState(clientState)
+	if err != nil {
+		return nil, err
+	}
+
+	proofBz, err := cdc.MarshalBinaryBare(res.ProofOps)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewQueryClientStateResponse(clientID, anyClientState, proofBz, res.Height), nil
+}
+
+// ClientStateProofResult is the result of the query of a client state proof at a
+// height. The response is nil and the error is set if the proof isn't available
+// at that height, eg: because the height has been pruned by the node.
+type ClientStateProofResult struct {
+	Height   int64
+	Response *types.QueryClientStateResponse
+	Error    string
+}
+
+// clientStateProofResultJSON is the JSON format of a client state proof result.
+// The client state is encoded as an identified client state with the protobuf
+// JSON encoding of the codec.
+type clientStateProofResultJSON struct {
+	Height      int64           `json:"height"`
+	Available   bool            `json:"available"`
+	ClientState json.RawMessage `json:"client_state,omitempty"`
+	Proof       []byte          `json:"proof,omitempty"`
+	ProofPath   string          `json:"proof_path,omitempty"`
+	ProofHeight uint64          `json:"proof_height,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
+
+// QueryClientStateProofs returns the client state of the given client along with
+// its merkle proof at each of the given heights of the node's store.
+func QueryClientStateProofs(clientCtx client.Context, clientID string, heights []int64) []ClientStateProofResult {
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	return QueryClientStateProofsWithQuerier(func(height int64) ABCIQuerier {
+		return clientCtx.WithHeight(height).QueryABCI
+	}, cdc, clientID, heights)
+}
+
+// QueryClientStateProofsWithQuerier queries the client state of the given client
+// and its merkle proof at each of the given heights, in order, using the ABCI
+// querier returned for each height. The proofs that aren't available don't stop
+// the queries: their result holds the error instead.
+func QueryClientStateProofsWithQuerier(
+	queryAt func(height int64) ABCIQuerier, cdc codec.Marshaler, clientID string, heights []int64,
+) []ClientStateProofResult {
+	results := make([]ClientStateProofResult, len(heights))
+	for i, height := range heights {
+		results[i].Height = height
+
+		res, err := QueryClientStateProofWithQuerier(queryAt(height), cdc, clientID, height)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		results[i].Response = res
+	}
+
+	return results
+}
+
+// MarshalClientStateProofResults returns the indented JSON encoding of the given
+// client state proof results of a client.
+func MarshalClientStateProofResults(
+	cdc codec.JSONMarshaler, clientID string, results []ClientStateProofResult,
+) ([]byte, error) {
+	resultsJSON := make([]clientStateProofResultJSON, len(results))
+	for i, result := range results {
+		resultsJSON[i] = clientStateProofResultJSON{
+			Height:    result.Height,
+			Available: result.Response != nil,
+			Error:     result.Error,
+		}
+
+		if result.Response == nil {
+			continue
+		}
+
+		clientState, err := types.UnpackClientState(result.Response.ClientState)
+		if err != nil {
+			return nil, err
+		}
+
+		identifiedClientState := types.NewIdentifiedClientState(clientID, clientState)
+		bz, err := cdc.MarshalJSON(&identifiedClientState)
+		if err != nil {
+			return nil, err
+		}
+
+		resultsJSON[i].ClientState = bz
+		resultsJSON[i].Proof = result.Response.Proof
+		resultsJSON[i].ProofPath = result.Response.ProofPath
+		resultsJSON[i].ProofHeight = result.Response.ProofHeight
+	}
+
+	return json.MarshalIndent(resultsJSON, "", "  ")
+}
@@ -0,0 +1,297 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+
+	ics23 "github.com/confio/ics23/go"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// fingerprintLength is the number of bytes of the proof specs hash kept in a
+// fingerprint.
+const fingerprintLength = 8
+
+// ClientProofSpecs defines the ICS23 proof specs a client verifies proofs with,
+// along with their fingerprint.
+type ClientProofSpecs struct {
+	ClientID    string             `json:"client_id" yaml:"client_id"`
+	ProofSpecs  []*ics23.ProofSpec `json:"proof_specs" yaml:"proof_specs"`
+	Fingerprint string             `json:"fingerprint" yaml:"fingerprint"`
+}
+
+// NewClientProofSpecs returns the proof specs of the given client state along
+// with their fingerprint.
+func NewClientProofSpecs(clientID string, clientState exported.ClientState) (ClientProofSpecs, error) {
+	specs := clientState.GetProofSpecs()
+	fingerprint, err := ProofSpecsFingerprint(specs)
+	if err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	return ClientProofSpecs{
+		ClientID:    clientID,
+		ProofSpecs:  specs,
+		Fingerprint: fingerprint,
+	}, nil
+}
+
+// QueryClientProofSpecs queries the state of the given client and returns its
+// proof specs along with their fingerprint.
+func QueryClientProofSpecs(clientCtx client.Context, clientID string) (ClientProofSpecs, error) {
+	res, err := QueryClientState(clientCtx, clientID, false)
+	if err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	if err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	return NewClientProofSpecs(clientID, clientState)
+}
+
+// ProofSpecsDiff is the difference between the proof specs of two clients.
+type ProofSpecsDiff struct {
+	ClientIDA   string                `json:"client_id_a" yaml:"client_id_a"`
+	ClientIDB   string                `json:"client_id_b" yaml:"client_id_b"`
+	Differences []ProofSpecDifference `json:"differences" yaml:"differences"`
+}
+
+// ProofSpecDifference is a field of the proof spec at the given index whose value
+// differs between two lists of proof specs. The field is named after its protobuf
+// path in the spec, eg: "leaf_spec.hash". A spec missing from one of the lists is
+// reported as the "spec" field.
+type ProofSpecDifference struct {
+	Index int    `json:"index" yaml:"index"`
+	Field string `json:"field" yaml:"field"`
+	A     string `json:"a" yaml:"a"`
+	B     string `json:"b" yaml:"b"`
+}
+
+// QueryProofSpecsDiff queries the states of both clients and returns the
+// differences between their proof specs.
+func QueryProofSpecsDiff(clientCtx client.Context, clientIDA, clientIDB string) (ProofSpecsDiff, error) {
+	specsA, err := QueryClientProofSpecs(clientCtx, clientIDA)
+	if err != nil {
+		return ProofSpecsDiff{}, err
+	}
+
+	specsB, err := QueryClientProofSpecs(clientCtx, clientIDB)
+	if err != nil {
+		return ProofSpecsDiff{}, err
+	}
+
+	return ProofSpecsDiff{
+		ClientIDA:   clientIDA,
+		ClientIDB:   clientIDB,
+		Differences: DiffProofSpecs(specsA.ProofSpecs, specsB.ProofSpecs),
+	}, nil
+}
+
+// ProofSpecsValidation is the result of the comparison of the proof specs of a
+// client against reference proof specs. In the differences, A is the value of
+// the client and B the value of the reference.
+type ProofSpecsValidation struct {
+	ClientID    string                `json:"client_id" yaml:"client_id"`
+	Matches     bool                  `json:"matches" yaml:"matches"`
+	Differences []ProofSpecDifference `json:"differences,omitempty" yaml:"differences,omitempty"`
+}
+
+// ValidateProofSpecs compares the proof specs of the client state against the
+// reference proof specs field by field and returns whether they match along with
+// the differing fields.
+func ValidateProofSpecs(clientID string, clientState exported.ClientState, reference []*ics23.ProofSpec) ProofSpecsValidation {
+	diffs := DiffProofSpecs(clientState.GetProofSpecs(), reference)
+	return ProofSpecsValidation{
+		ClientID:    clientID,
+		Matches:     len(diffs) == 0,
+		Differences: diffs,
+	}
+}
+
+// QueryValidateProofSpecs queries the state of the given client and compares its
+// proof specs against the reference proof specs read from the JSON file at the
+// given path, as written by WriteProofSpecsFile.
+func QueryValidateProofSpecs(clientCtx client.Context, clientID, referencePath string) (ProofSpecsValidation, error) {
+	reference, err := ReadProofSpecsFile(referencePath)
+	if err != nil {
+		return ProofSpecsValidation{}, err
+	}
+
+	res, err := QueryClientState(clientCtx, clientID, false)
+	if err != nil {
+		return ProofSpecsValidation{}, err
+	}
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	if err != nil {
+		return ProofSpecsValidation{}, err
+	}
+
+	return ValidateProofSpecs(clientID, clientState, reference), nil
+}
+
+// DiffProofSpecs compares the proof specs at each index of both lists field by
+// field and returns the fields whose values differ, ordered by index. It returns
+// nil if the proof specs are identical.
+func DiffProofSpecs(a, b []*ics23.ProofSpec) []ProofSpecDifference {
+	var diffs []ProofSpecDifference
+	diff := func(index int, field string, valueA, valueB interface{}) {
+		strA, strB := fmt.Sprint(valueA), fmt.Sprint(valueB)
+		if strA != strB {
+			diffs = append(diffs, ProofSpecDifference{Index: index, Field: field, A: strA, B: strB})
+		}
+	}
+
+	length := len(a)
+	if len(b) > length {
+		length = len(b)
+	}
+
+	for i := 0; i < length; i++ {
+		var specA, specB *ics23.ProofSpec
+		if i < len(a) {
+			specA = a[i]
+		}
+		if i < len(b) {
+			specB = b[i]
+		}
+
+		if specA == nil || specB == nil {
+			diff(i, "spec", specPresence(specA), specPresence(specB))
+			continue
+		}
+
+		leafA, leafB := specA.GetLeafSpec(), specB.GetLeafSpec()
+		diff(i, "leaf_spec.hash", leafA.GetHash(), leafB.GetHash())
+		diff(i, "leaf_spec.prehash_key", leafA.GetPrehashKey(), leafB.GetPrehashKey())
+		diff(i, "leaf_spec.prehash_value", leafA.GetPrehashValue(), leafB.GetPrehashValue())
+		diff(i, "leaf_spec.length", leafA.GetLength(), leafB.GetLength())
+		diff(i, "leaf_spec.prefix", hex.EncodeToString(leafA.GetPrefix()), hex.EncodeToString(leafB.GetPrefix()))
+
+		innerA, innerB := specA.GetInnerSpec(), specB.GetInnerSpec()
+		diff(i, "inner_spec.child_order", innerA.GetChildOrder(), innerB.GetChildOrder())
+		diff(i, "inner_spec.child_size", innerA.GetChildSize(), innerB.GetChildSize())
+		diff(i, "inner_spec.min_prefix_length", innerA.GetMinPrefixLength(), innerB.GetMinPrefixLength())
+		diff(i, "inner_spec.max_prefix_length", innerA.GetMaxPrefixLength(), innerB.GetMaxPrefixLength())
+		diff(i, "inner_spec.empty_child", hex.EncodeToString(innerA.GetEmptyChild()), hex.EncodeToString(innerB.GetEmptyChild()))
+		diff(i, "inner_spec.hash", innerA.GetHash(), innerB.GetHash())
+
+		diff(i, "max_depth", specA.GetMaxDepth(), specB.GetMaxDepth())
+		diff(i, "min_depth", specA.GetMinDepth(), specB.GetMinDepth())
+	}
+
+	return diffs
+}
+
+// specPresence describes whether a proof spec is set, for the difference of specs
+// missing from one of the lists.
+func specPresence(spec *ics23.ProofSpec) string {
+	if spec == nil {
+		return "missing"
+	}
+	return "present"
+}
+
+// ProofSpecsFingerprint returns a short hex encoded hash of the protobuf encoding
+// of the given proof specs. Clients with equal proof specs, in the same order,
+// have the same fingerprint.
+func ProofSpecsFingerprint(specs []*ics23.ProofSpec) (string, error) {
+	h := sha256.New()
+	for _, spec := range specs {
+		var bz []byte
+		if spec != nil {
+			var err error
+			bz, err = spec.Marshal()
+			if err != nil {
+				return "", err
+			}
+		}
+
+		// length prefix each spec so that different spec splits never collide
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(bz)))
+		h.Write(length[:])
+		h.Write(bz)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:fingerprintLength]), nil
+}
+
+// WriteProofSpecsFile writes the given proof specs in JSON format to the file at
+// the given path. The file can be used as the proof specs of a counterparty client
+// creation, either with the --proof-specs flag of the tendermint create command or
+// embedded as the proof_specs field of a client state.
+func WriteProofSpecsFile(path string, specs []*ics23.ProofSpec) error {
+	if len(specs) == 0 {
+		return fmt.Errorf("proof specs cannot be empty")
+	}
+
+	bz, err := codec.MarshalJSONIndent(codec.New(), specs)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// ExportProofSpecs writes the proof specs of the given client state in JSON format
+// to the file at the given path, as WriteProofSpecsFile, and returns them along
+// with their fingerprint. An error is returned if the client has no proof specs.
+func ExportProofSpecs(path, clientID string, clientState exported.ClientState) (ClientProofSpecs, error) {
+	specs, err := NewClientProofSpecs(clientID, clientState)
+	if err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	if len(specs.ProofSpecs) == 0 {
+		return ClientProofSpecs{}, fmt.Errorf("client %s of type %s has no proof specs", clientID, clientState.ClientType())
+	}
+
+	if err := WriteProofSpecsFile(path, specs.ProofSpecs); err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	return specs, nil
+}
+
+// QueryExportProofSpecs queries the state of the given client and exports its
+// proof specs to the file at the given path, as described in ExportProofSpecs.
+func QueryExportProofSpecs(clientCtx client.Context, clientID, path string) (ClientProofSpecs, error) {
+	res, err := QueryClientState(clientCtx, clientID, false)
+	if err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	if err != nil {
+		return ClientProofSpecs{}, err
+	}
+
+	return ExportProofSpecs(path, clientID, clientState)
+}
+
+// ReadProofSpecsFile reads proof specs from the JSON file at the given path, as
+// written by WriteProofSpecsFile.
+func ReadProofSpecsFile(path string) ([]*ics23.ProofSpec, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var specs []*ics23.ProofSpec
+	if err := codec.New().UnmarshalJSON(bz, &specs); err != nil {
+		return nil, fmt.Errorf("failed to decode proof specs file %s: %w", path, err)
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,205 @@
+package utils_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestProofSpecsFingerprint(t *testing.T) {
+	newClientState := func(specs []*ics23.ProofSpec) *ibctmtypes.ClientState {
+		return ibctmtypes.NewClientState(
+			chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, types.NewHeight(0, 10), specs,
+		)
+	}
+
+	specs, err := utils.NewClientProofSpecs(clientID, newClientState(commitmenttypes.GetSDKSpecs()))
+	require.NoError(t, err)
+	require.Equal(t, clientID, specs.ClientID)
+	require.Equal(t, commitmenttypes.GetSDKSpecs(), specs.ProofSpecs)
+	require.Len(t, specs.Fingerprint, 16)
+
+	// identical specs on another client
+	identical, err := utils.NewClientProofSpecs("otherclient", newClientState(commitmenttypes.GetSDKSpecs()))
+	require.NoError(t, err)
+	require.Equal(t, specs.Fingerprint, identical.Fingerprint)
+
+	// differing specs
+	differing := []*ics23.ProofSpec{ics23.IavlSpec}
+	reordered := []*ics23.ProofSpec{ics23.TendermintSpec, ics23.IavlSpec}
+	for _, other := range [][]*ics23.ProofSpec{differing, reordered, nil} {
+		otherSpecs, err := utils.NewClientProofSpecs(clientID, newClientState(other))
+		require.NoError(t, err)
+		require.NotEqual(t, specs.Fingerprint, otherSpecs.Fingerprint)
+	}
+
+	// clients without proof specs
+	localhost, err := utils.NewClientProofSpecs("localhost", localhosttypes.NewClientState(chainID, types.NewHeight(0, 10)))
+	require.NoError(t, err)
+	require.Empty(t, localhost.ProofSpecs)
+	require.NotEmpty(t, localhost.Fingerprint)
+}
+
+func TestDiffProofSpecs(t *testing.T) {
+	// identical specs
+	require.Empty(t, utils.DiffProofSpecs(commitmenttypes.GetSDKSpecs(), commitmenttypes.GetSDKSpecs()))
+	require.Empty(t, utils.DiffProofSpecs(nil, nil))
+
+	// differing leaf spec fields
+	leafSpec := *ics23.IavlSpec.LeafSpec
+	leafSpec.Hash = ics23.HashOp_SHA512
+	leafSpec.PrehashValue = ics23.HashOp_NO_HASH
+	leafSpec.Length = ics23.LengthOp_NO_PREFIX
+	spec := *ics23.IavlSpec
+	spec.LeafSpec = &leafSpec
+	spec.MaxDepth = 10
+
+	require.Equal(t, []utils.ProofSpecDifference{
+		{Index: 1, Field: "leaf_spec.hash", A: "SHA256", B: "SHA512"},
+		{Index: 1, Field: "leaf_spec.prehash_value", A: "SHA256", B: "NO_HASH"},
+		{Index: 1, Field: "leaf_spec.length", A: "VAR_PROTO", B: "NO_PREFIX"},
+		{Index: 1, Field: "max_depth", A: "0", B: "10"},
+	}, utils.DiffProofSpecs(
+		[]*ics23.ProofSpec{ics23.TendermintSpec, ics23.IavlSpec},
+		[]*ics23.ProofSpec{ics23.TendermintSpec, &spec},
+	))
+
+	// differing inner spec fields
+	innerSpec := *ics23.TendermintSpec.InnerSpec
+	innerSpec.ChildOrder = []int32{1, 0}
+	innerSpec.EmptyChild = []byte{0xff}
+	spec = *ics23.TendermintSpec
+	spec.InnerSpec = &innerSpec
+
+	require.Equal(t, []utils.ProofSpecDifference{
+		{Index: 0, Field: "inner_spec.child_order", A: "[0 1]", B: "[1 0]"},
+		{Index: 0, Field: "inner_spec.empty_child", A: "", B: "ff"},
+	}, utils.DiffProofSpecs([]*ics23.ProofSpec{ics23.TendermintSpec}, []*ics23.ProofSpec{&spec}))
+
+	// specs missing from one of the lists
+	require.Equal(t, []utils.ProofSpecDifference{
+		{Index: 1, Field: "spec", A: "present", B: "missing"},
+	}, utils.DiffProofSpecs(commitmenttypes.GetSDKSpecs(), commitmenttypes.GetSDKSpecs()[:1]))
+
+	// every differing field of specs for distinct trees is reported
+	diffs := utils.DiffProofSpecs([]*ics23.ProofSpec{ics23.IavlSpec}, []*ics23.ProofSpec{ics23.TendermintSpec})
+	require.NotEmpty(t, diffs)
+	for _, diff := range diffs {
+		require.Equal(t, 0, diff.Index)
+		require.NotEqual(t, diff.A, diff.B, diff.Field)
+	}
+}
+
+func TestProofSpecsFile(t *testing.T) {
+	nodeSpecs := commitmenttypes.GetSDKSpecs()
+	path := filepath.Join(t.TempDir(), "proof_specs.json")
+	require.NoError(t, utils.WriteProofSpecsFile(path, nodeSpecs))
+
+	specs, err := utils.ReadProofSpecsFile(path)
+	require.NoError(t, err)
+	require.Equal(t, nodeSpecs, specs)
+
+	// a client created with the exported specs verifies proofs with the node specs
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, types.NewHeight(0, 10), specs,
+	)
+	require.Equal(t, nodeSpecs, clientState.GetProofSpecs())
+
+	// the exported specs can be embedded as the proof specs of a client state
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	var embedded ibctmtypes.ClientState
+	require.NoError(t, simapp.MakeEncodingConfig().Marshaler.UnmarshalJSON([]byte(fmt.Sprintf(`{"proof_specs": %s}`, bz)), &embedded))
+	require.Equal(t, nodeSpecs, embedded.GetProofSpecs())
+
+	require.Error(t, utils.WriteProofSpecsFile(filepath.Join(t.TempDir(), "empty.json"), nil))
+
+	_, err = utils.ReadProofSpecsFile(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestExportProofSpecs(t *testing.T) {
+	// the exported specs are the ones of the client, not the SDK ones
+	specs := []*ics23.ProofSpec{ics23.TendermintSpec}
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		types.NewHeight(0, 10), specs,
+	)
+
+	path := filepath.Join(t.TempDir(), "proof_specs.json")
+	exported, err := utils.ExportProofSpecs(path, clientID, clientState)
+	require.NoError(t, err)
+
+	expected, err := utils.NewClientProofSpecs(clientID, clientState)
+	require.NoError(t, err)
+	require.Equal(t, expected, exported)
+
+	reloaded, err := utils.ReadProofSpecsFile(path)
+	require.NoError(t, err)
+	require.Equal(t, clientState.GetProofSpecs(), reloaded)
+	require.NotEqual(t, commitmenttypes.GetSDKSpecs(), reloaded)
+
+	// clients without proof specs can't be exported
+	_, err = utils.ExportProofSpecs(
+		filepath.Join(t.TempDir(), "localhost.json"), clientID, localhosttypes.NewClientState(chainID, types.NewHeight(0, 10)),
+	)
+	require.Error(t, err)
+}
+
+func TestValidateProofSpecs(t *testing.T) {
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+	)
+
+	// matching reference file
+	path := filepath.Join(t.TempDir(), "reference.json")
+	require.NoError(t, utils.WriteProofSpecsFile(path, commitmenttypes.GetSDKSpecs()))
+
+	reference, err := utils.ReadProofSpecsFile(path)
+	require.NoError(t, err)
+
+	validation := utils.ValidateProofSpecs(clientID, clientState, reference)
+	require.Equal(t, utils.ProofSpecsValidation{ClientID: clientID, Matches: true}, validation)
+
+	// altered reference file
+	// copy the specs, as the SDK specs are shared
+	altered := append([]*ics23.ProofSpec(nil), commitmenttypes.GetSDKSpecs()...)
+	innerSpec := *altered[1].InnerSpec
+	innerSpec.ChildSize = 64
+	spec := *altered[1]
+	spec.InnerSpec = &innerSpec
+	spec.MaxDepth = 5
+	altered[1] = &spec
+
+	path = filepath.Join(t.TempDir(), "altered.json")
+	require.NoError(t, utils.WriteProofSpecsFile(path, altered))
+
+	reference, err = utils.ReadProofSpecsFile(path)
+	require.NoError(t, err)
+
+	validation = utils.ValidateProofSpecs(clientID, clientState, reference)
+	require.False(t, validation.Matches)
+	require.Equal(t, []utils.ProofSpecDifference{
+		{Index: 1, Field: "inner_spec.child_size", A: "32", B: "64"},
+		{Index: 1, Field: "max_depth", A: "0", B: "5"},
+	}, validation.Differences)
+
+	// a reference with fewer specs than the client
+	validation = utils.ValidateProofSpecs(clientID, clientState, commitmenttypes.GetSDKSpecs()[:1])
+	require.False(t, validation.Matches)
+	require.Equal(t, []utils.ProofSpecDifference{{Index: 1, Field: "spec", A: "present", B: "missing"}}, validation.Differences)
+}
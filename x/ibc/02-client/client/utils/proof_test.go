@@ -0,0 +1,129 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestQueryClientStateProof(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	heightBeforeClient := chainA.App.LastBlockHeight()
+	clientA, _ := coordinator.SetupClients(chainA, chainB, exported.Tendermint)
+	height := chainA.App.LastBlockHeight()
+
+	query := func(req abci.RequestQuery) (abci.ResponseQuery, error) {
+		res := chainA.App.Query(req)
+		if !res.IsOK() {
+			return res, errors.New(res.Log)
+		}
+		return res, nil
+	}
+	cdc := chainA.App.AppCodec()
+
+	res, err := utils.QueryClientStateProofWithQuerier(query, cdc, clientA, height)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Proof)
+	require.Equal(t, uint64(height), res.ProofHeight)
+
+	clientState, err := types.UnpackClientState(res.ClientState)
+	require.NoError(t, err)
+	expClientState, found := chainA.App.IBCKeeper.ClientKeeper.GetClientState(chainA.GetContext(), clientA)
+	require.True(t, found)
+	require.Equal(t, expClientState, clientState)
+
+	// client didn't exist yet
+	_, err = utils.QueryClientStateProofWithQuerier(query, cdc, clientA, heightBeforeClient)
+	require.Error(t, err)
+
+	// store version not available on the node
+	_, err = utils.QueryClientStateProofWithQuerier(query, cdc, clientA, height+100)
+	require.Error(t, err)
+
+	_, err = utils.QueryClientStateProofWithQuerier(query, cdc, clientA, 0)
+	require.Error(t, err)
+}
+
+func TestQueryClientStateProofs(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	heightBeforeClient := chainA.App.LastBlockHeight()
+	clientA, _ := coordinator.SetupClients(chainA, chainB, exported.Tendermint)
+	height := chainA.App.LastBlockHeight()
+	require.NoError(t, coordinator.UpdateClient(chainA, chainB, clientA, exported.Tendermint))
+	updatedHeight := chainA.App.LastBlockHeight()
+
+	queryAt := func(int64) utils.ABCIQuerier {
+		return func(req abci.RequestQuery) (abci.ResponseQuery, error) {
+			res := chainA.App.Query(req)
+			if !res.IsOK() {
+				return res, errors.New(res.Log)
+			}
+			return res, nil
+		}
+	}
+	cdc := chainA.App.AppCodec()
+
+	// the heights beyond the latest height are unavailable, as pruned heights are
+	heights := []int64{height, heightBeforeClient, updatedHeight + 100, updatedHeight}
+	results := utils.QueryClientStateProofsWithQuerier(queryAt, cdc, clientA, heights)
+	require.Len(t, results, len(heights))
+
+	for i, result := range results {
+		require.Equal(t, heights[i], result.Height)
+	}
+
+	for _, i := range []int{0, 3} {
+		require.NotNil(t, results[i].Response)
+		require.Empty(t, results[i].Error)
+		require.NotEmpty(t, results[i].Response.Proof)
+		require.Equal(t, uint64(heights[i]), results[i].Response.ProofHeight)
+	}
+
+	for _, i := range []int{1, 2} {
+		require.Nil(t, results[i].Response)
+		require.NotEmpty(t, results[i].Error)
+	}
+
+	// the client state is the one at the queried height
+	before, err := types.UnpackClientState(results[0].Response.ClientState)
+	require.NoError(t, err)
+	after, err := types.UnpackClientState(results[3].Response.ClientState)
+	require.NoError(t, err)
+	require.Less(t, before.GetLatestHeight(), after.GetLatestHeight())
+
+	bz, err := utils.MarshalClientStateProofResults(codec.NewProtoCodec(chainA.App.InterfaceRegistry()), clientA, results)
+	require.NoError(t, err)
+
+	var decoded []struct {
+		Height      int64           `json:"height"`
+		Available   bool            `json:"available"`
+		ClientState json.RawMessage `json:"client_state"`
+		Proof       []byte          `json:"proof"`
+		ProofHeight uint64          `json:"proof_height"`
+		Error       string          `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Len(t, decoded, len(heights))
+	require.True(t, decoded[0].Available)
+	require.NotEmpty(t, decoded[0].ClientState)
+	require.Equal(t, results[0].Response.Proof, decoded[0].Proof)
+	require.Equal(t, uint64(height), decoded[0].ProofHeight)
+	require.False(t, decoded[1].Available)
+	require.Empty(t, decoded[1].ClientState)
+	require.NotEmpty(t, decoded[1].Error)
+}
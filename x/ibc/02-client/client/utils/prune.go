@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// PruneSavings is the estimate of the consensus states of a client a prune of
+// its expired consensus states would delete, and of the storage it would reclaim.
+// Expired consensus states which are unsafe to prune aren't counted as pruned.
+type PruneSavings struct {
+	ClientID        string                     `json:"client_id" yaml:"client_id"`
+	ConsensusStates int                        `json:"consensus_states" yaml:"consensus_states"`
+	PrunedStates    int                        `json:"pruned_states" yaml:"pruned_states"`
+	ReclaimedBytes  uint64                     `json:"reclaimed_bytes" yaml:"reclaimed_bytes"`
+	Unprunable      []UnprunableConsensusState `json:"unprunable,omitempty" yaml:"unprunable,omitempty"`
+}
+
+// UnprunableConsensusState is an expired consensus state of a client which can't
+// be pruned without breaking relaying, along with the reason why.
+type UnprunableConsensusState struct {
+	Height types.Height `json:"height" yaml:"height"`
+	Reason string       `json:"reason" yaml:"reason"`
+}
+
+// PruneCheck returns whether the consensus state of the client at the given
+// height can be pruned without breaking relaying and, if it can't, the reason.
+type PruneCheck func(height types.Height) (prunable bool, reason string, err error)
+
+// String implements the Stringer interface.
+func (ps PruneSavings) String() string {
+	return fmt.Sprintf(
+		"%d of the %d consensus state(s) of client %s are expired and safe to prune, pruning them would reclaim about %d bytes (%d expired consensus state(s) are unsafe to prune)",
+		ps.PrunedStates, ps.ConsensusStates, ps.ClientID, ps.ReclaimedBytes, len(ps.Unprunable),
+	)
+}
+
+// EstimatePruneSavings returns the number of consensus states of a client that
+// are expired at the given time, that is whose trusting period has elapsed since
+// their timestamp as for ExpiringClients, along with the size of their encoding
+// as stored by the client keeper, compressed or not. The expired consensus states
+// rejected by canPrune are skipped and reported as unprunable. A nil canPrune
+// considers every expired consensus state prunable. Nothing is deleted. An error
+// is returned if the client has no trusting period.
+func EstimatePruneSavings(
+	cdc codec.BinaryMarshaler, clientID string, clientState exported.ClientState,
+	consensusStates []exported.ConsensusState, compressed bool, now time.Time, canPrune PruneCheck,
+) (PruneSavings, error) {
+	savings := PruneSavings{
+		ClientID:        clientID,
+		ConsensusStates: len(consensusStates),
+	}
+
+	for _, consensusState := range consensusStates {
+		expiry, ok := NewClientExpiry(clientID, clientState, consensusState)
+		if !ok {
+			return PruneSavings{}, fmt.Errorf("client %s of type %s has no trusting period", clientID, clientState.ClientType())
+		}
+
+		if expiry.ExpiresAt.After(now) {
+			continue
+		}
+
+		if canPrune != nil {
+			height := types.ConsensusStateEpochHeight(consensusState, consensusState.GetHeight())
+			prunable, reason, err := canPrune(height)
+			if err != nil {
+				return PruneSavings{}, err
+			}
+
+			if !prunable {
+				savings.Unprunable = append(savings.Unprunable, UnprunableConsensusState{Height: height, Reason: reason})
+				continue
+			}
+		}
+
+		size, err := types.ConsensusStateSize(cdc, consensusState, compressed)
+		if err != nil {
+			return PruneSavings{}, err
+		}
+
+		savings.PrunedStates++
+		savings.ReclaimedBytes += uint64(size)
+	}
+
+	return savings, nil
+}
+
+// QueryPruneSavings queries the state of a client along with all its consensus
+// states and returns the estimate of a prune of its expired consensus states at
+// the given time, as described in EstimatePruneSavings. The node is queried for
+// whether each expired consensus state is safe to prune.
+func QueryPruneSavings(clientCtx client.Context, clientID string, now time.Time) (PruneSavings, error) {
+	// the ABCI query returns the client state already unpacked
+	clientStateRes, err := QueryClientState(clientCtx, clientID, true)
+	if err != nil {
+		return PruneSavings{}, err
+	}
+
+	clientState, err := types.UnpackClientState(clientStateRes.ClientState)
+	if err != nil {
+		return PruneSavings{}, err
+	}
+
+	anys, err := queryAllConsensusStates(clientCtx, clientID)
+	if err != nil {
+		return PruneSavings{}, err
+	}
+
+	consensusStates := make([]exported.ConsensusState, len(anys))
+	for i, any := range anys {
+		if err := clientCtx.InterfaceRegistry.UnpackAny(any, &consensusStates[i]); err != nil {
+			return PruneSavings{}, err
+		}
+	}
+
+	paramsRes, err := types.NewQueryClient(clientCtx).Params(context.Background(), &types.QueryParamsRequest{})
+	if err != nil {
+		return PruneSavings{}, err
+	}
+
+	channelQueryClient := channeltypes.NewQueryClient(clientCtx)
+	canPrune := func(height types.Height) (bool, string, error) {
+		res, err := channelQueryClient.ConsensusStatePrunable(context.Background(), &channeltypes.QueryConsensusStatePrunableRequest{
+			ClientId:    clientID,
+			EpochNumber: height.EpochNumber,
+			EpochHeight: height.EpochHeight,
+		})
+		if err != nil {
+			return false, "", err
+		}
+
+		return res.Prunable, res.Reason, nil
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+	return EstimatePruneSavings(cdc, clientID, clientState, consensusStates, paramsRes.Params.CompressConsensusStates, now, canPrune)
+}
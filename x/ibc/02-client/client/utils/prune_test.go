@@ -0,0 +1,101 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestEstimatePruneSavings(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+	)
+
+	// the consensus states are stored a trusting period before they expire
+	consensusStates := []exported.ConsensusState{
+		newConsensusState(1, now.Add(-ibctesting.TrustingPeriod-24*time.Hour)), // expired
+		newConsensusState(2, now.Add(-ibctesting.TrustingPeriod-time.Minute)),  // expired
+		newConsensusState(3, now.Add(-ibctesting.TrustingPeriod)),              // expires exactly now
+		newConsensusState(4, now.Add(-ibctesting.TrustingPeriod+time.Minute)),  // still valid
+		newConsensusState(5, now), // still valid
+	}
+
+	var expBytes uint64
+	for _, consensusState := range consensusStates[:3] {
+		size, err := types.ConsensusStateSize(cdc, consensusState, false)
+		require.NoError(t, err)
+		expBytes += uint64(size)
+	}
+
+	savings, err := utils.EstimatePruneSavings(cdc, clientID, clientState, consensusStates, false, now, nil)
+	require.NoError(t, err)
+	require.Equal(t, utils.PruneSavings{
+		ClientID:        clientID,
+		ConsensusStates: 5,
+		PrunedStates:    3,
+		ReclaimedBytes:  expBytes,
+	}, savings)
+
+	// nothing would be pruned before the oldest consensus state expires
+	savings, err = utils.EstimatePruneSavings(cdc, clientID, clientState, consensusStates, false, now.Add(-48*time.Hour), nil)
+	require.NoError(t, err)
+	require.Zero(t, savings.PrunedStates)
+	require.Zero(t, savings.ReclaimedBytes)
+
+	// the compressed size is reported when consensus states are stored compressed
+	compressed, err := utils.EstimatePruneSavings(cdc, clientID, clientState, consensusStates, true, now, nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, compressed.PrunedStates)
+	require.NotZero(t, compressed.ReclaimedBytes)
+	require.NotEqual(t, expBytes, compressed.ReclaimedBytes)
+
+	// expired consensus states unsafe to prune are reported instead of counted
+	canPrune := func(height types.Height) (bool, string, error) {
+		if height.EQ(types.NewHeight(0, 2)) {
+			return false, "in-flight packets", nil
+		}
+		return true, "", nil
+	}
+
+	size, err := types.ConsensusStateSize(cdc, consensusStates[1], false)
+	require.NoError(t, err)
+
+	savings, err = utils.EstimatePruneSavings(cdc, clientID, clientState, consensusStates, false, now, canPrune)
+	require.NoError(t, err)
+	require.Equal(t, utils.PruneSavings{
+		ClientID:        clientID,
+		ConsensusStates: 5,
+		PrunedStates:    2,
+		ReclaimedBytes:  expBytes - uint64(size),
+		Unprunable: []utils.UnprunableConsensusState{
+			{Height: types.NewHeight(0, 2), Reason: "in-flight packets"},
+		},
+	}, savings)
+
+	// errors checking whether a consensus state is prunable are returned
+	_, err = utils.EstimatePruneSavings(cdc, clientID, clientState, consensusStates, false, now, func(types.Height) (bool, string, error) {
+		return false, "", errors.New("query failed")
+	})
+	require.Error(t, err)
+
+	// clients without a trusting period can't be estimated
+	_, err = utils.EstimatePruneSavings(
+		cdc, exported.ClientTypeLocalHost, localhosttypes.NewClientState(chainID, types.NewHeight(0, 10)), consensusStates, false, now, nil,
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// RegisteredInterface defines a client interface and the proto type URLs of its
+// implementations registered in an interface registry.
+type RegisteredInterface struct {
+	Interface       string   `json:"interface" yaml:"interface"`
+	Implementations []string `json:"implementations" yaml:"implementations"`
+}
+
+// QueryRegisteredClients returns the implementations of the ClientState,
+// ConsensusState and Header interfaces registered in the given interface registry.
+// The implementations of each interface are sorted by type URL.
+func QueryRegisteredClients(registry codectypes.InterfaceRegistry) []RegisteredInterface {
+	ifaces := []string{
+		types.ClientStateInterfaceName,
+		types.ConsensusStateInterfaceName,
+		types.HeaderInterfaceName,
+	}
+
+	registered := make([]RegisteredInterface, len(ifaces))
+	for i, iface := range ifaces {
+		impls := registry.ListImplementations(iface)
+		sort.Strings(impls)
+
+		registered[i] = RegisteredInterface{
+			Interface:       iface,
+			Implementations: impls,
+		}
+	}
+
+	return registered
+}
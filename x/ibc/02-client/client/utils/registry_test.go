@@ -0,0 +1,38 @@
+package utils_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+func TestQueryRegisteredClients(t *testing.T) {
+	registry := simapp.MakeEncodingConfig().InterfaceRegistry
+
+	registered := utils.QueryRegisteredClients(registry)
+	require.Len(t, registered, 3)
+
+	require.Equal(t, types.ClientStateInterfaceName, registered[0].Interface)
+	require.Subset(t, registered[0].Implementations, []string{
+		"/ibc.tendermint.ClientState", "/ibc.lightclients.solomachine.v1.ClientState", "/ibc.localhost.ClientState",
+	})
+
+	require.Equal(t, types.ConsensusStateInterfaceName, registered[1].Interface)
+	require.Subset(t, registered[1].Implementations, []string{
+		"/ibc.tendermint.ConsensusState", "/ibc.lightclients.solomachine.v1.ConsensusState",
+	})
+
+	require.Equal(t, types.HeaderInterfaceName, registered[2].Interface)
+	require.Subset(t, registered[2].Implementations, []string{
+		"/ibc.tendermint.Header", "/ibc.lightclients.solomachine.v1.Header",
+	})
+
+	for _, iface := range registered {
+		require.True(t, sort.StringsAreSorted(iface.Implementations), iface.Interface)
+	}
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ReadHeaders reads a JSON array of headers from the given file. Every header is
+// encoded as a protobuf Any so that the archive may contain any client type.
+func ReadHeaders(cdc codec.Marshaler, file string) ([]exported.Header, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawHeaders []json.RawMessage
+	if err := json.Unmarshal(bz, &rawHeaders); err != nil {
+		return nil, fmt.Errorf("failed to decode headers file, expected a JSON array: %w", err)
+	}
+
+	if len(rawHeaders) == 0 {
+		return nil, fmt.Errorf("no header found in %s", file)
+	}
+
+	headers := make([]exported.Header, len(rawHeaders))
+	for i, rawHeader := range rawHeaders {
+		any := &codectypes.Any{}
+		if err := cdc.UnmarshalJSON(rawHeader, any); err != nil {
+			return nil, fmt.Errorf("failed to decode header %d: %w", i, err)
+		}
+
+		if err := cdc.UnpackAny(any, &headers[i]); err != nil {
+			return nil, fmt.Errorf("failed to unpack header %d: %w", i, err)
+		}
+	}
+
+	return headers, nil
+}
+
+// ReplayHeaders submits the headers in order, starting from the given client
+// height. Every header must pass basic validation and advance the height reached
+// by the previous ones before it is submitted. A header equal to the previously
+// submitted one is a duplicate submission and is skipped as a no-op. The replay
+// stops on the first header that is rejected or fails to be submitted, and the
+// height reached by the last successful update is returned along with the error.
+func ReplayHeaders(
+	height uint64, headers []exported.Header, submit func(exported.Header) error,
+) (uint64, error) {
+	var previous exported.Header
+	for i, header := range headers {
+		if err := header.ValidateBasic(); err != nil {
+			return height, fmt.Errorf("header %d is invalid: %w", i, err)
+		}
+
+		if previous != nil && types.HeadersEqual(header, previous) {
+			continue
+		}
+
+		if header.GetHeight() <= height {
+			return height, fmt.Errorf(
+				"header %d at height %d doesn't advance the client height %d", i, header.GetHeight(), height,
+			)
+		}
+
+		if err := submit(header); err != nil {
+			return height, fmt.Errorf("failed to update the client with header %d at height %d: %w", i, header.GetHeight(), err)
+		}
+
+		height = header.GetHeight()
+		previous = header
+	}
+
+	return height, nil
+}
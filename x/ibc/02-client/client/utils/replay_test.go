@@ -0,0 +1,168 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestReadHeaders(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(0))
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	dir := t.TempDir()
+
+	var (
+		expHeaders []exported.Header
+		rawHeaders []json.RawMessage
+	)
+	for i := 0; i < 2; i++ {
+		coordinator.CommitBlock(chain)
+
+		any, err := types.PackHeader(chain.LastHeader)
+		require.NoError(t, err)
+
+		expHeaders = append(expHeaders, chain.LastHeader)
+		rawHeaders = append(rawHeaders, cdc.MustMarshalJSON(any))
+	}
+
+	bz, err := json.Marshal(rawHeaders)
+	require.NoError(t, err)
+
+	file := filepath.Join(dir, "headers.json")
+	require.NoError(t, ioutil.WriteFile(file, bz, 0600))
+
+	headers, err := utils.ReadHeaders(cdc, file)
+	require.NoError(t, err)
+	require.Equal(t, expHeaders, headers)
+
+	invalid := map[string]string{
+		"empty.json":     "[]",
+		"object.json":    "{}",
+		"unknown.json":   `[{"@type": "/ibc.unknown.Header"}]`,
+		"notjson.json":   "headers",
+		"nonheader.json": `[{"@type": "/ibc.tendermint.ClientState"}]`,
+	}
+	for name, contents := range invalid {
+		file := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(file, []byte(contents), 0600))
+
+		_, err := utils.ReadHeaders(cdc, file)
+		require.Error(t, err, name)
+	}
+
+	_, err = utils.ReadHeaders(cdc, filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
+
+func TestReplayHeaders(t *testing.T) {
+	var (
+		coordinator    *ibctesting.Coordinator
+		chainA, chainB *ibctesting.TestChain
+		clientID       string
+	)
+
+	// setup creates a client of chainB on chainA and returns the headers of the
+	// following blocks of chainB, each trusting the previous one.
+	setup := func(count int) []*ibctmtypes.Header {
+		coordinator = ibctesting.NewCoordinator(t, 3)
+		chainA = coordinator.GetChain(ibctesting.GetChainID(0))
+		chainB = coordinator.GetChain(ibctesting.GetChainID(1))
+
+		var err error
+		clientID, err = coordinator.CreateClient(chainA, chainB, exported.Tendermint)
+		require.NoError(t, err)
+
+		trustedHeight := chainA.GetClientState(clientID).GetLatestHeight()
+
+		headers := make([]*ibctmtypes.Header, count)
+		for i := range headers {
+			coordinator.CommitBlock(chainA, chainB)
+
+			header := *chainB.LastHeader
+			header.TrustedHeight = types.NewHeight(0, trustedHeight)
+			header.TrustedValidators = header.ValidatorSet
+
+			headers[i] = &header
+			trustedHeight = header.GetHeight()
+		}
+
+		return headers
+	}
+
+	submit := func(header exported.Header) error {
+		_, err := chainA.App.IBCKeeper.ClientKeeper.UpdateClient(chainA.GetContext(), clientID, header)
+		return err
+	}
+
+	// a valid sequence rebuilds the client history up to the last header
+	headers := setup(3)
+	startHeight := chainA.GetClientState(clientID).GetLatestHeight()
+
+	height, err := utils.ReplayHeaders(startHeight, []exported.Header{headers[0], headers[1], headers[2]}, submit)
+	require.NoError(t, err)
+	require.Equal(t, headers[2].GetHeight(), height)
+	require.Equal(t, height, chainA.GetClientState(clientID).GetLatestHeight())
+
+	for _, header := range headers {
+		_, found := chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(chainA.GetContext(), clientID, header.GetHeight())
+		require.True(t, found)
+	}
+
+	// a duplicate of the previously submitted header is skipped as a no-op
+	headers = setup(2)
+	startHeight = chainA.GetClientState(clientID).GetLatestHeight()
+
+	duplicate := *headers[0]
+	var submitted int
+	height, err = utils.ReplayHeaders(startHeight, []exported.Header{headers[0], &duplicate, headers[1], headers[1]}, func(header exported.Header) error {
+		submitted++
+		return submit(header)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, submitted)
+	require.Equal(t, headers[1].GetHeight(), height)
+
+	// a header failing verification midway stops the replay at the previous height
+	headers = setup(3)
+	startHeight = chainA.GetClientState(clientID).GetLatestHeight()
+
+	trustedVals, err := coordinator.GetChain(ibctesting.GetChainID(2)).Vals.ToProto()
+	require.NoError(t, err)
+	headers[1].TrustedValidators = trustedVals
+
+	submitted = 0
+	height, err = utils.ReplayHeaders(startHeight, []exported.Header{headers[0], headers[1], headers[2]}, func(header exported.Header) error {
+		submitted++
+		return submit(header)
+	})
+	require.Error(t, err)
+	require.Equal(t, 2, submitted)
+	require.Equal(t, headers[0].GetHeight(), height)
+	require.Equal(t, height, chainA.GetClientState(clientID).GetLatestHeight())
+
+	// headers that don't advance the height are rejected before being submitted
+	height, err = utils.ReplayHeaders(height, []exported.Header{headers[0]}, func(exported.Header) error {
+		t.Fatal("header not advancing the height must not be submitted")
+		return nil
+	})
+	require.Error(t, err)
+	require.Equal(t, headers[0].GetHeight(), height)
+
+	// invalid headers are rejected before being submitted
+	_, err = utils.ReplayHeaders(height, []exported.Header{&ibctmtypes.Header{}}, func(exported.Header) error {
+		t.Fatal("invalid header must not be submitted")
+		return nil
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// FindConsensusStateByRoot returns the first of the given consensus states whose
+// commitment root hash is equal to the given hash. Consensus states without a
+// commitment root never match. It returns false if no consensus state matches.
+func FindConsensusStateByRoot(consensusStates []*codectypes.Any, rootHash []byte) (*codectypes.Any, bool, error) {
+	for _, anyConsensusState := range consensusStates {
+		consensusState, err := types.UnpackConsensusState(anyConsensusState)
+		if err != nil {
+			return nil, false, err
+		}
+
+		root := consensusState.GetRoot()
+		if root == nil || root.Empty() {
+			continue
+		}
+
+		if bytes.Equal(root.GetHash(), rootHash) {
+			return anyConsensusState, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// QueryConsensusStateByRoot returns the consensus state of a client whose
+// commitment root hash is equal to the given hash. It performs a linear scan of
+// the consensus states of the client, page by page, until a match is found.
+func QueryConsensusStateByRoot(clientCtx client.Context, clientID string, rootHash []byte) (*codectypes.Any, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	pageReq := &query.PageRequest{}
+
+	for {
+		res, err := queryClient.ConsensusStates(context.Background(), &types.QueryConsensusStatesRequest{
+			ClientId:   clientID,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		consensusState, found, err := FindConsensusStateByRoot(res.ConsensusStates, rootHash)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			return consensusState, nil
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nil, sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client %s has no consensus state with root %X", clientID, rootHash)
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
@@ -0,0 +1,50 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestFindConsensusStateByRoot(t *testing.T) {
+	now := time.Now().UTC()
+
+	var consensusStates []*codectypes.Any
+	for i, root := range []string{"root_a", "root_b", "root_c"} {
+		any, err := types.PackConsensusState(ibctmtypes.NewConsensusState(
+			now.Add(time.Duration(i)*time.Minute), commitmenttypes.NewMerkleRoot([]byte(root)), types.NewHeight(0, uint64(i+1)), nil,
+		))
+		require.NoError(t, err)
+		consensusStates = append(consensusStates, any)
+	}
+
+	// consensus states without a root never match
+	solomachine := ibctesting.NewSolomachine(t, "solomachine")
+	any, err := types.PackConsensusState(solomachine.ConsensusState())
+	require.NoError(t, err)
+	consensusStates = append([]*codectypes.Any{any}, consensusStates...)
+
+	consensusState, found, err := utils.FindConsensusStateByRoot(consensusStates, []byte("root_b"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, consensusStates[2], consensusState)
+
+	_, found, err = utils.FindConsensusStateByRoot(consensusStates, []byte("root_d"))
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, found, err = utils.FindConsensusStateByRoot(consensusStates, nil)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, _, err = utils.FindConsensusStateByRoot([]*codectypes.Any{{}}, []byte("root_a"))
+	require.Error(t, err)
+}
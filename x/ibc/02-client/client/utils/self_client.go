@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+// ReadSelfClientStateFile reads a Tendermint client state from the JSON file at
+// the given path.
+func ReadSelfClientStateFile(cdc codec.JSONMarshaler, path string) (*ibctmtypes.ClientState, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	clientState := &ibctmtypes.ClientState{}
+	if err := cdc.UnmarshalJSON(bz, clientState); err != nil {
+		return nil, fmt.Errorf("failed to decode client state file %s: %w", path, err)
+	}
+
+	return clientState, nil
+}
+
+// CompareSelfClientState returns the mismatches between a client state of this
+// chain and the view of the node, given its chain ID and latest height. The chain
+// ID must match, the latest height of the client state must not be greater than
+// the node height and the proof specs must be the SDK proof specs.
+func CompareSelfClientState(clientState *ibctmtypes.ClientState, chainID string, nodeHeight uint64) []error {
+	var errs []error
+
+	if clientState.ChainId != chainID {
+		errs = append(errs, fmt.Errorf("chain ID mismatch: expected %s, got %s", chainID, clientState.ChainId))
+	}
+
+	// for now, assume the epoch number of the node is zero
+	if selfHeight := types.NewHeight(0, nodeHeight); clientState.LatestHeight.GT(selfHeight) {
+		errs = append(errs, fmt.Errorf("latest height %s is greater than the node height %s", clientState.LatestHeight, selfHeight))
+	}
+
+	if expectedProofSpecs := commitmenttypes.GetSDKSpecs(); !reflect.DeepEqual(expectedProofSpecs, clientState.ProofSpecs) {
+		errs = append(errs, fmt.Errorf("proof specs mismatch: expected %v, got %v", expectedProofSpecs, clientState.ProofSpecs))
+	}
+
+	return errs
+}
+
+// ValidateSelfClientState compares a client state of this chain with the chain ID
+// and latest height of the node. The mismatches are returned as a list of errors.
+// An error is only returned if the node status can't be queried.
+func ValidateSelfClientState(clientCtx client.Context, clientState *ibctmtypes.ClientState) ([]error, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	return CompareSelfClientState(clientState, status.NodeInfo.Network, uint64(status.SyncInfo.LatestBlockHeight)), nil
+}
@@ -0,0 +1,79 @@
+package utils_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestCompareSelfClientState(t *testing.T) {
+	const nodeHeight = 100
+
+	newClientState := func(chainID string, height uint64) *ibctmtypes.ClientState {
+		return ibctmtypes.NewClientState(
+			chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+			types.NewHeight(0, height), commitmenttypes.GetSDKSpecs(),
+		)
+	}
+
+	testCases := []struct {
+		name        string
+		clientState *ibctmtypes.ClientState
+		expErrs     int
+	}{
+		{"matching client state", newClientState(chainID, nodeHeight), 0},
+		{"latest height lower than the node height", newClientState(chainID, nodeHeight-10), 0},
+		{"mismatched chain ID", newClientState("otherchain", nodeHeight), 1},
+		{"latest height greater than the node height", newClientState(chainID, nodeHeight+1), 1},
+		{"mismatched proof specs", func() *ibctmtypes.ClientState {
+			clientState := newClientState(chainID, nodeHeight)
+			clientState.ProofSpecs = []*ics23.ProofSpec{ics23.TendermintSpec}
+			return clientState
+		}(), 1},
+		{"all mismatched", func() *ibctmtypes.ClientState {
+			clientState := newClientState("otherchain", nodeHeight+1)
+			clientState.ProofSpecs = nil
+			return clientState
+		}(), 3},
+	}
+
+	for _, tc := range testCases {
+		errs := utils.CompareSelfClientState(tc.clientState, chainID, nodeHeight)
+		require.Len(t, errs, tc.expErrs, tc.name)
+	}
+
+	errs := utils.CompareSelfClientState(newClientState("otherchain", nodeHeight), chainID, nodeHeight)
+	require.Contains(t, errs[0].Error(), "chain ID mismatch: expected testchain, got otherchain")
+}
+
+func TestReadSelfClientStateFile(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		types.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(),
+	)
+
+	bz, err := cdc.MarshalJSON(clientState)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "client_state.json")
+	require.NoError(t, ioutil.WriteFile(path, bz, 0600))
+
+	read, err := utils.ReadSelfClientStateFile(cdc, path)
+	require.NoError(t, err)
+	require.Empty(t, utils.CompareSelfClientState(read, chainID, 10))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("{not json"), 0600))
+	_, err = utils.ReadSelfClientStateFile(cdc, path)
+	require.Error(t, err)
+}
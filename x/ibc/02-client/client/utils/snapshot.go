@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// ClientHeightsSnapshot maps the identifier of every client of a chain to its
+// latest height. Snapshots taken on two nodes can be diffed to compare the
+// progress of their clients.
+type ClientHeightsSnapshot map[string]types.Height
+
+// NewClientHeightsSnapshot returns the snapshot of the latest heights of the
+// given clients.
+func NewClientHeightsSnapshot(clientStates []*types.IdentifiedClientState) (ClientHeightsSnapshot, error) {
+	snapshot := make(ClientHeightsSnapshot, len(clientStates))
+	for _, identifiedClientState := range clientStates {
+		clientState, err := types.UnpackClientState(identifiedClientState.ClientState)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := snapshot[identifiedClientState.ClientId]; ok {
+			return nil, fmt.Errorf("duplicate client %s", identifiedClientState.ClientId)
+		}
+
+		// for now, assume the epoch number of the latest height is zero
+		snapshot[identifiedClientState.ClientId] = types.NewHeight(0, clientState.GetLatestHeight())
+	}
+
+	return snapshot, nil
+}
+
+// WriteClientHeightsSnapshot writes the snapshot in JSON format to the file at
+// the given path. The clients are sorted by identifier so that snapshots of
+// different nodes can be diffed line by line.
+func WriteClientHeightsSnapshot(path string, snapshot ClientHeightsSnapshot) error {
+	// encoding/json sorts the keys of maps
+	bz, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// ReadClientHeightsSnapshot reads a snapshot from the JSON file at the given path.
+func ReadClientHeightsSnapshot(path string) (ClientHeightsSnapshot, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot ClientHeightsSnapshot
+	if err := json.Unmarshal(bz, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode client heights snapshot %s: %w", path, err)
+	}
+
+	return snapshot, nil
+}
+
+// QueryClientHeightsSnapshot queries the states of all the clients of the chain
+// and returns the snapshot of their latest heights.
+func QueryClientHeightsSnapshot(clientCtx client.Context) (ClientHeightsSnapshot, error) {
+	clientStates, err := queryAllClientStates(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientHeightsSnapshot(clientStates)
+}
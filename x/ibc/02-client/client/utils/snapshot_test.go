@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestClientHeightsSnapshot(t *testing.T) {
+	newClientState := func(id string, height uint64) *types.IdentifiedClientState {
+		clientState := ibctmtypes.NewClientState(
+			chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+			types.NewHeight(0, height), commitmenttypes.GetSDKSpecs(),
+		)
+		identifiedClientState := types.NewIdentifiedClientState(id, clientState)
+		return &identifiedClientState
+	}
+
+	localhost := types.NewIdentifiedClientState("localhost", localhosttypes.NewClientState(chainID, types.NewHeight(0, 30)))
+	clientStates := []*types.IdentifiedClientState{
+		newClientState("clientidb", 25), newClientState("clientida", 10), &localhost,
+	}
+
+	snapshot, err := utils.NewClientHeightsSnapshot(clientStates)
+	require.NoError(t, err)
+	require.Equal(t, utils.ClientHeightsSnapshot{
+		"clientida": types.NewHeight(0, 10),
+		"clientidb": types.NewHeight(0, 25),
+		"localhost": types.NewHeight(0, 30),
+	}, snapshot)
+
+	path := filepath.Join(t.TempDir(), "client_heights.json")
+	require.NoError(t, utils.WriteClientHeightsSnapshot(path, snapshot))
+
+	read, err := utils.ReadClientHeightsSnapshot(path)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, read)
+
+	// the file is a plain JSON map of client identifier to height
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	var file map[string]struct {
+		EpochNumber uint64 `json:"epoch_number"`
+		EpochHeight uint64 `json:"epoch_height"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &file))
+	require.Len(t, file, 3)
+	require.Equal(t, uint64(25), file["clientidb"].EpochHeight)
+
+	// empty chain
+	snapshot, err = utils.NewClientHeightsSnapshot(nil)
+	require.NoError(t, err)
+	require.Empty(t, snapshot)
+
+	// a client can't appear twice
+	_, err = utils.NewClientHeightsSnapshot(append(clientStates, newClientState("clientida", 12)))
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// IsClientStale returns true if the latest consensus state of a client is older
+// than the given maximum age at the given time. A consensus state exactly the
+// maximum age old is not stale.
+func IsClientStale(latest exported.ConsensusState, maxAge time.Duration, now time.Time) bool {
+	updatedAt := time.Unix(0, int64(latest.GetTimestamp()))
+	return now.Sub(updatedAt) > maxAge
+}
+
+// QueryIsClientStale queries the latest consensus state of a client and returns
+// whether it is older than the given maximum age at the given time.
+func QueryIsClientStale(clientCtx client.Context, clientID string, maxAge time.Duration, now time.Time) (bool, error) {
+	if maxAge <= 0 {
+		return false, fmt.Errorf("maximum age must be positive, got: %s", maxAge)
+	}
+
+	res, err := QueryConsensusState(clientCtx, clientID, 0, false, true)
+	if err != nil {
+		return false, err
+	}
+
+	latest, err := types.UnpackConsensusState(res.ConsensusState)
+	if err != nil {
+		return false, err
+	}
+
+	return IsClientStale(latest, maxAge, now), nil
+}
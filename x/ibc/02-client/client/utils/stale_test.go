@@ -0,0 +1,35 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+)
+
+func TestIsClientStale(t *testing.T) {
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+	maxAge := 10 * time.Minute
+
+	testCases := []struct {
+		name      string
+		updatedAt time.Time
+		expStale  bool
+	}{
+		{"stale client", now.Add(-time.Hour), true},
+		{"updated just over the maximum age ago", now.Add(-maxAge - time.Nanosecond), true},
+		{"updated exactly the maximum age ago", now.Add(-maxAge), false},
+		{"fresh client", now.Add(-time.Minute), false},
+		{"updated in the future", now.Add(time.Minute), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expStale, utils.IsClientStale(newConsensusState(10, tc.updatedAt), maxAge, now))
+		})
+	}
+}
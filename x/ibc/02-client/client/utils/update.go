@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// SplitUpdateRange returns the heights of the headers needed to advance a client
+// from the from height up to and including the to height. The heights are grouped
+// by epoch so that every group can be submitted in its own transaction.
+//
+// Headers within the same epoch are consecutive. When the range crosses into the
+// next epoch, epochEndHeight must be set to the last height of the from epoch and
+// the headers of the next epoch start at height 1. Ranges spanning more than two
+// epochs are rejected as the last height of the intermediate epochs is unknown.
+func SplitUpdateRange(from, to types.Height, epochEndHeight uint64) ([][]types.Height, error) {
+	if to.EpochHeight == 0 {
+		return nil, fmt.Errorf("target height %s cannot have a zero epoch height", to)
+	}
+
+	if to.LTE(from) {
+		return nil, fmt.Errorf("target height %s must be greater than the current height %s", to, from)
+	}
+
+	if from.EpochNumber == to.EpochNumber {
+		return [][]types.Height{heightRange(from.EpochNumber, from.EpochHeight+1, to.EpochHeight)}, nil
+	}
+
+	if to.EpochNumber != from.EpochNumber+1 {
+		return nil, fmt.Errorf(
+			"cannot update a client across more than one epoch (epoch %d to %d)", from.EpochNumber, to.EpochNumber,
+		)
+	}
+
+	if epochEndHeight < from.EpochHeight {
+		return nil, fmt.Errorf(
+			"epoch %d end height %d cannot be lower than the current height %s", from.EpochNumber, epochEndHeight, from,
+		)
+	}
+
+	var batches [][]types.Height
+	if epochEndHeight > from.EpochHeight {
+		batches = append(batches, heightRange(from.EpochNumber, from.EpochHeight+1, epochEndHeight))
+	}
+
+	return append(batches, heightRange(to.EpochNumber, 1, to.EpochHeight)), nil
+}
+
+// heightRange returns the heights of the given epoch between start and end,
+// inclusive.
+func heightRange(epoch, start, end uint64) []types.Height {
+	heights := make([]types.Height, 0, end-start+1)
+	for h := start; h <= end; h++ {
+		heights = append(heights, types.NewHeight(epoch, h))
+	}
+	return heights
+}
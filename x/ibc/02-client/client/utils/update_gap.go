@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// UpdateGap is the time elapsed between two consecutive consensus states of a
+// client.
+type UpdateGap struct {
+	FromHeight uint64        `json:"from_height" yaml:"from_height"`
+	ToHeight   uint64        `json:"to_height" yaml:"to_height"`
+	Gap        time.Duration `json:"gap" yaml:"gap"`
+}
+
+// Format returns the JSON encoding of the update gap, with the gap in
+// nanoseconds. If human is true, it returns a single line with the gap formatted
+// as a duration instead.
+func (ug UpdateGap) Format(human bool) (string, error) {
+	if human {
+		return fmt.Sprintf("%s between heights %d and %d", ug.Gap, ug.FromHeight, ug.ToHeight), nil
+	}
+
+	bz, err := json.MarshalIndent(ug, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// MaxUpdateGap returns the largest time delta between consecutive consensus
+// states, ordered by height. If several gaps are equal, the lowest one is
+// returned. It returns false if there are less than two consensus states.
+func MaxUpdateGap(anyConsensusStates []*codectypes.Any) (UpdateGap, bool, error) {
+	consensusStates := make([]exported.ConsensusState, len(anyConsensusStates))
+	for i, anyConsensusState := range anyConsensusStates {
+		consensusState, err := types.UnpackConsensusState(anyConsensusState)
+		if err != nil {
+			return UpdateGap{}, false, err
+		}
+		consensusStates[i] = consensusState
+	}
+
+	// consensus states are not stored in numerical order of height
+	sort.SliceStable(consensusStates, func(i, j int) bool {
+		return consensusStates[i].GetHeight() < consensusStates[j].GetHeight()
+	})
+
+	var (
+		max   UpdateGap
+		found bool
+	)
+
+	for i := 1; i < len(consensusStates); i++ {
+		prev, next := consensusStates[i-1], consensusStates[i]
+		gap := time.Duration(int64(next.GetTimestamp()) - int64(prev.GetTimestamp()))
+
+		if !found || gap > max.Gap {
+			max = UpdateGap{FromHeight: prev.GetHeight(), ToHeight: next.GetHeight(), Gap: gap}
+			found = true
+		}
+	}
+
+	return max, found, nil
+}
+
+// QueryMaxUpdateGap queries all the consensus states of a client and returns the
+// largest time delta between consecutive ones. It returns false if the client has
+// less than two consensus states.
+func QueryMaxUpdateGap(clientCtx client.Context, clientID string) (UpdateGap, bool, error) {
+	consensusStates, err := queryAllConsensusStates(clientCtx, clientID)
+	if err != nil {
+		return UpdateGap{}, false, err
+	}
+
+	return MaxUpdateGap(consensusStates)
+}
+
+// queryAllConsensusStates queries all the consensus states of a client, page by
+// page.
+func queryAllConsensusStates(clientCtx client.Context, clientID string) ([]*codectypes.Any, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	var (
+		consensusStates []*codectypes.Any
+		pageReq         = &query.PageRequest{}
+	)
+
+	for {
+		res, err := queryClient.ConsensusStates(context.Background(), &types.QueryConsensusStatesRequest{
+			ClientId:   clientID,
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		consensusStates = append(consensusStates, res.ConsensusStates...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return consensusStates, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
@@ -0,0 +1,82 @@
+package utils_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+func TestMaxUpdateGap(t *testing.T) {
+	start := time.Now().UTC()
+
+	// packConsensusStates returns consensus states at the given heights, each
+	// created at the given offset from the start time
+	packConsensusStates := func(heights []uint64, offsets []time.Duration) []*codectypes.Any {
+		var consensusStates []*codectypes.Any
+		for i, height := range heights {
+			any, err := types.PackConsensusState(ibctmtypes.NewConsensusState(
+				start.Add(offsets[i]), commitmenttypes.NewMerkleRoot([]byte("root")), types.NewHeight(0, height), nil,
+			))
+			require.NoError(t, err)
+			consensusStates = append(consensusStates, any)
+		}
+		return consensusStates
+	}
+
+	testCases := []struct {
+		name     string
+		heights  []uint64
+		offsets  []time.Duration
+		expGap   utils.UpdateGap
+		expFound bool
+	}{
+		{"no consensus states", nil, nil, utils.UpdateGap{}, false},
+		{"single consensus state", []uint64{1}, []time.Duration{0}, utils.UpdateGap{}, false},
+		{
+			"two consensus states",
+			[]uint64{1, 2}, []time.Duration{0, time.Minute},
+			utils.UpdateGap{FromHeight: 1, ToHeight: 2, Gap: time.Minute},
+			true,
+		},
+		{
+			"largest gap in the middle",
+			[]uint64{1, 2, 5, 6}, []time.Duration{0, time.Minute, time.Hour, time.Hour + time.Second},
+			utils.UpdateGap{FromHeight: 2, ToHeight: 5, Gap: time.Hour - time.Minute},
+			true,
+		},
+		{
+			"consensus states ordered by height",
+			[]uint64{12, 2, 5}, []time.Duration{3 * time.Hour, 0, time.Hour},
+			utils.UpdateGap{FromHeight: 5, ToHeight: 12, Gap: 2 * time.Hour},
+			true,
+		},
+		{
+			"lowest of equal gaps",
+			[]uint64{1, 2, 3}, []time.Duration{0, time.Minute, 2 * time.Minute},
+			utils.UpdateGap{FromHeight: 1, ToHeight: 2, Gap: time.Minute},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		gap, found, err := utils.MaxUpdateGap(packConsensusStates(tc.heights, tc.offsets))
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expGap, gap, tc.name)
+	}
+
+	// consensus states that can't be unpacked are rejected
+	_, _, err := utils.MaxUpdateGap([]*codectypes.Any{{}})
+	require.Error(t, err)
+
+	human, err := utils.UpdateGap{FromHeight: 2, ToHeight: 5, Gap: 90 * time.Second}.Format(true)
+	require.NoError(t, err)
+	require.Equal(t, "1m30s between heights 2 and 5", human)
+}
@@ -0,0 +1,58 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+func TestSplitUpdateRange(t *testing.T) {
+	testCases := []struct {
+		name           string
+		from, to       types.Height
+		epochEndHeight uint64
+		expBatches     [][]types.Height
+		expPass        bool
+	}{
+		{
+			"same epoch catch-up",
+			types.NewHeight(1, 10), types.NewHeight(1, 13), 0,
+			[][]types.Height{{types.NewHeight(1, 11), types.NewHeight(1, 12), types.NewHeight(1, 13)}},
+			true,
+		},
+		{
+			"cross epoch catch-up",
+			types.NewHeight(1, 10), types.NewHeight(2, 2), 12,
+			[][]types.Height{
+				{types.NewHeight(1, 11), types.NewHeight(1, 12)},
+				{types.NewHeight(2, 1), types.NewHeight(2, 2)},
+			},
+			true,
+		},
+		{
+			"cross epoch from the last epoch height",
+			types.NewHeight(1, 10), types.NewHeight(2, 1), 10,
+			[][]types.Height{{types.NewHeight(2, 1)}},
+			true,
+		},
+		{"target height not greater", types.NewHeight(1, 10), types.NewHeight(1, 10), 0, nil, false},
+		{"target height in lower epoch", types.NewHeight(2, 1), types.NewHeight(1, 20), 0, nil, false},
+		{"target epoch height is zero", types.NewHeight(1, 10), types.NewHeight(2, 0), 12, nil, false},
+		{"epoch end height lower than current height", types.NewHeight(1, 10), types.NewHeight(2, 2), 9, nil, false},
+		{"more than one epoch", types.NewHeight(1, 10), types.NewHeight(3, 2), 12, nil, false},
+	}
+
+	for _, tc := range testCases {
+		batches, err := utils.SplitUpdateRange(tc.from, tc.to, tc.epochEndHeight)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expBatches, batches, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
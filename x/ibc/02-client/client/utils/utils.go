@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
@@ -125,6 +127,29 @@ func QueryConsensusStateABCI(
 	return types.NewQueryConsensusStateResponse(clientID, anyConsensusState, proofBz, res.Height), nil
 }
 
+// QueryConsensusStateLegacyHeight returns the height under which the consensus
+// state of a client at the given epoch-aware height is stored, using the index of
+// consensus states ordered by height.
+func QueryConsensusStateLegacyHeight(
+	clientCtx client.Context, clientID string, height types.Height,
+) (uint64, error) {
+	req := abci.RequestQuery{
+		Path: "store/ibc/key",
+		Data: host.KeyConsensusHeight(clientID, height.Bytes()),
+	}
+
+	res, err := clientCtx.QueryABCI(req)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(res.Value) == 0 {
+		return 0, sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client %s has no consensus state at height %s", clientID, height)
+	}
+
+	return sdk.BigEndianToUint64(res.Value), nil
+}
+
 // QueryTendermintHeader takes a client context and returns the appropriate
 // tendermint header
 func QueryTendermintHeader(clientCtx client.Context) (ibctmtypes.Header, int64, error) {
@@ -140,23 +165,40 @@ func QueryTendermintHeader(clientCtx client.Context) (ibctmtypes.Header, int64,
 
 	height := info.Response.LastBlockHeight
 
-	commit, err := node.Commit(&height)
+	header, err := QueryTendermintHeaderAtHeight(clientCtx, height)
 	if err != nil {
 		return ibctmtypes.Header{}, 0, err
 	}
 
+	return header, height, nil
+}
+
+// QueryTendermintHeaderAtHeight takes a client context and returns the Tendermint
+// header of the chain at the given height. The trusted height and validators of
+// the returned header are left empty.
+func QueryTendermintHeaderAtHeight(clientCtx client.Context, height int64) (ibctmtypes.Header, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return ibctmtypes.Header{}, err
+	}
+
+	commit, err := node.Commit(&height)
+	if err != nil {
+		return ibctmtypes.Header{}, err
+	}
+
 	page := 0
 	count := 10_000
 
 	validators, err := node.Validators(&height, &page, &count)
 	if err != nil {
-		return ibctmtypes.Header{}, 0, err
+		return ibctmtypes.Header{}, err
 	}
 
 	protoCommit := commit.SignedHeader.ToProto()
 	protoValset, err := tmtypes.NewValidatorSet(validators.Validators).ToProto()
 	if err != nil {
-		return ibctmtypes.Header{}, 0, err
+		return ibctmtypes.Header{}, err
 	}
 
 	header := ibctmtypes.Header{
@@ -164,7 +206,7 @@ func QueryTendermintHeader(clientCtx client.Context) (ibctmtypes.Header, int64,
 		ValidatorSet: protoValset,
 	}
 
-	return header, height, nil
+	return header, nil
 }
 
 // QueryNodeConsensusState takes a client context and returns the appropriate
@@ -182,25 +224,42 @@ func QueryNodeConsensusState(clientCtx client.Context) (*ibctmtypes.ConsensusSta
 
 	height := info.Response.LastBlockHeight
 
-	commit, err := node.Commit(&height)
+	state, err := QueryNodeConsensusStateAtHeight(clientCtx, height)
 	if err != nil {
 		return &ibctmtypes.ConsensusState{}, 0, err
 	}
 
+	return state, height, nil
+}
+
+// QueryNodeConsensusStateAtHeight takes a client context and returns the
+// tendermint consensus state of the node at the given height.
+func QueryNodeConsensusStateAtHeight(clientCtx client.Context, height int64) (*ibctmtypes.ConsensusState, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return &ibctmtypes.ConsensusState{}, err
+	}
+
+	commit, err := node.Commit(&height)
+	if err != nil {
+		return &ibctmtypes.ConsensusState{}, err
+	}
+
 	page := 0
 	count := 10_000
 
 	nextHeight := height + 1
 	nextVals, err := node.Validators(&nextHeight, &page, &count)
 	if err != nil {
-		return &ibctmtypes.ConsensusState{}, 0, err
+		return &ibctmtypes.ConsensusState{}, err
 	}
 
 	state := &ibctmtypes.ConsensusState{
+		Height:             types.NewHeight(0, uint64(height)),
 		Timestamp:          commit.Time,
 		Root:               commitmenttypes.NewMerkleRoot(commit.AppHash),
 		NextValidatorsHash: tmtypes.NewValidatorSet(nextVals.Validators).Hash(),
 	}
 
-	return state, height, nil
+	return state, nil
 }
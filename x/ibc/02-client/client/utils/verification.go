@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// DefaultVerificationCacheSize is the number of successful verifications
+	// remembered by the cache used by QueryVerifyPacketAcknowledgement.
+	DefaultVerificationCacheSize = 1024
+	// DefaultVerificationCacheTTL is the expiry of the successful verifications
+	// remembered by the cache used by QueryVerifyPacketAcknowledgement.
+	DefaultVerificationCacheTTL = time.Minute
+)
+
+// defaultVerificationCache is the cache used by QueryVerifyPacketAcknowledgement.
+var defaultVerificationCache = mustNewVerificationCache(DefaultVerificationCacheSize, DefaultVerificationCacheTTL)
+
+// VerificationKey identifies a proof verification. Two verifications share a
+// cache entry only if all of their fields are equal.
+type VerificationKey struct {
+	ClientID string
+	Height   uint64
+	Proof    []byte
+	// Target is the serialized prefix, path and value proven by the proof, as
+	// returned by NewVerificationTarget.
+	Target []byte
+}
+
+// NewVerificationTarget serializes the commitment prefix, the path and the value
+// proven by a proof into the target of a VerificationKey. The fields are length
+// prefixed so that different field splits never collide.
+func NewVerificationTarget(prefix, path, value []byte) []byte {
+	target := make([]byte, 0, 3*8+len(prefix)+len(path)+len(value))
+	for _, field := range [][]byte{prefix, path, value} {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(field)))
+		target = append(target, length[:]...)
+		target = append(target, field...)
+	}
+	return target
+}
+
+// hash returns a collision resistant digest of the verification key. Variable
+// length fields are length prefixed so that different field splits never collide.
+func (k VerificationKey) hash() [sha256.Size]byte {
+	h := sha256.New()
+	for _, field := range [][]byte{[]byte(k.ClientID), k.Proof, k.Target} {
+		writeUint64(h, uint64(len(field)))
+		h.Write(field)
+	}
+	writeUint64(h, k.Height)
+
+	var digest [sha256.Size]byte
+	copy(digest[:], h.Sum(nil))
+	return digest
+}
+
+func writeUint64(h hash.Hash, n uint64) {
+	var bz [8]byte
+	binary.BigEndian.PutUint64(bz[:], n)
+	h.Write(bz[:])
+}
+
+// VerificationCache memoizes the successful proof verifications for a given TTL
+// so that retrying a relay doesn't verify the same proof more than once. Failed
+// verifications are never cached, as they may succeed once the client is updated.
+// The cache is bounded: once full, the least recently used verification is
+// evicted. It is meant to be used off-chain only, as the cache expiry depends on
+// the local clock, and it is safe for concurrent use.
+type VerificationCache struct {
+	ttl time.Duration
+	// successful verifications indexed by key digest, with the time they were cached at
+	cache *lru.Cache
+}
+
+// NewVerificationCache creates a new VerificationCache remembering at most size
+// successful verifications, which expire after the given TTL. An error is returned
+// if the size isn't positive.
+func NewVerificationCache(size int, ttl time.Duration) (*VerificationCache, error) {
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerificationCache{ttl: ttl, cache: cache}, nil
+}
+
+func mustNewVerificationCache(size int, ttl time.Duration) *VerificationCache {
+	cache, err := NewVerificationCache(size, ttl)
+	if err != nil {
+		panic(err)
+	}
+	return cache
+}
+
+// Verify returns nil without calling verify if the verification identified by the
+// key succeeded less than TTL ago. Otherwise it calls verify and caches its result
+// if it succeeds, evicting the least recently used verification if the cache is
+// full. A nil cache always calls verify.
+func (c *VerificationCache) Verify(key VerificationKey, verify func() error) error {
+	if c == nil {
+		return verify()
+	}
+
+	digest := key.hash()
+	if cachedAt, ok := c.cache.Get(digest); ok {
+		if time.Since(cachedAt.(time.Time)) < c.ttl {
+			return nil
+		}
+		c.cache.Remove(digest)
+	}
+
+	if err := verify(); err != nil {
+		return err
+	}
+
+	c.cache.Add(digest, time.Now())
+	return nil
+}
+
+// Prune removes all the expired verifications from the cache.
+func (c *VerificationCache) Prune() {
+	for _, digest := range c.cache.Keys() {
+		if cachedAt, ok := c.cache.Peek(digest); ok && time.Since(cachedAt.(time.Time)) >= c.ttl {
+			c.cache.Remove(digest)
+		}
+	}
+}
+
+// Len returns the number of cached verifications, including the expired ones
+// which haven't been pruned yet.
+func (c *VerificationCache) Len() int {
+	return c.cache.Len()
+}
@@ -0,0 +1,121 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
+)
+
+func TestVerificationCache(t *testing.T) {
+	cache, err := utils.NewVerificationCache(10, time.Hour)
+	require.NoError(t, err)
+	key := utils.VerificationKey{
+		ClientID: clientID,
+		Height:   10,
+		Proof:    []byte("proof"),
+		Target:   utils.NewVerificationTarget([]byte("ibc"), []byte("path"), []byte("value")),
+	}
+
+	var verifications int
+	verify := func() error {
+		verifications++
+		return nil
+	}
+
+	require.NoError(t, cache.Verify(key, verify))
+	require.Equal(t, 1, verifications)
+
+	// cache hit skips the verification
+	require.NoError(t, cache.Verify(key, verify))
+	require.Equal(t, 1, verifications)
+
+	// any difference in the inputs misses the cache
+	misses := []utils.VerificationKey{
+		{ClientID: "otherclient", Height: 10, Proof: key.Proof, Target: key.Target},
+		{ClientID: clientID, Height: 11, Proof: key.Proof, Target: key.Target},
+		{ClientID: clientID, Height: 10, Proof: []byte("proof2"), Target: key.Target},
+		{ClientID: clientID, Height: 10, Proof: key.Proof, Target: utils.NewVerificationTarget([]byte("ibc"), []byte("path"), []byte("value2"))},
+		{ClientID: clientID, Height: 10, Proof: key.Proof, Target: utils.NewVerificationTarget([]byte("other"), []byte("path"), []byte("value"))},
+		// fields concatenating to the same bytes must not collide
+		{ClientID: clientID, Height: 10, Proof: []byte("proofpath"), Target: []byte("/value")},
+		{ClientID: clientID, Height: 10, Proof: key.Proof, Target: utils.NewVerificationTarget([]byte("ibc"), []byte("pathvalue"), nil)},
+	}
+	for i, miss := range misses {
+		require.NoError(t, cache.Verify(miss, verify))
+		require.Equal(t, i+2, verifications, "key %d hit the cache", i)
+	}
+
+	// failed verifications aren't cached
+	failure := utils.VerificationKey{ClientID: clientID, Height: 12, Proof: []byte("bad proof")}
+	fail := func() error {
+		verifications++
+		return errors.New("invalid proof")
+	}
+	require.Error(t, cache.Verify(failure, fail))
+	require.Error(t, cache.Verify(failure, fail))
+	require.Equal(t, len(misses)+3, verifications)
+	require.Equal(t, len(misses)+1, cache.Len())
+
+	// a failure is verified again once it succeeds
+	require.NoError(t, cache.Verify(failure, verify))
+	require.NoError(t, cache.Verify(failure, verify))
+	require.Equal(t, len(misses)+4, verifications)
+
+	// entries are verified again after expiry
+	cache, err = utils.NewVerificationCache(10, time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, cache.Verify(key, verify))
+	time.Sleep(2 * time.Millisecond)
+	require.NoError(t, cache.Verify(key, verify))
+	require.Equal(t, len(misses)+6, verifications)
+
+	time.Sleep(2 * time.Millisecond)
+	cache.Prune()
+	require.Zero(t, cache.Len())
+
+	// a nil cache always verifies
+	var nilCache *utils.VerificationCache
+	require.NoError(t, nilCache.Verify(key, verify))
+	require.NoError(t, nilCache.Verify(key, verify))
+	require.Equal(t, len(misses)+8, verifications)
+}
+
+func TestVerificationCacheEviction(t *testing.T) {
+	_, err := utils.NewVerificationCache(0, time.Hour)
+	require.Error(t, err)
+
+	cache, err := utils.NewVerificationCache(2, time.Hour)
+	require.NoError(t, err)
+
+	var verifications int
+	verify := func() error {
+		verifications++
+		return nil
+	}
+
+	keys := []utils.VerificationKey{
+		{ClientID: clientID, Height: 1, Proof: []byte("proof")},
+		{ClientID: clientID, Height: 2, Proof: []byte("proof")},
+		{ClientID: clientID, Height: 3, Proof: []byte("proof")},
+	}
+
+	require.NoError(t, cache.Verify(keys[0], verify))
+	require.NoError(t, cache.Verify(keys[1], verify))
+	// mark the first verification as the most recently used one
+	require.NoError(t, cache.Verify(keys[0], verify))
+	require.Equal(t, 2, verifications)
+
+	// inserting into the full cache evicts the least recently used verification
+	require.NoError(t, cache.Verify(keys[2], verify))
+	require.Equal(t, 2, cache.Len())
+	require.Equal(t, 3, verifications)
+
+	require.NoError(t, cache.Verify(keys[0], verify))
+	require.Equal(t, 3, verifications)
+	require.NoError(t, cache.Verify(keys[1], verify))
+	require.Equal(t, 4, verifications)
+}
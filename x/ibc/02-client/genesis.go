@@ -32,6 +32,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		}
 	}
 
+	k.SetParams(ctx, gs.Params)
+
 	if !gs.CreateLocalhost {
 		return
 	}
@@ -63,5 +65,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		Clients:          k.GetAllGenesisClients(ctx),
 		ClientsConsensus: k.GetAllConsensusStates(ctx),
 		CreateLocalhost:  false,
+		Params:           k.GetParams(ctx),
 	}
 }
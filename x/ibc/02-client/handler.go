@@ -21,6 +21,10 @@ func HandleMsgCreateClient(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCreat
 		return nil, err
 	}
 
+	if err := types.ValidateMinTrustingPeriod(clientState, k.GetMinTrustingPeriod(ctx)); err != nil {
+		return nil, err
+	}
+
 	_, err = k.CreateClient(ctx, msg.ClientId, clientState, consensusState)
 	if err != nil {
 		return nil, err
@@ -93,3 +97,26 @@ func HandleMsgSubmitMisbehaviour(ctx sdk.Context, k keeper.Keeper, msg *types.Ms
 		Events: ctx.EventManager().Events().ToABCIEvents(),
 	}, nil
 }
+
+// HandleMsgFreezeClient defines the sdk.Handler for MsgFreezeClient
+func HandleMsgFreezeClient(ctx sdk.Context, k keeper.Keeper, msg *types.MsgFreezeClient) (*sdk.Result, error) {
+	if err := k.FreezeClient(ctx, msg.ClientId, msg.FrozenHeight, msg.Signer); err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to freeze IBC client")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, msg.ClientId),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, msg.FrozenHeight.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
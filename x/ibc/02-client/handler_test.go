@@ -0,0 +1,46 @@
+package client_test
+
+import (
+	"time"
+
+	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *ClientTestSuite) TestHandleMsgCreateClientMinTrustingPeriod() {
+	testCases := []struct {
+		name              string
+		clientType        string
+		minTrustingPeriod time.Duration
+		expPass           bool
+	}{
+		{"no minimum", exported.ClientTypeTendermint, 0, true},
+		{"trusting period above the minimum", exported.ClientTypeTendermint, ibctesting.TrustingPeriod - time.Hour, true},
+		{"trusting period below the minimum", exported.ClientTypeTendermint, ibctesting.TrustingPeriod + time.Hour, false},
+		{"solo machine client is exempt", exported.ClientTypeSoloMachine, ibctesting.TrustingPeriod + time.Hour, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			k := suite.chainA.App.IBCKeeper.ClientKeeper
+			k.SetParams(ctx, types.NewParams(false, tc.minTrustingPeriod, "", false, false))
+
+			msg := suite.chainA.ConstructMsgCreateClient(suite.chainB, "testclient", tc.clientType)
+			_, err := client.HandleMsgCreateClient(ctx, k, msg)
+
+			_, found := k.GetClientState(ctx, "testclient")
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+			} else {
+				suite.Require().True(types.ErrInvalidClient.Is(err), err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
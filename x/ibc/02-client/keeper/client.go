@@ -60,18 +60,49 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		return nil, sdkerrors.Wrapf(types.ErrClientFrozen, "cannot update client with ID %s", clientID)
 	}
 
+	// check that the header belongs to the chain tracked by the client
+	// NOTE: not checked for localhost client
+	if header != nil && clientType != exported.Localhost {
+		if err := types.ValidateHeaderChainID(clientState, header); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+		}
+
+		// check that the header can be verified against the stored consensus states
+		if latestConsensusState, found := k.GetLatestClientConsensusState(ctx, clientID); found {
+			if err := types.ValidateHeaderConsensusTypeMatch(latestConsensusState, header); err != nil {
+				return nil, sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+			}
+		}
+	}
+
 	var (
 		consensusState  exported.ConsensusState
 		consensusHeight uint64
 		err             error
 	)
 
+	previousClientState := clientState
 	clientState, consensusState, err = clientState.CheckHeaderAndUpdateState(ctx, k.cdc, k.ClientStore(ctx, clientID), header)
 
 	if err != nil {
 		return nil, sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
 	}
 
+	// check that the header only advanced the client state
+	// NOTE: not checked for localhost client, which is updated in place
+	if header != nil && clientType != exported.Localhost {
+		if err := types.ValidateClientStateUpdate(previousClientState, clientState); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+		}
+	}
+
+	// we don't set consensus state for localhost client
+	if header != nil && clientType != exported.Localhost {
+		if err := k.checkConsensusStateTimestamp(ctx, clientID, header.GetHeight(), consensusState); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
+		}
+	}
+
 	k.SetClientState(ctx, clientID, clientState)
 
 	// we don't set consensus state for localhost client
@@ -95,6 +126,36 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	return clientState, nil
 }
 
+// checkConsensusStateTimestamp returns an error if the timestamp of the new
+// consensus state, to be stored at the given height, is not greater than the
+// timestamp of the stored consensus state with the greatest height below it. The
+// predecessor is the first consensus state found by iterating the height index
+// in reverse from the height below the new one.
+func (k Keeper) checkConsensusStateTimestamp(
+	ctx sdk.Context, clientID string, height uint64, consensusState exported.ConsensusState,
+) error {
+	if height == 0 {
+		return nil
+	}
+
+	var previous exported.ConsensusState
+	below := types.ConsensusStateEpochHeight(consensusState, height-1)
+	k.IterateConsensusStatesReverse(ctx, clientID, below, func(_ types.Height, cs exported.ConsensusState) bool {
+		previous = cs
+		return true
+	})
+
+	if previous != nil && consensusState.GetTimestamp() <= previous.GetTimestamp() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidConsensus,
+			"consensus state timestamp at height %d must be greater than the timestamp at height %d (%d <= %d)",
+			consensusState.GetHeight(), previous.GetHeight(), consensusState.GetTimestamp(), previous.GetTimestamp(),
+		)
+	}
+
+	return nil
+}
+
 // CheckMisbehaviourAndUpdateState checks for client misbehaviour and freezes the
 // client if so.
 func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour exported.Misbehaviour) error {
@@ -113,3 +174,41 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 
 	return nil
 }
+
+// FreezeClient freezes a client at the given height without misbehaviour, to
+// simulate a frozen client on test networks. It fails unless manual freezing is
+// allowed and the signer is the freeze authority. Only the client states
+// implementing FreezableClientState can be frozen.
+func (k Keeper) FreezeClient(ctx sdk.Context, clientID string, frozenHeight types.Height, signer sdk.AccAddress) error {
+	if !k.IsManualFreezeAllowed(ctx) {
+		return sdkerrors.Wrapf(types.ErrManualFreezeDisabled, "cannot freeze client with ID %s", clientID)
+	}
+
+	authority := k.GetFreezeAuthority(ctx)
+	if authority == "" || authority != signer.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signer %s is not the freeze authority", signer)
+	}
+
+	if frozenHeight.IsZero() {
+		return sdkerrors.Wrap(types.ErrInvalidHeight, "frozen height cannot be zero")
+	}
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot freeze client with ID %s", clientID)
+	}
+
+	if clientState.IsFrozen() {
+		return sdkerrors.Wrapf(types.ErrClientFrozen, "client with ID %s is already frozen", clientID)
+	}
+
+	freezable, ok := clientState.(types.FreezableClientState)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "cannot freeze client of type %s", clientState.ClientType())
+	}
+
+	k.SetClientState(ctx, clientID, freezable.Freeze(frozenHeight))
+	k.Logger(ctx).Info(fmt.Sprintf("client %s manually frozen at height %s", clientID, frozenHeight))
+
+	return nil
+}
@@ -1,16 +1,21 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
 )
 
 const (
@@ -95,6 +100,26 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			updateHeader = createFutureUpdateFn(suite)
 			return err
 		}, true},
+		{"consensus state timestamp not greater than previous consensus state timestamp", func() error {
+			clientState = ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+			_, err := suite.keeper.CreateClient(suite.ctx, testClientID, clientState, suite.consensusState)
+			suite.Require().NoError(err)
+
+			// store intermediate consensus state with a timestamp after the update header time
+			incrementedClientHeight := testClientHeight.Increment()
+			intermediateConsState := &ibctmtypes.ConsensusState{
+				Height:             incrementedClientHeight,
+				Timestamp:          suite.header.Header.Time.Add(2 * time.Hour),
+				NextValidatorsHash: suite.valSetHash,
+			}
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID, incrementedClientHeight.EpochHeight, intermediateConsState)
+
+			clientState.LatestHeight = incrementedClientHeight
+			suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+
+			updateHeader = createFutureUpdateFn(suite)
+			return nil
+		}, false},
 		{"valid past update", func() error {
 			clientState = ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
 			_, err := suite.keeper.CreateClient(suite.ctx, testClientID, clientState, suite.consensusState)
@@ -146,6 +171,26 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 
 			return nil
 		}, false},
+		{"header chain-id does not match client chain-id", func() error {
+			clientState = ibctmtypes.NewClientState("otherchain", ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+			_, err := suite.keeper.CreateClient(suite.ctx, testClientID, clientState, suite.consensusState)
+			suite.Require().NoError(err)
+
+			updateHeader = createFutureUpdateFn(suite)
+			return nil
+		}, false},
+		{"header type does not match consensus state type", func() error {
+			clientState = ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+			_, err := suite.keeper.CreateClient(suite.ctx, testClientID, clientState, suite.consensusState)
+			suite.Require().NoError(err)
+
+			// overwrite the latest consensus state with one of another client type
+			solomachine := ibctesting.NewSolomachine(suite.T(), "solomachine")
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID, testClientHeight.EpochHeight, solomachine.ConsensusState())
+
+			updateHeader = createFutureUpdateFn(suite)
+			return nil
+		}, false},
 		{"frozen client before update", func() error {
 			clientState = &ibctmtypes.ClientState{FrozenHeight: types.NewHeight(0, 1), LatestHeight: testClientHeight}
 			suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
@@ -232,6 +277,31 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientSolomachine() {
+	solomachine := ibctesting.NewSolomachine(suite.T(), testClientID)
+
+	_, err := suite.keeper.CreateClient(suite.ctx, testClientID, solomachine.ClientState(), solomachine.ConsensusState())
+	suite.Require().NoError(err)
+
+	// successive updates store consensus states with increasing timestamps
+	for i := 0; i < 3; i++ {
+		header := solomachine.CreateHeader()
+
+		updatedClientState, err := suite.keeper.UpdateClient(suite.ctx, testClientID, header)
+		suite.Require().NoError(err, "update %d failed", i)
+		suite.Require().Equal(header.Sequence+1, updatedClientState.GetLatestHeight())
+
+		consensusState, found := suite.keeper.GetClientConsensusState(suite.ctx, testClientID, header.GetHeight())
+		suite.Require().True(found)
+		suite.Require().Equal(header.Timestamp, consensusState.GetTimestamp())
+	}
+
+	// a header whose timestamp doesn't advance is rejected
+	solomachine.Time--
+	_, err = suite.keeper.UpdateClient(suite.ctx, testClientID, solomachine.CreateHeader())
+	suite.Require().True(errors.Is(err, types.ErrInvalidConsensus))
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientLocalhost() {
 	var localhostClient exported.ClientState = localhosttypes.NewClientState(suite.header.Header.GetChainID(), types.NewHeight(0, uint64(suite.ctx.BlockHeight())))
 
@@ -454,3 +524,85 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestFreezeClient() {
+	var (
+		clientID     string
+		frozenHeight types.Height
+		signer       sdk.AccAddress
+	)
+
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"success - tendermint client", func() {}, nil},
+		{"success - solo machine client", func() {
+			clientID = testClientID2
+			suite.keeper.SetClientState(suite.ctx, clientID, ibctesting.NewSolomachine(suite.T(), "solomachine").ClientState())
+		}, nil},
+		{"manual freezing disabled", func() {
+			suite.keeper.SetParams(suite.ctx, types.NewParams(false, 0, authority.String(), false, false))
+		}, types.ErrManualFreezeDisabled},
+		{"signer is not the freeze authority", func() {
+			signer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		}, sdkerrors.ErrUnauthorized},
+		{"no freeze authority", func() {
+			suite.keeper.SetParams(suite.ctx, types.NewParams(true, 0, "", false, false))
+		}, sdkerrors.ErrUnauthorized},
+		{"zero frozen height", func() {
+			frozenHeight = types.Height{}
+		}, types.ErrInvalidHeight},
+		{"client not found", func() {
+			clientID = "clientidnotfound"
+		}, types.ErrClientNotFound},
+		{"client already frozen", func() {
+			suite.Require().NoError(suite.keeper.FreezeClient(suite.ctx, clientID, types.NewHeight(0, 1), signer))
+		}, types.ErrClientFrozen},
+		{"localhost client cannot be frozen", func() {
+			clientID = exported.ClientTypeLocalHost
+			suite.keeper.SetClientState(suite.ctx, clientID, localhosttypes.NewClientState(testChainID, testClientHeight))
+		}, types.ErrInvalidClientType},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// manual freezing is disallowed by default
+			suite.Require().False(suite.keeper.IsManualFreezeAllowed(suite.ctx))
+
+			clientID = testClientID
+			frozenHeight = types.NewHeight(0, height)
+			clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+			_, err := suite.keeper.CreateClient(suite.ctx, testClientID, clientState, suite.consensusState)
+			suite.Require().NoError(err)
+
+			signer = authority
+			suite.keeper.SetParams(suite.ctx, types.NewParams(true, 0, authority.String(), false, false))
+
+			tc.malleate()
+
+			err = suite.keeper.FreezeClient(suite.ctx, clientID, frozenHeight, signer)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				clientState, found := suite.keeper.GetClientState(suite.ctx, clientID)
+				suite.Require().True(found)
+				suite.Require().True(clientState.IsFrozen())
+				suite.Require().Equal(frozenHeight.EpochHeight, clientState.GetFrozenHeight())
+			} else {
+				suite.Require().True(errors.Is(err, tc.expErr), err)
+
+				if clientState, found := suite.keeper.GetClientState(suite.ctx, clientID); found && tc.expErr != types.ErrClientFrozen {
+					suite.Require().False(clientState.IsFrozen())
+				}
+			}
+		})
+	}
+}
@@ -57,6 +57,10 @@ func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequ
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if req.OrderByHeight {
+		return q.clientStatesByHeight(ctx, req.Pagination)
+	}
+
 	clientStates := []*types.IdentifiedClientState{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.KeyClientStorePrefix)
 
@@ -91,6 +95,34 @@ func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequ
 	}, nil
 }
 
+// clientStatesByHeight paginates the client states by descending latest height
+// using the height ordered client index.
+func (q Keeper) clientStatesByHeight(ctx sdk.Context, pagination *query.PageRequest) (*types.QueryClientStatesResponse, error) {
+	clientStates := []*types.IdentifiedClientState{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.KeyHeightOrderedClientsPrefix)
+
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		clientID := string(value)
+		clientState, found := q.GetClientState(ctx, clientID)
+		if !found {
+			return sdkerrors.Wrap(types.ErrClientNotFound, clientID)
+		}
+
+		identifiedClient := types.NewIdentifiedClientState(clientID, clientState)
+		clientStates = append(clientStates, &identifiedClient)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClientStatesResponse{
+		ClientStates: clientStates,
+		Pagination:   pageRes,
+	}, nil
+}
+
 // ConsensusState implements the Query/ConsensusState gRPC method
 func (q Keeper) ConsensusState(c context.Context, req *types.QueryConsensusStateRequest) (*types.QueryConsensusStateResponse, error) {
 	if req == nil {
@@ -136,6 +168,121 @@ func (q Keeper) ConsensusState(c context.Context, req *types.QueryConsensusState
 	}, nil
 }
 
+// ConsensusStateWithPredecessor implements the Query/ConsensusStateWithPredecessor gRPC method
+func (q Keeper) ConsensusStateWithPredecessor(c context.Context, req *types.QueryConsensusStateWithPredecessorRequest) (*types.QueryConsensusStateWithPredecessorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consensusState, found := q.GetClientConsensusState(ctx, req.ClientId, req.Height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %d", req.ClientId, req.Height).Error(),
+		)
+	}
+
+	any, err := types.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryConsensusStateWithPredecessorResponse{
+		ConsensusState: any,
+		ProofHeight:    uint64(ctx.BlockHeight()),
+	}
+
+	height := types.ConsensusStateEpochHeight(consensusState, req.Height)
+	predecessor, found := q.GetPreviousClientConsensusState(ctx, req.ClientId, height)
+	if found {
+		res.Predecessor, err = types.PackConsensusState(predecessor)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return res, nil
+}
+
+// ConsensusStateMetadata implements the Query/ConsensusStateMetadata gRPC method
+func (q Keeper) ConsensusStateMetadata(c context.Context, req *types.QueryConsensusStateMetadataRequest) (*types.QueryConsensusStateMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consensusState, found := q.GetClientConsensusState(ctx, req.ClientId, req.Height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %d", req.ClientId, req.Height).Error(),
+		)
+	}
+
+	res := &types.QueryConsensusStateMetadataResponse{
+		Height:    consensusState.GetHeight(),
+		Timestamp: consensusState.GetTimestamp(),
+	}
+
+	// solo machine consensus states don't have a commitment root
+	if root := consensusState.GetRoot(); root != nil {
+		res.RootHash = root.GetHash()
+	}
+
+	return res, nil
+}
+
+// OldestValidConsensusState implements the Query/OldestValidConsensusState gRPC method
+func (q Keeper) OldestValidConsensusState(c context.Context, req *types.QueryOldestValidConsensusStateRequest) (*types.QueryOldestValidConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consensusState, err := q.GetOldestValidConsensusState(ctx, req.ClientId)
+	switch {
+	case types.ErrClientNotFound.Is(err), types.ErrConsensusStateNotFound.Is(err):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	any, err := types.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOldestValidConsensusStateResponse{
+		ConsensusState: any,
+		Height:         consensusState.GetHeight(),
+		ProofHeight:    uint64(ctx.BlockHeight()),
+	}, nil
+}
+
 // ConsensusStates implements the Query/ConsensusStates gRPC method
 func (q Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusStatesRequest) (*types.QueryConsensusStatesResponse, error) {
 	if req == nil {
@@ -175,3 +322,136 @@ func (q Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusStat
 		Pagination:      pageRes,
 	}, nil
 }
+
+// RecentConsensusStates implements the Query/RecentConsensusStates gRPC method
+func (q Keeper) RecentConsensusStates(c context.Context, req *types.QueryRecentConsensusStatesRequest) (*types.QueryRecentConsensusStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	consensusStates := []*codectypes.Any{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.FullKeyClientPath(req.ClientId, []byte("consensusState/")))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		consensusState, err := q.UnmarshalConsensusState(value)
+		if err != nil {
+			return false, err
+		}
+
+		if consensusState.GetTimestamp() < req.MinTimestamp {
+			return false, nil
+		}
+
+		if accumulate {
+			any, err := types.PackConsensusState(consensusState)
+			if err != nil {
+				return false, err
+			}
+
+			consensusStates = append(consensusStates, any)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRecentConsensusStatesResponse{
+		ConsensusStates: consensusStates,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// ClientTypeCounts implements the Query/ClientTypeCounts gRPC method
+func (q Keeper) ClientTypeCounts(c context.Context, req *types.QueryClientTypeCountsRequest) (*types.QueryClientTypeCountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryClientTypeCountsResponse{
+		ClientTypeCounts: q.GetClientTypeCounts(ctx),
+	}, nil
+}
+
+// ClientStorageUsage implements the Query/ClientStorageUsage gRPC method
+func (q Keeper) ClientStorageUsage(c context.Context, req *types.QueryClientStorageUsageRequest) (*types.QueryClientStorageUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	count, size := q.GetClientStorageUsage(ctx, req.ClientId)
+
+	return &types.QueryClientStorageUsageResponse{
+		ConsensusStateCount: count,
+		ConsensusStatesSize: size,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
+
+// ConsensusStatesAround implements the Query/ConsensusStatesAround gRPC method
+func (q Keeper) ConsensusStatesAround(c context.Context, req *types.QueryConsensusStatesAroundRequest) (*types.QueryConsensusStatesAroundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	consensusStates := []*codectypes.Any{}
+	for _, consensusState := range q.GetConsensusStatesAround(ctx, req.ClientId, req.Pivot, req.Radius) {
+		any, err := types.PackConsensusState(consensusState)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		consensusStates = append(consensusStates, any)
+	}
+
+	return &types.QueryConsensusStatesAroundResponse{
+		ConsensusStates: consensusStates,
+	}, nil
+}
@@ -2,16 +2,21 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryClientState() {
@@ -165,6 +170,68 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientStatesOrderByHeight() {
+	heights := []struct {
+		clientID string
+		height   types.Height
+	}{
+		{"clientida", types.NewHeight(0, 10)}, {"clientidb", types.NewHeight(0, 30)}, {"clientidc", types.NewHeight(0, 20)},
+		{"clientidd", types.NewHeight(0, 20)}, {"clientide", types.NewHeight(0, 5)},
+		// a later epoch is ordered above any height of an earlier epoch
+		{"clientidf", types.NewHeight(1, 1)},
+		// updating a client moves it to its new latest height
+		{"clientide", types.NewHeight(0, 40)},
+	}
+	for _, h := range heights {
+		clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, h.height, commitmenttypes.GetSDKSpecs())
+		suite.keeper.SetClientState(suite.ctx, h.clientID, clientState)
+	}
+
+	allRes, err := suite.queryClient.ClientStates(sdk.WrapSDKContext(suite.ctx), &types.QueryClientStatesRequest{})
+	suite.Require().NoError(err)
+
+	// page through the height ordered clients
+	var clientIDs []string
+	req := &types.QueryClientStatesRequest{
+		Pagination:    &query.PageRequest{Limit: 2},
+		OrderByHeight: true,
+	}
+	for {
+		res, err := suite.queryClient.ClientStates(sdk.WrapSDKContext(suite.ctx), req)
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.ClientStates), 2)
+
+		for _, identifiedClient := range res.ClientStates {
+			clientIDs = append(clientIDs, identifiedClient.ClientId)
+		}
+
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+
+	// every client is returned exactly once
+	suite.Require().Len(clientIDs, len(allRes.ClientStates))
+
+	var ordered []string
+	for _, clientID := range clientIDs {
+		if strings.HasPrefix(clientID, "clientid") {
+			ordered = append(ordered, clientID)
+		}
+	}
+	suite.Require().Equal([]string{"clientidf", "clientide", "clientidb", "clientidc", "clientidd", "clientida"}, ordered)
+
+	// top N clients by height
+	res, err := suite.queryClient.ClientStates(sdk.WrapSDKContext(suite.ctx), &types.QueryClientStatesRequest{
+		Pagination:    &query.PageRequest{Limit: 1},
+		OrderByHeight: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ClientStates, 1)
+	suite.Require().Equal(clientIDs[0], res.ClientStates[0].ClientId)
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	var (
 		req               *types.QueryConsensusStateRequest
@@ -267,6 +334,116 @@ func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConsensusStateWithPredecessor() {
+	var (
+		req            *types.QueryConsensusStateWithPredecessorRequest
+		expState       *codectypes.Any
+		expPredecessor *codectypes.Any
+	)
+
+	// store consensus states at heights whose keys are not sorted numerically
+	storeConsensusStates := func() []*codectypes.Any {
+		var states []*codectypes.Any
+		for _, h := range []uint64{2, 5, 12} {
+			cs := ibctmtypes.NewConsensusState(
+				suite.consensusState.Timestamp, commitmenttypes.NewMerkleRoot([]byte("hash")), types.NewHeight(0, h), nil,
+			)
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID, h, cs)
+
+			any, err := types.PackConsensusState(cs)
+			suite.Require().NoError(err)
+			states = append(states, any)
+		}
+		return states
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryConsensusStateWithPredecessorRequest{}
+			},
+			false,
+		},
+		{
+			"invalid height",
+			func() {
+				req = &types.QueryConsensusStateWithPredecessorRequest{
+					ClientId: testClientID,
+					Height:   0,
+				}
+			},
+			false,
+		},
+		{
+			"consensus state not found at height",
+			func() {
+				storeConsensusStates()
+				req = &types.QueryConsensusStateWithPredecessorRequest{
+					ClientId: testClientID,
+					Height:   3,
+				}
+			},
+			false,
+		},
+		{
+			"success lowest height without predecessor",
+			func() {
+				states := storeConsensusStates()
+				expState, expPredecessor = states[0], nil
+				req = &types.QueryConsensusStateWithPredecessorRequest{
+					ClientId: testClientID,
+					Height:   2,
+				}
+			},
+			true,
+		},
+		{
+			"success with predecessor",
+			func() {
+				states := storeConsensusStates()
+				expState, expPredecessor = states[2], states[1]
+				req = &types.QueryConsensusStateWithPredecessorRequest{
+					ClientId: testClientID,
+					Height:   12,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.ConsensusStateWithPredecessor(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expState.ClearCachedValue()
+				suite.Require().Equal(expState, res.ConsensusState)
+
+				if expPredecessor == nil {
+					suite.Require().Nil(res.Predecessor)
+				} else {
+					expPredecessor.ClearCachedValue()
+					suite.Require().Equal(expPredecessor, res.Predecessor)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusStates() {
 	var (
 		req                *types.QueryConsensusStatesRequest
@@ -363,3 +540,576 @@ func (suite *KeeperTestSuite) TestQueryConsensusStates() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryRecentConsensusStates() {
+	var (
+		req                *types.QueryRecentConsensusStatesRequest
+		expConsensusStates []*codectypes.Any
+		expTotal           uint64
+	)
+
+	// setConsensusStates stores a consensus state at each height, starting from
+	// height, with a timestamp of the given age relative to the block time
+	setConsensusStates := func(ages ...time.Duration) []*codectypes.Any {
+		anys := make([]*codectypes.Any, len(ages))
+		for i, age := range ages {
+			cs := ibctmtypes.NewConsensusState(
+				suite.ctx.BlockTime().Add(-age), commitmenttypes.NewMerkleRoot([]byte(fmt.Sprintf("hash%d", i))), types.NewHeight(0, height+uint64(i)), nil,
+			)
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID, height+uint64(i), cs)
+
+			any, err := types.PackConsensusState(cs)
+			suite.Require().NoError(err)
+			anys[i] = any
+		}
+		return anys
+	}
+
+	minTimestamp := func(since time.Duration) uint64 {
+		return uint64(suite.ctx.BlockTime().Add(-since).UnixNano())
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid client identifier",
+			func() {
+				req = &types.QueryRecentConsensusStatesRequest{}
+			},
+			false,
+		},
+		{
+			"success, no results",
+			func() {
+				req = &types.QueryRecentConsensusStatesRequest{
+					ClientId:     testClientID,
+					MinTimestamp: minTimestamp(6 * time.Hour),
+				}
+			},
+			true,
+		},
+		{
+			"success, states outside the window filtered out",
+			func() {
+				anys := setConsensusStates(10*time.Hour, 5*time.Hour, 7*time.Hour, time.Hour, 6*time.Hour)
+
+				expConsensusStates = []*codectypes.Any{anys[1], anys[3], anys[4]}
+				expTotal = 3
+				req = &types.QueryRecentConsensusStatesRequest{
+					ClientId:     testClientID,
+					MinTimestamp: minTimestamp(6 * time.Hour),
+					Pagination: &query.PageRequest{
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+		{
+			"success, pagination applies to the filtered states",
+			func() {
+				anys := setConsensusStates(10*time.Hour, 5*time.Hour, 7*time.Hour, time.Hour, 2*time.Hour)
+
+				expConsensusStates = []*codectypes.Any{anys[3]}
+				expTotal = 3
+				req = &types.QueryRecentConsensusStatesRequest{
+					ClientId:     testClientID,
+					MinTimestamp: minTimestamp(6 * time.Hour),
+					Pagination: &query.PageRequest{
+						Offset:     1,
+						Limit:      1,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expConsensusStates = nil
+			expTotal = 0
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.RecentConsensusStates(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(len(expConsensusStates), len(res.ConsensusStates))
+				for i := range expConsensusStates {
+					expConsensusStates[i].ClearCachedValue()
+					suite.Require().Equal(expConsensusStates[i], res.ConsensusStates[i])
+				}
+				if req.Pagination != nil && req.Pagination.CountTotal {
+					suite.Require().Equal(expTotal, res.Pagination.Total)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryConsensusStateMetadata() {
+	var (
+		req      *types.QueryConsensusStateMetadataRequest
+		expState exported.ConsensusState
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid clientID",
+			func() {
+				req = &types.QueryConsensusStateMetadataRequest{Height: testClientHeight.EpochHeight}
+			},
+			false,
+		},
+		{
+			"invalid height",
+			func() {
+				req = &types.QueryConsensusStateMetadataRequest{ClientId: testClientID}
+			},
+			false,
+		},
+		{
+			"consensus state not found",
+			func() {
+				req = &types.QueryConsensusStateMetadataRequest{
+					ClientId: testClientID,
+					Height:   testClientHeight.EpochHeight,
+				}
+			},
+			false,
+		},
+		{
+			"success tendermint consensus state",
+			func() {
+				expState = ibctmtypes.NewConsensusState(
+					suite.consensusState.Timestamp, commitmenttypes.NewMerkleRoot([]byte("hash")), testClientHeight, suite.valSetHash,
+				)
+				suite.keeper.SetClientConsensusState(suite.ctx, testClientID, testClientHeight.EpochHeight, expState)
+
+				req = &types.QueryConsensusStateMetadataRequest{
+					ClientId: testClientID,
+					Height:   testClientHeight.EpochHeight,
+				}
+			},
+			true,
+		},
+		{
+			"success consensus state without root",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), "solomachine")
+				expState = solomachine.ConsensusState()
+				suite.keeper.SetClientConsensusState(suite.ctx, testClientID, solomachine.Sequence, expState)
+
+				req = &types.QueryConsensusStateMetadataRequest{
+					ClientId: testClientID,
+					Height:   solomachine.Sequence,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.queryClient.ConsensusStateMetadata(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the metadata matches the fields of the full consensus state
+				fullRes, err := suite.queryClient.ConsensusState(ctx, &types.QueryConsensusStateRequest{
+					ClientId: req.ClientId,
+					Height:   req.Height,
+				})
+				suite.Require().NoError(err)
+
+				var consensusState exported.ConsensusState
+				suite.Require().NoError(suite.cdc.UnpackAny(fullRes.ConsensusState, &consensusState))
+				suite.Require().Equal(expState, consensusState)
+
+				suite.Require().Equal(consensusState.GetHeight(), res.Height)
+				suite.Require().Equal(consensusState.GetTimestamp(), res.Timestamp)
+				if consensusState.GetRoot() == nil {
+					suite.Require().Empty(res.RootHash)
+				} else {
+					suite.Require().Equal(consensusState.GetRoot().GetHash(), res.RootHash)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryOldestValidConsensusState() {
+	var (
+		req               *types.QueryOldestValidConsensusStateRequest
+		expConsensusState exported.ConsensusState
+	)
+
+	// setConsensusStates stores a consensus state at each height with the given timestamp
+	setConsensusStates := func(timestamps ...time.Time) []exported.ConsensusState {
+		consensusStates := make([]exported.ConsensusState, len(timestamps))
+		for i, timestamp := range timestamps {
+			h := uint64(height + i)
+			cs := ibctmtypes.NewConsensusState(
+				timestamp, commitmenttypes.NewMerkleRoot([]byte(fmt.Sprintf("hash%d", i))), types.NewHeight(0, h), nil,
+			)
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID, h, cs)
+			consensusStates[i] = cs
+		}
+		return consensusStates
+	}
+
+	expired := suite.ctx.BlockTime().Add(-trustingPeriod - time.Hour)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid client identifier",
+			func() {
+				req = &types.QueryOldestValidConsensusStateRequest{}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryOldestValidConsensusStateRequest{
+					ClientId: testClientID,
+				}
+			},
+			false,
+		},
+		{
+			"client without trusting period",
+			func() {
+				suite.keeper.SetClientState(suite.ctx, testClientID, localhosttypes.NewClientState(testChainID, testClientHeight))
+
+				req = &types.QueryOldestValidConsensusStateRequest{
+					ClientId: testClientID,
+				}
+			},
+			false,
+		},
+		{
+			"all consensus states expired",
+			func() {
+				clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+				suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+				setConsensusStates(expired, expired.Add(time.Minute))
+
+				req = &types.QueryOldestValidConsensusStateRequest{
+					ClientId: testClientID,
+				}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+				suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+				consensusStates := setConsensusStates(expired, expired.Add(time.Minute), suite.now, suite.now.Add(time.Minute))
+
+				expConsensusState = consensusStates[2]
+				req = &types.QueryOldestValidConsensusStateRequest{
+					ClientId: testClientID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.OldestValidConsensusState(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expAny, err := types.PackConsensusState(expConsensusState)
+				suite.Require().NoError(err)
+				expAny.ClearCachedValue()
+				suite.Require().Equal(expAny, res.ConsensusState)
+				suite.Require().Equal(expConsensusState.GetHeight(), res.Height)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientTypeCounts() {
+	var expCounts map[string]uint64
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"only localhost",
+			func() {
+				expCounts = map[string]uint64{exported.ClientTypeLocalHost: 1}
+			},
+			true,
+		},
+		{
+			"mixed client types",
+			func() {
+				suite.keeper.SetClientType(suite.ctx, testClientID, exported.Tendermint)
+				suite.keeper.SetClientType(suite.ctx, testClientID2, exported.Tendermint)
+				suite.keeper.SetClientType(suite.ctx, testClientID3, exported.SoloMachine)
+
+				expCounts = map[string]uint64{
+					exported.ClientTypeTendermint:  2,
+					exported.ClientTypeSoloMachine: 1,
+					exported.ClientTypeLocalHost:   1,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.ClientTypeCounts(ctx, &types.QueryClientTypeCountsRequest{})
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expCounts, res.ClientTypeCounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientStorageUsage() {
+	var (
+		req      *types.QueryClientStorageUsageRequest
+		expCount uint64
+		expSize  uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid client identifier",
+			func() {
+				req = &types.QueryClientStorageUsageRequest{}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryClientStorageUsageRequest{ClientId: testClientID}
+			},
+			false,
+		},
+		{
+			"success, no consensus states",
+			func() {
+				clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+				suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+
+				req = &types.QueryClientStorageUsageRequest{ClientId: testClientID}
+			},
+			true,
+		},
+		{
+			"success, small and large consensus states",
+			func() {
+				clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+				suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+
+				small := ibctmtypes.NewConsensusState(suite.now, commitmenttypes.NewMerkleRoot([]byte("hash")), testClientHeight, nil)
+				large := ibctmtypes.NewConsensusState(suite.now, commitmenttypes.NewMerkleRoot([]byte(strings.Repeat("hash", 256))), types.NewHeight(0, testClientHeight.EpochHeight+1), suite.valSetHash)
+				suite.keeper.SetClientConsensusState(suite.ctx, testClientID, small.GetHeight(), small)
+				suite.keeper.SetClientConsensusState(suite.ctx, testClientID, large.GetHeight(), large)
+
+				store := suite.keeper.ClientStore(suite.ctx, testClientID)
+				expCount = 2
+				expSize = uint64(len(store.Get(host.KeyConsensusState(small.GetHeight()))) + len(store.Get(host.KeyConsensusState(large.GetHeight()))))
+				req = &types.QueryClientStorageUsageRequest{ClientId: testClientID}
+			},
+			true,
+		},
+		{
+			"success, size of the stored bytes",
+			func() {
+				clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+				suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+
+				// the stored value is measured as is, without being decoded
+				stored := []byte(strings.Repeat("consensus state", 10))
+				suite.keeper.ClientStore(suite.ctx, testClientID).Set(host.KeyConsensusState(testClientHeight.EpochHeight), stored)
+
+				expCount = 1
+				expSize = uint64(len(stored))
+				req = &types.QueryClientStorageUsageRequest{ClientId: testClientID}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expCount = 0
+			expSize = 0
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.ClientStorageUsage(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expCount, res.ConsensusStateCount)
+				suite.Require().Equal(expSize, res.ConsensusStatesSize)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), *res.Params)
+
+	params := types.NewParams(true, time.Hour, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(), true, false)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	res, err = suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, *res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryConsensusStatesAround() {
+	var (
+		req        *types.QueryConsensusStatesAroundRequest
+		expHeights []uint64
+	)
+
+	setClient := func(heights ...uint64) {
+		clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, commitmenttypes.GetSDKSpecs())
+		suite.keeper.SetClientState(suite.ctx, testClientID, clientState)
+
+		for _, h := range heights {
+			cs := ibctmtypes.NewConsensusState(suite.now, commitmenttypes.NewMerkleRoot([]byte("hash")), types.NewHeight(0, h), nil)
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID, h, cs)
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid client identifier",
+			func() {
+				req = &types.QueryConsensusStatesAroundRequest{}
+			},
+			false,
+		},
+		{
+			"client not found",
+			func() {
+				req = &types.QueryConsensusStatesAroundRequest{ClientId: testClientID, Pivot: types.NewHeight(0, 5), Radius: 2}
+			},
+			false,
+		},
+		{
+			"success, dense store",
+			func() {
+				setClient(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+				expHeights = []uint64{3, 4, 5, 6, 7}
+				req = &types.QueryConsensusStatesAroundRequest{ClientId: testClientID, Pivot: types.NewHeight(0, 5), Radius: 2}
+			},
+			true,
+		},
+		{
+			"success, sparse store",
+			func() {
+				setClient(2, 10)
+				expHeights = []uint64{2, 10}
+				req = &types.QueryConsensusStatesAroundRequest{ClientId: testClientID, Pivot: types.NewHeight(0, 5), Radius: 2}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expHeights = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.ConsensusStatesAround(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				var heights []uint64
+				for _, any := range res.ConsensusStates {
+					var cs exported.ConsensusState
+					suite.Require().NoError(suite.cdc.UnpackAny(any, &cs))
+					heights = append(heights, cs.GetHeight())
+				}
+				suite.Require().Equal(expHeights, heights)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
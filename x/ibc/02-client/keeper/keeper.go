@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/light"
@@ -18,6 +21,7 @@ import (
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper represents a type that grants read and write permissions to any client
@@ -25,14 +29,21 @@ import (
 type Keeper struct {
 	storeKey      sdk.StoreKey
 	cdc           codec.BinaryMarshaler
+	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingKeeper
 }
 
 // NewKeeper creates a new NewKeeper instance
-func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, sk types.StakingKeeper) Keeper {
+func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace, sk types.StakingKeeper) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
+		paramSpace:    paramSpace,
 		stakingKeeper: sk,
 	}
 }
@@ -58,6 +69,22 @@ func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (exported.Clien
 func (k Keeper) SetClientState(ctx sdk.Context, clientID string, clientState exported.ClientState) {
 	store := k.ClientStore(ctx, clientID)
 	store.Set(host.KeyClientState(), k.MustMarshalClientState(clientState))
+	k.setHeightOrderedClient(ctx, clientID, types.ClientStateEpochHeight(clientState))
+}
+
+// setHeightOrderedClient moves a client to the given latest height in the height
+// ordered client index. The indexed height is stored separately from the client
+// state, as light clients may write their client state directly to the client
+// store, and is only refreshed when the client state is set through the keeper.
+func (k Keeper) setHeightOrderedClient(ctx sdk.Context, clientID string, latestHeight types.Height) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(host.KeyIndexedClientHeight(clientID)); bz != nil {
+		store.Delete(host.KeyHeightOrderedClient(bz, clientID))
+	}
+
+	heightBz := latestHeight.Bytes()
+	store.Set(host.KeyHeightOrderedClient(heightBz, clientID), []byte(clientID))
+	store.Set(host.KeyIndexedClientHeight(clientID), heightBz)
 }
 
 // GetClientType gets the consensus type for a specific client
@@ -90,10 +117,46 @@ func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height
 }
 
 // SetClientConsensusState sets a ConsensusState to a particular client at the given
-// height
+// height and adds it to the index of the client consensus states ordered by height.
+// The consensus state is stored compressed if enabled by the client parameters.
 func (k Keeper) SetClientConsensusState(ctx sdk.Context, clientID string, height uint64, consensusState exported.ConsensusState) {
+	bz := k.MustMarshalConsensusState(consensusState)
+	if k.IsConsensusStateCompressionEnabled(ctx) {
+		var err error
+		bz, err = types.CompressConsensusState(bz)
+		if err != nil {
+			panic(fmt.Errorf("failed to compress consensus state: %w", err))
+		}
+	}
+
 	store := k.ClientStore(ctx, clientID)
-	store.Set(host.KeyConsensusState(height), k.MustMarshalConsensusState(consensusState))
+	store.Set(host.KeyConsensusState(height), bz)
+	heightBz := types.ConsensusStateEpochHeight(consensusState, height).Bytes()
+	ctx.KVStore(k.storeKey).Set(host.KeyConsensusHeight(clientID, heightBz), sdk.Uint64ToBigEndian(height))
+}
+
+// ImportClientConsensusState stores an imported consensus state of a client at the
+// given height unless a consensus state is already stored at that height. An
+// identical re-import, as defined by ConsensusStateEqual, is a no-op while a
+// conflicting consensus state at the same height is rejected. It returns true if
+// the consensus state was stored.
+func (k Keeper) ImportClientConsensusState(
+	ctx sdk.Context, clientID string, height uint64, consensusState exported.ConsensusState,
+) (bool, error) {
+	stored, found := k.GetClientConsensusState(ctx, clientID, height)
+	if !found {
+		k.SetClientConsensusState(ctx, clientID, height, consensusState)
+		return true, nil
+	}
+
+	if !types.ConsensusStateEqual(stored, consensusState) {
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidConsensus,
+			"imported consensus state conflicts with the consensus state stored for client %s at height %d", clientID, height,
+		)
+	}
+
+	return false, nil
 }
 
 // IterateConsensusStates provides an iterator over all stored consensus states.
@@ -152,6 +215,17 @@ func (k Keeper) GetAllConsensusStates(ctx sdk.Context) types.ClientsConsensusSta
 		return false
 	})
 
+	// consensus state keys are not ordered numerically by height in the store,
+	// so sort each client's consensus states to export them in ascending order
+	for _, clientConsState := range clientConsStates {
+		consStates := clientConsState.ConsensusStates
+		sort.SliceStable(consStates, func(i, j int) bool {
+			csI := consStates[i].GetCachedValue().(exported.ConsensusState)
+			csJ := consStates[j].GetCachedValue().(exported.ConsensusState)
+			return csI.GetHeight() < csJ.GetHeight()
+		})
+	}
+
 	return clientConsStates.Sort()
 }
 
@@ -183,6 +257,184 @@ func (k Keeper) GetClientConsensusStateLTE(ctx sdk.Context, clientID string, max
 	return nil, false
 }
 
+// IterateClientConsensusStates provides an iterator over all the consensus states
+// stored for the given client. For each consensus state, cb will be called. If the
+// cb returns true, the iterator will close and stop.
+func (k Keeper) IterateClientConsensusStates(ctx sdk.Context, clientID string, cb func(cs exported.ConsensusState) bool) {
+	store := k.ClientStore(ctx, clientID)
+	iterator := sdk.KVStorePrefixIterator(store, []byte("consensusState/"))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		consensusState := k.MustUnmarshalConsensusState(iterator.Value())
+
+		if cb(consensusState) {
+			break
+		}
+	}
+}
+
+// GetPreviousClientConsensusState returns the ConsensusState of a particular client
+// stored at the greatest height strictly lower than the given height, using the
+// index of consensus states ordered by height.
+func (k Keeper) GetPreviousClientConsensusState(ctx sdk.Context, clientID string, height types.Height) (exported.ConsensusState, bool) {
+	start, ok := height.Decrement()
+	if !ok {
+		if height.EpochNumber == 0 {
+			return nil, false
+		}
+		// the greatest height of the previous epoch
+		start = types.NewHeight(height.EpochNumber-1, math.MaxUint64)
+	}
+
+	var previous exported.ConsensusState
+	k.IterateConsensusStatesReverse(ctx, clientID, start, func(_ types.Height, cs exported.ConsensusState) bool {
+		previous = cs
+		return true
+	})
+
+	return previous, previous != nil
+}
+
+// iterateConsensusHeights provides an iterator over the heights of the consensus
+// states of a client in ascending order, using the index of consensus states
+// ordered by height. If the cb returns true, the iterator will close and stop.
+func (k Keeper) iterateConsensusHeights(ctx sdk.Context, clientID string, cb func(height uint64) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, host.KeyConsensusHeightsPrefix(clientID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IterateConsensusStatesReverse provides an iterator over the consensus states of
+// a client with a height lower than or equal to the start height, from the highest
+// to the lowest height. Index keys that don't decode to a height are skipped. If
+// the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateConsensusStatesReverse(
+	ctx sdk.Context, clientID string, startHeight types.Height, cb func(height types.Height, cs exported.ConsensusState) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := host.KeyConsensusHeightsPrefix(clientID)
+	// the end of the iterator is exclusive, the key following the start height key
+	// makes the start height inclusive
+	end := sdk.PrefixEndBytes(host.KeyConsensusHeight(clientID, startHeight.Bytes()))
+	iterator := store.ReverseIterator(prefix, end)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		height, err := types.HeightFromBytes(iterator.Key()[len(prefix):])
+		if err != nil {
+			// a malformed index key doesn't identify a consensus state height
+			continue
+		}
+
+		consensusState, found := k.GetClientConsensusState(ctx, clientID, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			continue
+		}
+
+		if cb(height, consensusState) {
+			break
+		}
+	}
+}
+
+// GetConsensusStatesAround returns up to radius consensus states of a client with
+// a height lower than the pivot height, the consensus state at the pivot height if
+// it exists, and up to radius consensus states with a height greater than the
+// pivot height, in ascending order of height.
+func (k Keeper) GetConsensusStatesAround(
+	ctx sdk.Context, clientID string, pivot types.Height, radius uint64,
+) []exported.ConsensusState {
+	var (
+		pivotState exported.ConsensusState
+		below      []exported.ConsensusState
+	)
+	k.IterateConsensusStatesReverse(ctx, clientID, pivot, func(height types.Height, cs exported.ConsensusState) bool {
+		if height.EQ(pivot) {
+			pivotState = cs
+			return false
+		}
+
+		if uint64(len(below)) == radius {
+			return true
+		}
+		below = append(below, cs)
+		return false
+	})
+
+	// the states below the pivot height are collected from the highest to the lowest
+	consensusStates := make([]exported.ConsensusState, 0, len(below)+1)
+	for i := len(below) - 1; i >= 0; i-- {
+		consensusStates = append(consensusStates, below[i])
+	}
+	if pivotState != nil {
+		consensusStates = append(consensusStates, pivotState)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	prefix := host.KeyConsensusHeightsPrefix(clientID)
+	// the start of the iterator is inclusive, the key following the pivot height
+	// key makes the pivot height exclusive
+	start := sdk.PrefixEndBytes(host.KeyConsensusHeight(clientID, pivot.Bytes()))
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(prefix))
+
+	defer iterator.Close()
+	for above := uint64(0); above < radius && iterator.Valid(); iterator.Next() {
+		consensusState, found := k.GetClientConsensusState(ctx, clientID, sdk.BigEndianToUint64(iterator.Value()))
+		if !found {
+			continue
+		}
+
+		consensusStates = append(consensusStates, consensusState)
+		above++
+	}
+
+	return consensusStates
+}
+
+// GetOldestValidConsensusState returns the consensus state of a client with the
+// lowest height whose timestamp is still within the trusting period of the client
+// at the current block time. Only clients with a trusting period are supported.
+func (k Keeper) GetOldestValidConsensusState(ctx sdk.Context, clientID string) (exported.ConsensusState, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrClientNotFound, clientID)
+	}
+
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidClientType, "client type %s doesn't have a trusting period", clientState.ClientType())
+	}
+
+	var oldest exported.ConsensusState
+	k.iterateConsensusHeights(ctx, clientID, func(height uint64) bool {
+		consensusState, found := k.GetClientConsensusState(ctx, clientID, height)
+		if !found {
+			return false
+		}
+
+		// a consensus state expires once the trusting period has elapsed since its timestamp
+		expiry := time.Unix(0, int64(consensusState.GetTimestamp())).Add(tmClientState.TrustingPeriod)
+		if expiry.After(ctx.BlockTime()) {
+			oldest = consensusState
+			return true
+		}
+		return false
+	})
+
+	if oldest == nil {
+		return nil, sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "all the consensus states of client %s are expired", clientID)
+	}
+
+	return oldest, nil
+}
+
 // GetSelfConsensusState introspects the (self) past historical info at a given height
 // and returns the expected consensus state at that height.
 // TODO: Replace height with *clienttypes.Height once interfaces change
@@ -273,6 +525,58 @@ func (k Keeper) IterateClients(ctx sdk.Context, cb func(clientID string, cs expo
 	}
 }
 
+// IterateClientTypes provides an iterator over the client type of every stored
+// light client. The client type is read directly from its store key so the client
+// states are not deserialized. If the cb returns true, the iterator will close
+// and stop.
+func (k Keeper) IterateClientTypes(ctx sdk.Context, cb func(clientID string, clientType exported.ClientType) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, host.KeyClientStorePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// client type key is in the format "clients/<clientID>/clientType"
+		if len(keySplit) != 3 || keySplit[2] != host.ClientTypePath() {
+			continue
+		}
+
+		bz := iterator.Value()
+		if len(bz) == 0 {
+			continue
+		}
+
+		if cb(keySplit[1], exported.ClientType(bz[0])) {
+			break
+		}
+	}
+}
+
+// GetClientTypeCounts returns the number of stored clients for each client type.
+func (k Keeper) GetClientTypeCounts(ctx sdk.Context) map[string]uint64 {
+	counts := make(map[string]uint64)
+	k.IterateClientTypes(ctx, func(_ string, clientType exported.ClientType) bool {
+		counts[clientType.String()]++
+		return false
+	})
+	return counts
+}
+
+// GetClientStorageUsage returns the number of consensus states stored for the
+// given client and the total size in bytes of their stored values.
+func (k Keeper) GetClientStorageUsage(ctx sdk.Context, clientID string) (count, size uint64) {
+	store := k.ClientStore(ctx, clientID)
+	iterator := sdk.KVStorePrefixIterator(store, []byte("consensusState/"))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		size += uint64(len(iterator.Value()))
+	}
+
+	return count, size
+}
+
 // GetAllClients returns all stored light client State objects.
 func (k Keeper) GetAllClients(ctx sdk.Context) (states []exported.ClientState) {
 	k.IterateClients(ctx, func(_ string, state exported.ClientState) bool {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -19,6 +20,7 @@ import (
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -119,6 +121,33 @@ func (suite *KeeperTestSuite) TestSetClientState() {
 	suite.Require().Equal(clientState, retrievedState, "Client states are not equal")
 }
 
+func (suite *KeeperTestSuite) TestSetClientStateHeightOrderedClient() {
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	// the second update moves the client to a later epoch at a lower epoch height
+	for _, latestHeight := range []types.Height{types.NewHeight(0, 10), types.NewHeight(1, 2)} {
+		clientState := ibctmtypes.NewClientState(testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, latestHeight, commitmenttypes.GetSDKSpecs())
+		clientKeeper.SetClientState(ctx, testClientID, clientState)
+	}
+
+	store := ctx.KVStore(suite.chainA.App.GetKey(host.StoreKey))
+	iterator := sdk.KVStorePrefixIterator(store, host.KeyHeightOrderedClientsPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Value()) == testClientID {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	// the client is indexed exactly once, at its epoch-aware latest height
+	suite.Require().Len(keys, 1)
+	suite.Require().Equal(host.KeyHeightOrderedClient(types.NewHeight(1, 2).Bytes(), testClientID), keys[0])
+	suite.Require().Equal(types.NewHeight(1, 2).Bytes(), store.Get(host.KeyIndexedClientHeight(testClientID)))
+}
+
 func (suite *KeeperTestSuite) TestSetClientType() {
 	suite.keeper.SetClientType(suite.ctx, testClientID, exported.Tendermint)
 	clientType, found := suite.keeper.GetClientType(suite.ctx, testClientID)
@@ -138,6 +167,76 @@ func (suite *KeeperTestSuite) TestSetClientConsensusState() {
 	suite.Require().Equal(suite.consensusState, tmConsState, "ConsensusState not stored correctly")
 }
 
+func (suite *KeeperTestSuite) TestSetCompressedClientConsensusState() {
+	params := types.DefaultParams()
+	params.CompressConsensusStates = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.keeper.SetClientConsensusState(suite.ctx, testClientID, height, suite.consensusState)
+
+	// the consensus state is stored compressed
+	stored := suite.keeper.ClientStore(suite.ctx, testClientID).Get(host.KeyConsensusState(height))
+	suite.Require().True(types.IsCompressedConsensusState(stored))
+
+	canonical, err := types.DecompressConsensusState(stored)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.keeper.MustMarshalConsensusState(suite.consensusState), canonical)
+
+	retrievedConsState, found := suite.keeper.GetClientConsensusState(suite.ctx, testClientID, height)
+	suite.Require().True(found, "GetConsensusState failed")
+	suite.Require().Equal(suite.consensusState, retrievedConsState, "ConsensusState not stored correctly")
+
+	// consensus states stored before compression was disabled are still read
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.keeper.SetClientConsensusState(suite.ctx, testClientID, height+1, suite.consensusState)
+
+	stored = suite.keeper.ClientStore(suite.ctx, testClientID).Get(host.KeyConsensusState(height + 1))
+	suite.Require().False(types.IsCompressedConsensusState(stored))
+	suite.Require().Equal(suite.keeper.MustMarshalConsensusState(suite.consensusState), stored)
+
+	var iterated []exported.ConsensusState
+	suite.keeper.IterateClientConsensusStates(suite.ctx, testClientID, func(cs exported.ConsensusState) bool {
+		iterated = append(iterated, cs)
+		return false
+	})
+	suite.Require().Equal([]exported.ConsensusState{suite.consensusState, suite.consensusState}, iterated)
+}
+
+func (suite *KeeperTestSuite) TestImportClientConsensusState() {
+	imported, err := suite.keeper.ImportClientConsensusState(suite.ctx, testClientID, height, suite.consensusState)
+	suite.Require().NoError(err)
+	suite.Require().True(imported)
+
+	retrievedConsState, found := suite.keeper.GetClientConsensusState(suite.ctx, testClientID, height)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.consensusState, retrievedConsState)
+
+	// identical re-import is a no-op
+	identical := *suite.consensusState
+	imported, err = suite.keeper.ImportClientConsensusState(suite.ctx, testClientID, height, &identical)
+	suite.Require().NoError(err)
+	suite.Require().False(imported)
+
+	// conflicting re-import is rejected and the stored consensus state is kept
+	conflicting := *suite.consensusState
+	conflicting.Root = commitmenttypes.NewMerkleRoot([]byte("conflicting root"))
+	imported, err = suite.keeper.ImportClientConsensusState(suite.ctx, testClientID, height, &conflicting)
+	suite.Require().Error(err)
+	suite.Require().False(imported)
+
+	retrievedConsState, found = suite.keeper.GetClientConsensusState(suite.ctx, testClientID, height)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.consensusState, retrievedConsState)
+
+	// consensus states at other heights are imported
+	other := *suite.consensusState
+	other.Height = types.NewHeight(0, height+1)
+	imported, err = suite.keeper.ImportClientConsensusState(suite.ctx, testClientID, height+1, &other)
+	suite.Require().NoError(err)
+	suite.Require().True(imported)
+	suite.Require().True(suite.keeper.HasClientConsensusState(suite.ctx, testClientID, height+1))
+}
+
 func (suite *KeeperTestSuite) TestValidateSelfClient() {
 	testCases := []struct {
 		name        string
@@ -156,7 +255,7 @@ func (suite *KeeperTestSuite) TestValidateSelfClient() {
 		},
 		{
 			"frozen client",
-			&ibctmtypes.ClientState{testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, testClientHeight, commitmenttypes.GetSDKSpecs()},
+			&ibctmtypes.ClientState{testChainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, testClientHeight, testClientHeight, commitmenttypes.GetSDKSpecs(), 0, 0},
 			false,
 		},
 		{
@@ -308,6 +407,140 @@ func (suite KeeperTestSuite) TestConsensusStateHelpers() {
 	suite.Require().Equal(suite.consensusState, lte, "LTE helper function did not return latest client state below height: %d", height+3)
 }
 
+func (suite KeeperTestSuite) TestIterateConsensusStatesReverse() {
+	heights := []uint64{2, 4, 6, 8}
+	for i, h := range heights {
+		cs := ibctmtypes.NewConsensusState(
+			suite.now.Add(time.Duration(i)*time.Minute), commitmenttypes.NewMerkleRoot([]byte(fmt.Sprintf("hash%d", h))), types.NewHeight(0, h), nil,
+		)
+		suite.keeper.SetClientConsensusState(suite.ctx, testClientID, h, cs)
+	}
+	// the consensus states of other clients are not iterated
+	suite.keeper.SetClientConsensusState(suite.ctx, testClientID2, 5, suite.consensusState)
+
+	testCases := []struct {
+		msg         string
+		startHeight types.Height
+		stopAfter   int
+		expHeights  []uint64
+	}{
+		{"start at a consensus state height", types.NewHeight(0, 8), 0, []uint64{8, 6, 4, 2}},
+		{"start between consensus state heights", types.NewHeight(0, 5), 0, []uint64{4, 2}},
+		{"start above the latest height", types.NewHeight(0, 100), 0, []uint64{8, 6, 4, 2}},
+		{"start below the lowest height", types.NewHeight(0, 1), 0, nil},
+		{"start in a later epoch", types.NewHeight(1, 0), 0, []uint64{8, 6, 4, 2}},
+		{"early stop", types.NewHeight(0, 8), 2, []uint64{8, 6}},
+	}
+
+	for _, tc := range testCases {
+		var iterated []uint64
+		suite.keeper.IterateConsensusStatesReverse(suite.ctx, testClientID, tc.startHeight, func(height types.Height, cs exported.ConsensusState) bool {
+			suite.Require().Equal(height.EpochHeight, cs.GetHeight(), tc.msg)
+			iterated = append(iterated, cs.GetHeight())
+			return len(iterated) == tc.stopAfter
+		})
+		suite.Require().Equal(tc.expHeights, iterated, tc.msg)
+	}
+}
+
+func (suite KeeperTestSuite) TestGetPreviousClientConsensusState() {
+	for i, h := range []uint64{2, 12, 5} {
+		cs := ibctmtypes.NewConsensusState(
+			suite.now.Add(time.Duration(i)*time.Minute), commitmenttypes.NewMerkleRoot([]byte(fmt.Sprintf("hash%d", h))), types.NewHeight(0, h), nil,
+		)
+		suite.keeper.SetClientConsensusState(suite.ctx, testClientID, h, cs)
+	}
+	// the consensus states of other clients are not returned
+	suite.keeper.SetClientConsensusState(suite.ctx, testClientID2, 11, suite.consensusState)
+
+	testCases := []struct {
+		msg       string
+		height    types.Height
+		expHeight uint64
+		expFound  bool
+	}{
+		{"consensus state height", types.NewHeight(0, 12), 5, true},
+		{"height between consensus state heights", types.NewHeight(0, 11), 5, true},
+		{"lowest consensus state height", types.NewHeight(0, 2), 0, false},
+		{"lowest possible height", types.NewHeight(0, 0), 0, false},
+		{"start of a later epoch", types.NewHeight(1, 0), 12, true},
+	}
+
+	for _, tc := range testCases {
+		previous, found := suite.keeper.GetPreviousClientConsensusState(suite.ctx, testClientID, tc.height)
+		suite.Require().Equal(tc.expFound, found, tc.msg)
+		if tc.expFound {
+			suite.Require().Equal(tc.expHeight, previous.GetHeight(), tc.msg)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestIterateConsensusStatesReverseMalformedKey() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	k.SetClientConsensusState(ctx, testClientID, 2, suite.consensusState)
+	k.SetClientConsensusState(ctx, testClientID, 4, suite.consensusState)
+
+	// an index key too short to decode to a height is skipped
+	store := ctx.KVStore(suite.chainA.App.GetKey(host.StoreKey))
+	store.Set(host.KeyConsensusHeight(testClientID, []byte{1, 2, 3}), sdk.Uint64ToBigEndian(3))
+
+	var iterated []types.Height
+	suite.Require().NotPanics(func() {
+		k.IterateConsensusStatesReverse(ctx, testClientID, types.NewHeight(0, 10), func(height types.Height, _ exported.ConsensusState) bool {
+			iterated = append(iterated, height)
+			return false
+		})
+	})
+	suite.Require().Equal([]types.Height{types.NewHeight(0, 4), types.NewHeight(0, 2)}, iterated)
+}
+
+func (suite KeeperTestSuite) TestGetConsensusStatesAround() {
+	setConsensusStates := func(clientID string, heights ...uint64) {
+		for i, h := range heights {
+			cs := ibctmtypes.NewConsensusState(
+				suite.now.Add(time.Duration(i)*time.Minute), commitmenttypes.NewMerkleRoot([]byte(fmt.Sprintf("hash%d", h))), types.NewHeight(0, h), nil,
+			)
+			suite.keeper.SetClientConsensusState(suite.ctx, clientID, h, cs)
+		}
+	}
+
+	// dense store: a consensus state at every height from 1 to 10
+	setConsensusStates(testClientID, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	// sparse store: fewer consensus states than the radius on each side of most pivots
+	setConsensusStates(testClientID2, 2, 4, 10)
+	// the consensus states of other clients are not returned
+	setConsensusStates(testClientID3, 3, 5)
+
+	testCases := []struct {
+		msg        string
+		clientID   string
+		pivot      types.Height
+		radius     uint64
+		expHeights []uint64
+	}{
+		{"dense store, pivot present", testClientID, types.NewHeight(0, 5), 2, []uint64{3, 4, 5, 6, 7}},
+		{"dense store, zero radius", testClientID, types.NewHeight(0, 5), 0, []uint64{5}},
+		{"dense store, pivot near the lowest height", testClientID, types.NewHeight(0, 2), 3, []uint64{1, 2, 3, 4, 5}},
+		{"dense store, pivot near the latest height", testClientID, types.NewHeight(0, 9), 3, []uint64{6, 7, 8, 9, 10}},
+		{"dense store, pivot above the latest height", testClientID, types.NewHeight(0, 20), 2, []uint64{9, 10}},
+		{"sparse store, pivot absent", testClientID2, types.NewHeight(0, 5), 3, []uint64{2, 4, 10}},
+		{"sparse store, pivot present", testClientID2, types.NewHeight(0, 4), 3, []uint64{2, 4, 10}},
+		{"sparse store, pivot absent with zero radius", testClientID2, types.NewHeight(0, 5), 0, nil},
+		{"sparse store, radius limits one side only", testClientID2, types.NewHeight(0, 5), 1, []uint64{4, 10}},
+		{"no consensus states", "unknownclient", types.NewHeight(0, 5), 2, nil},
+	}
+
+	for _, tc := range testCases {
+		var heights []uint64
+		for _, cs := range suite.keeper.GetConsensusStatesAround(suite.ctx, tc.clientID, tc.pivot, tc.radius) {
+			heights = append(heights, cs.GetHeight())
+		}
+		suite.Require().Equal(tc.expHeights, heights, tc.msg)
+	}
+}
+
 func (suite KeeperTestSuite) TestGetAllConsensusStates() {
 	expConsensus := []exported.ConsensusState{
 		ibctmtypes.NewConsensusState(
@@ -322,6 +555,9 @@ func (suite KeeperTestSuite) TestGetAllConsensusStates() {
 		ibctmtypes.NewConsensusState(
 			suite.consensusState.Timestamp.Add(2*time.Minute), commitmenttypes.NewMerkleRoot([]byte("app_hash_2")), types.NewHeight(0, suite.consensusState.GetHeight()+2), nil,
 		),
+		ibctmtypes.NewConsensusState(
+			suite.consensusState.Timestamp.Add(3*time.Minute), commitmenttypes.NewMerkleRoot([]byte("app_hash_3")), types.NewHeight(0, suite.consensusState.GetHeight()+5), nil,
+		),
 	}
 
 	expAnyConsensus := types.ClientsConsensusStates{
@@ -332,6 +568,7 @@ func (suite KeeperTestSuite) TestGetAllConsensusStates() {
 	suite.keeper.SetClientConsensusState(suite.ctx, testClientID, expConsensus[0].GetHeight(), expConsensus[0])
 	suite.keeper.SetClientConsensusState(suite.ctx, testClientID, expConsensus[1].GetHeight(), expConsensus[1])
 	suite.keeper.SetClientConsensusState(suite.ctx, testClientID2, expConsensus2[0].GetHeight(), expConsensus2[0])
+	suite.keeper.SetClientConsensusState(suite.ctx, testClientID2, expConsensus2[1].GetHeight(), expConsensus2[1])
 
 	consStates := suite.keeper.GetAllConsensusStates(suite.ctx)
 	suite.Require().Equal(expAnyConsensus, consStates, "%s \n\n%s", expAnyConsensus, consStates)
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// legacyConsensusStateKey defines a consensus state store key whose height is a
+// bare uint64 without an epoch.
+type legacyConsensusStateKey struct {
+	clientID string
+	height   uint64
+}
+
+// MigrateConsensusStateKeys rebuilds the index of consensus states ordered by
+// height, indexing the consensus states stored before the index existed. The
+// legacy flat height of each consensus state store key is migrated to an
+// epoch-aware height, in the epoch of the stored consensus state, and written to
+// the index in the Height.Bytes format. Index entries that don't match the epoch
+// of their consensus state are replaced. The consensus states are kept at their
+// ICS paths, as counterparty chains verify proofs against them. It returns the
+// number of index entries written, so that migrating a store more than once
+// writes no entry. It is run by the ConsensusHeightIndexUpgrade upgrade handler.
+func (k Keeper) MigrateConsensusStateKeys(ctx sdk.Context) (uint64, error) {
+	var legacyKeys []legacyConsensusStateKey
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), host.KeyClientStorePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// consensus key is in the format "clients/<clientID>/consensusState/<height>"
+		if len(keySplit) != 4 || keySplit[2] != "consensusState" {
+			continue
+		}
+
+		height, err := strconv.ParseUint(keySplit[3], 10, 64)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidHeight, "consensus state key %s: %s", iterator.Key(), err)
+		}
+
+		legacyKeys = append(legacyKeys, legacyConsensusStateKey{clientID: keySplit[1], height: height})
+	}
+
+	// the index is rewritten once the iteration over the store is done
+	store := ctx.KVStore(k.storeKey)
+	indexed := make(map[string]bool)
+	indexKeys, _ := collectKeyValues(store, []byte(fmt.Sprintf("%s/", host.KeyConsensusHeightPrefix)))
+	for _, key := range indexKeys {
+		indexed[string(key)] = true
+		store.Delete(key)
+	}
+
+	var migrated uint64
+	for _, legacyKey := range legacyKeys {
+		consensusState, found := k.GetClientConsensusState(ctx, legacyKey.clientID, legacyKey.height)
+		if !found {
+			continue
+		}
+
+		height := types.ConsensusStateEpochHeight(consensusState, legacyKey.height)
+		key := host.KeyConsensusHeight(legacyKey.clientID, height.Bytes())
+		store.Set(key, sdk.Uint64ToBigEndian(legacyKey.height))
+		if !indexed[string(key)] {
+			migrated++
+		}
+	}
+
+	return migrated, nil
+}
+
+// MigrateClientID moves a client from the old identifier to the new one: every
+// key of the client store, including the client state, the client type and the
+// consensus states, is moved under the store prefix of the new identifier, along
+// with the entries of the client in the consensus height and latest height
+// indexes. The new identifier must be valid and unused. Clients referenced by
+// connections cannot be migrated, as the connection ends of both chains refer to
+// the client by its identifier. All the checks are performed before the store is
+// written, so that the migration is either fully applied or not at all.
+func (k Keeper) MigrateClientID(ctx sdk.Context, oldID, newID string) error {
+	if err := host.ClientIdentifierValidator(newID); err != nil {
+		return sdkerrors.Wrapf(err, "cannot migrate client %s", oldID)
+	}
+
+	if oldID == newID {
+		return sdkerrors.Wrapf(types.ErrClientExists, "cannot migrate client %s to the same identifier", oldID)
+	}
+
+	clientState, found := k.GetClientState(ctx, oldID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot migrate client %s", oldID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(host.KeyClientConnections(oldID)) {
+		return sdkerrors.Wrapf(types.ErrClientInUse, "cannot migrate client %s", oldID)
+	}
+
+	newStore := k.ClientStore(ctx, newID)
+	iterator := newStore.Iterator(nil, nil)
+	newIDUsed := iterator.Valid()
+	iterator.Close()
+	if newIDUsed {
+		return sdkerrors.Wrapf(types.ErrClientExists, "cannot migrate client %s to %s", oldID, newID)
+	}
+
+	// the keys are moved once the iteration over the store is done
+	oldStore := k.ClientStore(ctx, oldID)
+	keys, values := collectKeyValues(oldStore, nil)
+	for i, key := range keys {
+		newStore.Set(key, values[i])
+		oldStore.Delete(key)
+	}
+
+	heightKeys, heightValues := collectKeyValues(store, host.KeyConsensusHeightsPrefix(oldID))
+	for i, key := range heightKeys {
+		heightBz := key[len(host.KeyConsensusHeightsPrefix(oldID)):]
+		store.Set(host.KeyConsensusHeight(newID, heightBz), heightValues[i])
+		store.Delete(key)
+	}
+
+	latestHeight := types.ClientStateEpochHeight(clientState)
+	if bz := store.Get(host.KeyIndexedClientHeight(oldID)); bz != nil {
+		if indexedHeight, err := types.HeightFromBytes(bz); err == nil {
+			latestHeight = indexedHeight
+		}
+		store.Delete(host.KeyHeightOrderedClient(bz, oldID))
+		store.Delete(host.KeyIndexedClientHeight(oldID))
+	}
+	k.setHeightOrderedClient(ctx, newID, latestHeight)
+
+	k.Logger(ctx).Info(fmt.Sprintf("client %s migrated to %s", oldID, newID))
+
+	return nil
+}
+
+// collectKeyValues returns the keys, including the prefix, and the values of the
+// store entries with the given prefix.
+func collectKeyValues(store sdk.KVStore, prefix []byte) (keys, values [][]byte) {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		values = append(values, iterator.Value())
+	}
+
+	return keys, values
+}
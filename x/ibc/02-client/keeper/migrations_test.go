@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrateConsensusStateKeys() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	// consensus states of a counterparty chain in epoch 1
+	epochConsensusState := ibctmtypes.NewConsensusState(
+		suite.now, commitmenttypes.NewMerkleRoot([]byte("hash")), types.NewHeight(1, height), suite.valSetHash,
+	)
+
+	// legacy keys whose decimal heights aren't ordered numerically in the store
+	legacyHeights := []uint64{10, 2, 100, 9}
+	for _, height := range legacyHeights {
+		store := k.ClientStore(ctx, testClientID)
+		store.Set(host.KeyConsensusState(height), k.MustMarshalConsensusState(epochConsensusState))
+	}
+	store := k.ClientStore(ctx, testClientID2)
+	store.Set(host.KeyConsensusState(5), k.MustMarshalConsensusState(suite.consensusState))
+
+	// an index entry written in the wrong epoch is replaced
+	ibcStore := ctx.KVStore(suite.chainA.App.GetKey(host.StoreKey))
+	staleKey := host.KeyConsensusHeight(testClientID, types.NewHeight(0, 10).Bytes())
+	ibcStore.Set(staleKey, sdk.Uint64ToBigEndian(10))
+
+	migrated, err := k.MigrateConsensusStateKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(len(legacyHeights)+1), migrated)
+	suite.Require().False(ibcStore.Has(staleKey))
+
+	// the index iterates the migrated heights in ascending order, in the epoch
+	// of the consensus states
+	var indexed []uint64
+	prefix := host.KeyConsensusHeightsPrefix(testClientID)
+	iterator := sdk.KVStorePrefixIterator(ibcStore, prefix)
+	for ; iterator.Valid(); iterator.Next() {
+		heightBz := iterator.Key()[len(prefix):]
+		suite.Require().Equal(types.MigrateHeight(sdk.BigEndianToUint64(iterator.Value()), 1).Bytes(), heightBz)
+		indexed = append(indexed, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+	suite.Require().Equal([]uint64{2, 9, 10, 100}, indexed)
+	suite.Require().True(ibcStore.Has(host.KeyConsensusHeight(testClientID2, types.NewHeight(0, 5).Bytes())))
+
+	// the consensus states are kept at their ICS paths
+	for _, height := range legacyHeights {
+		suite.Require().True(k.HasClientConsensusState(ctx, testClientID, height))
+	}
+
+	// migrating again is a no-op
+	migrated, err = k.MigrateConsensusStateKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Zero(migrated)
+
+	// consensus states set through the keeper are indexed in their epoch
+	k.SetClientConsensusState(ctx, testClientID3, 7, epochConsensusState)
+	suite.Require().True(ibcStore.Has(host.KeyConsensusHeight(testClientID3, types.NewHeight(1, 7).Bytes())))
+	migrated, err = k.MigrateConsensusStateKeys(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Zero(migrated)
+}
+
+func (suite *KeeperTestSuite) TestConsensusHeightIndexUpgrade() {
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	k.ClientStore(ctx, testClientID).Set(host.KeyConsensusState(4), k.MustMarshalConsensusState(suite.consensusState))
+
+	plan := upgradetypes.Plan{Name: types.ConsensusHeightIndexUpgrade, Height: ctx.BlockHeight()}
+	suite.Require().True(suite.chainA.App.UpgradeKeeper.HasHandler(plan.Name))
+	suite.chainA.App.UpgradeKeeper.ApplyUpgrade(ctx, plan)
+
+	ibcStore := ctx.KVStore(suite.chainA.App.GetKey(host.StoreKey))
+	suite.Require().True(ibcStore.Has(host.KeyConsensusHeight(testClientID, types.NewHeight(0, 4).Bytes())))
+}
+
+func (suite *KeeperTestSuite) TestMigrateClientID() {
+	clientA, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+	// update the client so that it has several consensus states
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, clientA, exported.Tendermint))
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	clientState, found := k.GetClientState(ctx, clientA)
+	suite.Require().True(found)
+
+	var consensusStates []exported.ConsensusState
+	k.IterateClientConsensusStates(ctx, clientA, func(cs exported.ConsensusState) bool {
+		consensusStates = append(consensusStates, cs)
+		return false
+	})
+	suite.Require().Len(consensusStates, 2)
+
+	newID := "migratedclient"
+	suite.Require().NoError(k.MigrateClientID(ctx, clientA, newID))
+
+	// nothing is left under the old identifier
+	_, found = k.GetClientState(ctx, clientA)
+	suite.Require().False(found)
+	_, found = k.GetClientType(ctx, clientA)
+	suite.Require().False(found)
+	for _, cs := range consensusStates {
+		suite.Require().False(k.HasClientConsensusState(ctx, clientA, cs.GetHeight()))
+	}
+
+	migratedState, found := k.GetClientState(ctx, newID)
+	suite.Require().True(found)
+	suite.Require().Equal(clientState, migratedState)
+
+	clientType, found := k.GetClientType(ctx, newID)
+	suite.Require().True(found)
+	suite.Require().Equal(exported.Tendermint, clientType)
+
+	var migratedConsensusStates []exported.ConsensusState
+	k.IterateClientConsensusStates(ctx, newID, func(cs exported.ConsensusState) bool {
+		migratedConsensusStates = append(migratedConsensusStates, cs)
+		return false
+	})
+	suite.Require().Equal(consensusStates, migratedConsensusStates)
+
+	latest, found := k.GetLatestClientConsensusState(ctx, newID)
+	suite.Require().True(found)
+	suite.Require().Equal(clientState.GetLatestHeight(), latest.GetHeight())
+
+	var clientIDs []string
+	k.IterateClients(ctx, func(clientID string, _ exported.ClientState) bool {
+		clientIDs = append(clientIDs, clientID)
+		return false
+	})
+	suite.Require().Contains(clientIDs, newID)
+	suite.Require().NotContains(clientIDs, clientA)
+
+	// the client can still be updated under the new identifier
+	suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, newID, exported.Tendermint))
+}
+
+func (suite *KeeperTestSuite) TestMigrateClientIDRejected() {
+	clientA, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+	otherClientA, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+	connectedClientA, _, _, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+	ctx := suite.chainA.GetContext()
+	k := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	testCases := []struct {
+		name   string
+		oldID  string
+		newID  string
+		expErr error
+	}{
+		{"new identifier already used", clientA, otherClientA, types.ErrClientExists},
+		{"same identifier", clientA, clientA, types.ErrClientExists},
+		{"client not found", "unknownclient", "migratedclient", types.ErrClientNotFound},
+		{"client used by a connection", connectedClientA, "migratedclient", types.ErrClientInUse},
+	}
+
+	for _, tc := range testCases {
+		err := k.MigrateClientID(ctx, tc.oldID, tc.newID)
+		suite.Require().True(errors.Is(err, tc.expErr), "%s: %v", tc.name, err)
+	}
+
+	suite.Require().Error(k.MigrateClientID(ctx, clientA, "invalid/identifier"))
+
+	// rejected migrations leave the clients untouched
+	for _, clientID := range []string{clientA, otherClientA, connectedClientA} {
+		_, found := k.GetClientState(ctx, clientID)
+		suite.Require().True(found)
+	}
+	_, found := k.GetClientState(ctx, "migratedclient")
+	suite.Require().False(found)
+}
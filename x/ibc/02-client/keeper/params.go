@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// IsManualFreezeAllowed retrieves the allow manual freeze boolean from the
+// paramstore
+func (k Keeper) IsManualFreezeAllowed(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyAllowManualFreeze, &res)
+	return res
+}
+
+// GetMinTrustingPeriod retrieves the minimum trusting period of the clients
+// created through MsgCreateClient from the paramstore
+func (k Keeper) GetMinTrustingPeriod(ctx sdk.Context) time.Duration {
+	var res time.Duration
+	k.paramSpace.Get(ctx, types.KeyMinTrustingPeriod, &res)
+	return res
+}
+
+// GetFreezeAuthority retrieves the address allowed to freeze clients through
+// MsgFreezeClient from the paramstore
+func (k Keeper) GetFreezeAuthority(ctx sdk.Context) string {
+	var res string
+	k.paramSpace.Get(ctx, types.KeyFreezeAuthority, &res)
+	return res
+}
+
+// IsLegacyProofAllowed retrieves the allow legacy proofs boolean from the
+// paramstore
+func (k Keeper) IsLegacyProofAllowed(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyAllowLegacyProofs, &res)
+	return res
+}
+
+// IsConsensusStateCompressionEnabled retrieves the compress consensus states
+// boolean from the paramstore
+func (k Keeper) IsConsensusStateCompressionEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.Get(ctx, types.KeyCompressConsensusStates, &res)
+	return res
+}
+
+// GetParams returns the total set of ibc client parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		k.IsManualFreezeAllowed(ctx), k.GetMinTrustingPeriod(ctx), k.GetFreezeAuthority(ctx),
+		k.IsLegacyProofAllowed(ctx), k.IsConsensusStateCompressionEnabled(ctx),
+	)
+}
+
+// SetParams sets the total set of ibc client parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
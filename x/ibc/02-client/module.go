@@ -13,6 +13,11 @@ func Name() string {
 	return types.SubModuleName
 }
 
+// GetTxCmd returns the root tx command for the IBC client.
+func GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
 // GetQueryCmd returns no root query command for the IBC client
 func GetQueryCmd() *cobra.Command {
 	return cli.GetQueryCmd()
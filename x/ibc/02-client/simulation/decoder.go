@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
@@ -30,11 +32,22 @@ func NewDecodeStore(cdc ClientUnmarshaler, kvA, kvB kv.Pair) (string, bool) {
 	case bytes.HasPrefix(kvA.Key, host.KeyClientStorePrefix) && bytes.HasSuffix(kvA.Key, host.KeyClientType()):
 		return fmt.Sprintf("Client type A: %s\nClient type B: %s", string(kvA.Value), string(kvB.Value)), true
 
+	case bytes.HasPrefix(kvA.Key, host.KeyConsensusHeightPrefix):
+		return fmt.Sprintf("Consensus height A: %d\nConsensus height B: %d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value)), true
+
 	case bytes.HasPrefix(kvA.Key, host.KeyClientStorePrefix) && bytes.Contains(kvA.Key, []byte("consensusState")):
 		consensusStateA := cdc.MustUnmarshalConsensusState(kvA.Value)
 		consensusStateB := cdc.MustUnmarshalConsensusState(kvB.Value)
 		return fmt.Sprintf("ConsensusState A: %v\nConsensusState B: %v", consensusStateA, consensusStateB), true
 
+	case bytes.HasPrefix(kvA.Key, host.KeyHeightOrderedClientsPrefix):
+		return fmt.Sprintf("Height ordered client A: %s\nHeight ordered client B: %s", string(kvA.Value), string(kvB.Value)), true
+
+	case bytes.HasPrefix(kvA.Key, host.KeyIndexedClientHeightPrefix):
+		heightA, _ := types.HeightFromBytes(kvA.Value)
+		heightB, _ := types.HeightFromBytes(kvB.Value)
+		return fmt.Sprintf("Indexed client height A: %s\nIndexed client height B: %s", heightA, heightB), true
+
 	default:
 		return "", false
 	}
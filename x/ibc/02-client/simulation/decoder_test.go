@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/simulation"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
@@ -43,6 +44,18 @@ func TestDecodeStore(t *testing.T) {
 				Key:   host.FullKeyClientPath(clientID, host.KeyConsensusState(10)),
 				Value: app.IBCKeeper.ClientKeeper.MustMarshalConsensusState(consState),
 			},
+			{
+				Key:   host.KeyHeightOrderedClient(types.NewHeight(0, 10).Bytes(), clientID),
+				Value: []byte(clientID),
+			},
+			{
+				Key:   host.KeyIndexedClientHeight(clientID),
+				Value: types.NewHeight(0, 10).Bytes(),
+			},
+			{
+				Key:   host.KeyConsensusHeight(clientID, types.NewHeight(0, 10).Bytes()),
+				Value: sdk.Uint64ToBigEndian(10),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -56,6 +69,9 @@ func TestDecodeStore(t *testing.T) {
 		{"ClientState", fmt.Sprintf("ClientState A: %v\nClientState B: %v", clientState, clientState)},
 		{"client type", fmt.Sprintf("Client type A: %s\nClient type B: %s", exported.Tendermint, exported.Tendermint)},
 		{"ConsensusState", fmt.Sprintf("ConsensusState A: %v\nConsensusState B: %v", consState, consState)},
+		{"height ordered client", fmt.Sprintf("Height ordered client A: %s\nHeight ordered client B: %s", clientID, clientID)},
+		{"indexed client height", fmt.Sprintf("Indexed client height A: %s\nIndexed client height B: %s", types.NewHeight(0, 10), types.NewHeight(0, 10))},
+		{"consensus height", "Consensus height A: 10\nConsensus height B: 10"},
 		{"other", ""},
 	}
 
@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// RandomHeight returns a random valid height, with a non-zero epoch height. The
+// magnitudes of the epoch number and epoch height vary from a single bit to the
+// full uint64 range so that small, equal and extreme values are all generated.
+func RandomHeight(r *rand.Rand) types.Height {
+	epochNumber := randomUint64(r)
+
+	epochHeight := randomUint64(r)
+	if epochHeight == 0 {
+		epochHeight = 1
+	}
+
+	return types.NewHeight(epochNumber, epochHeight)
+}
+
+// randomUint64 returns a random uint64 with a random number of significant bits.
+func randomUint64(r *rand.Rand) uint64 {
+	return r.Uint64() >> uint(r.Intn(65))
+}
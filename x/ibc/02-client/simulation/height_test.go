@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/simulation"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+const heightSamples = 1000
+
+func TestRandomHeightRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < heightSamples; i++ {
+		height := simulation.RandomHeight(r)
+		require.NotZero(t, height.EpochHeight, height.String())
+
+		parsed, err := types.ParseHeight(height.String())
+		require.NoError(t, err, height.String())
+		require.Equal(t, height, parsed)
+
+		parsed, err = types.HeightFromBytes(height.Bytes())
+		require.NoError(t, err, height.String())
+		require.Equal(t, height, parsed)
+	}
+}
+
+func TestRandomHeightBytesOrdering(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < heightSamples; i++ {
+		a, b := simulation.RandomHeight(r), simulation.RandomHeight(r)
+		// compare some heights to themselves and to heights of the same epoch
+		switch i % 4 {
+		case 0:
+			b = a
+		case 1:
+			b.EpochNumber = a.EpochNumber
+		}
+
+		cmp := a.Compare(b)
+		require.Equal(t, sign(cmp), int64(bytes.Compare(a.Bytes(), b.Bytes())), "%s vs %s", a, b)
+		require.Equal(t, cmp == 0, a.EQ(b), "%s vs %s", a, b)
+	}
+}
+
+func sign(cmp int64) int64 {
+	switch {
+	case cmp < 0:
+		return -1
+	case cmp > 0:
+		return 1
+	default:
+		return 0
+	}
+}
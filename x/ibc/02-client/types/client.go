@@ -1,11 +1,16 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"reflect"
+	"time"
 
+	ics23 "github.com/confio/ics23/go"
 	proto "github.com/gogo/protobuf/proto"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
@@ -29,6 +34,273 @@ func NewIdentifiedClientState(clientID string, clientState exported.ClientState)
 	}
 }
 
+// ChainIDClientState is implemented by the client states that track a chain
+// identified by a chain-id, such as Tendermint and localhost client states.
+type ChainIDClientState interface {
+	GetChainID() string
+}
+
+// clientChainID returns the chain-id of a client state, or an empty string if it
+// doesn't track a chain-id, such as solo machine ones.
+func clientChainID(clientState exported.ClientState) string {
+	ccs, ok := clientState.(ChainIDClientState)
+	if !ok {
+		return ""
+	}
+	return ccs.GetChainID()
+}
+
+// ValidateHeaderChainID returns an error if the chain-id of the header doesn't
+// match the chain-id of the client state it is meant to update. Client states
+// without a chain-id, such as solo machine ones, are exempt.
+func ValidateHeaderChainID(clientState exported.ClientState, header exported.Header) error {
+	ccs, ok := clientState.(ChainIDClientState)
+	if !ok {
+		return nil
+	}
+
+	if header.GetChainID() != ccs.GetChainID() {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader, "header chain-id (%s) does not match client state chain-id (%s)",
+			header.GetChainID(), ccs.GetChainID(),
+		)
+	}
+	return nil
+}
+
+// ValidateHeaderConsensusTypeMatch returns an error if the client type of the
+// header doesn't match the client type of the consensus state it is meant to be
+// verified against, eg: a tendermint header submitted to a solo machine client.
+func ValidateHeaderConsensusTypeMatch(consensusState exported.ConsensusState, header exported.Header) error {
+	if header.ClientType() != consensusState.ClientType() {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeader, "header client type (%s) does not match consensus state client type (%s)",
+			header.ClientType(), consensusState.ClientType(),
+		)
+	}
+	return nil
+}
+
+// ValidateClientNotFrozen returns an error if the client is frozen and the proof
+// height is greater than or equal to the frozen height. Proofs at a lower height
+// predate the misbehaviour and can still be verified.
+func ValidateClientNotFrozen(clientState exported.ClientState, height uint64) error {
+	if clientState.IsFrozen() && height >= clientState.GetFrozenHeight() {
+		return sdkerrors.Wrapf(
+			ErrClientFrozen, "proof height (%d) is not lower than the frozen height (%d)",
+			height, clientState.GetFrozenHeight(),
+		)
+	}
+	return nil
+}
+
+// EpochConsensusState is implemented by the consensus states whose height has
+// an epoch number, such as Tendermint consensus states.
+type EpochConsensusState interface {
+	GetEpochNumber() uint64
+}
+
+// ConsensusStateEpochHeight returns the epoch-aware height of a consensus state
+// stored at the given height. Consensus states without an epoch number, such as
+// solo machine ones, are in epoch zero.
+func ConsensusStateEpochHeight(consensusState exported.ConsensusState, height uint64) Height {
+	ecs, ok := consensusState.(EpochConsensusState)
+	if !ok {
+		return NewHeight(0, height)
+	}
+	return NewHeight(ecs.GetEpochNumber(), height)
+}
+
+// EpochClientState is implemented by the client states whose latest height has
+// an epoch number, such as Tendermint client states.
+type EpochClientState interface {
+	GetEpochNumber() uint64
+}
+
+// ClientStateEpochHeight returns the epoch-aware latest height of a client state.
+// Client states without an epoch number, such as solo machine ones, are in epoch
+// zero.
+func ClientStateEpochHeight(clientState exported.ClientState) Height {
+	ecs, ok := clientState.(EpochClientState)
+	if !ok {
+		return NewHeight(0, clientState.GetLatestHeight())
+	}
+	return NewHeight(ecs.GetEpochNumber(), clientState.GetLatestHeight())
+}
+
+// FreezableClientState is implemented by the client states that can be frozen
+// at a given height without misbehaviour, as done by the manual freeze of test
+// networks.
+type FreezableClientState interface {
+	exported.ClientState
+
+	// Freeze returns a copy of the client state frozen at the given height,
+	// leaving the client state unchanged.
+	Freeze(frozenHeight Height) exported.ClientState
+}
+
+// TrustingPeriodClientState is implemented by the client states that trust their
+// consensus states for a limited period of time.
+type TrustingPeriodClientState interface {
+	GetTrustingPeriod() time.Duration
+}
+
+// ValidateMinTrustingPeriod returns an error if the client state has a trusting
+// period below the given minimum. Client states without a trusting period, such
+// as localhost, are exempt, and a zero minimum disables the check.
+func ValidateMinTrustingPeriod(clientState exported.ClientState, minTrustingPeriod time.Duration) error {
+	if minTrustingPeriod <= 0 {
+		return nil
+	}
+
+	periodClientState, ok := clientState.(TrustingPeriodClientState)
+	if !ok {
+		return nil
+	}
+
+	if trustingPeriod := periodClientState.GetTrustingPeriod(); trustingPeriod < minTrustingPeriod {
+		return sdkerrors.Wrapf(
+			ErrInvalidClient, "trusting period (%s) is below the minimum trusting period (%s)",
+			trustingPeriod, minTrustingPeriod,
+		)
+	}
+	return nil
+}
+
+// ClientStateUpdateValidator is implemented by the client states that restrict the
+// fields a header update can change further than ValidateClientStateUpdate does.
+type ClientStateUpdateValidator interface {
+	ValidateUpdate(updated exported.ClientState) error
+}
+
+// ValidateClientStateUpdate returns an error if a header update of a client
+// changed the client state in a way a header update must not: the client type,
+// chain-id and proof specs must be unchanged, the client must not be frozen and
+// the latest height can only advance. The client states implementing
+// ClientStateUpdateValidator further validate the update.
+func ValidateClientStateUpdate(previous, updated exported.ClientState) error {
+	if updated == nil {
+		return sdkerrors.Wrap(ErrInvalidClientStateUpdate, "updated client state cannot be nil")
+	}
+	if updated.ClientType() != previous.ClientType() {
+		return sdkerrors.Wrapf(
+			ErrInvalidClientStateUpdate, "client type changed from %s to %s",
+			previous.ClientType(), updated.ClientType(),
+		)
+	}
+	if clientChainID(updated) != clientChainID(previous) {
+		return sdkerrors.Wrapf(
+			ErrInvalidClientStateUpdate, "chain-id changed from %s to %s",
+			clientChainID(previous), clientChainID(updated),
+		)
+	}
+	if !proofSpecsEqual(previous.GetProofSpecs(), updated.GetProofSpecs()) {
+		return sdkerrors.Wrap(ErrInvalidClientStateUpdate, "proof specs changed")
+	}
+	if updated.IsFrozen() != previous.IsFrozen() || updated.GetFrozenHeight() != previous.GetFrozenHeight() {
+		return sdkerrors.Wrapf(
+			ErrInvalidClientStateUpdate, "frozen height changed from %d to %d",
+			previous.GetFrozenHeight(), updated.GetFrozenHeight(),
+		)
+	}
+	if updated.GetLatestHeight() < previous.GetLatestHeight() {
+		return sdkerrors.Wrapf(
+			ErrInvalidClientStateUpdate, "latest height decreased from %d to %d",
+			previous.GetLatestHeight(), updated.GetLatestHeight(),
+		)
+	}
+
+	if validator, ok := previous.(ClientStateUpdateValidator); ok {
+		return validator.ValidateUpdate(updated)
+	}
+
+	return nil
+}
+
+// proofSpecsEqual returns true if both lists hold the same proof specs in the same
+// order.
+func proofSpecsEqual(a, b []*ics23.ProofSpec) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateClientBundle validates a client state and the consensus state it is
+// created with, as well as their consistency: both must be of the same client
+// type and the consensus state height cannot exceed the latest height of the
+// client. Consensus states don't commit to a chain-id, which is only validated as
+// part of the client state.
+func ValidateClientBundle(clientState exported.ClientState, consensusState exported.ConsensusState) error {
+	if clientState == nil {
+		return sdkerrors.Wrap(ErrInvalidClient, "client state cannot be nil")
+	}
+	if consensusState == nil {
+		return sdkerrors.Wrap(ErrInvalidConsensus, "consensus state cannot be nil")
+	}
+	if err := clientState.Validate(); err != nil {
+		return err
+	}
+	if err := consensusState.ValidateBasic(); err != nil {
+		return err
+	}
+	if clientState.ClientType() != consensusState.ClientType() {
+		return sdkerrors.Wrapf(
+			ErrInvalidClientType, "consensus state type (%s) does not match client state type (%s)",
+			consensusState.ClientType(), clientState.ClientType(),
+		)
+	}
+	if consensusState.GetHeight() > clientState.GetLatestHeight() {
+		return sdkerrors.Wrapf(
+			ErrInvalidHeight, "consensus state height (%d) is greater than the latest client height (%d)",
+			consensusState.GetHeight(), clientState.GetLatestHeight(),
+		)
+	}
+	return nil
+}
+
+// compatibleClientTypes maps the type of a client tracking the counterparty chain
+// to the types the counterparty client tracking this chain can have. As this chain
+// runs Tendermint consensus, the counterparty must track it with a Tendermint
+// client, except for the localhost loopback client.
+var compatibleClientTypes = map[exported.ClientType][]exported.ClientType{
+	exported.Tendermint:  {exported.Tendermint},
+	exported.SoloMachine: {exported.Tendermint},
+	exported.Localhost:   {exported.Localhost},
+}
+
+// CheckClientCompatibility returns an error if any of the given client types is
+// unknown or if a connection cannot be established between a client of the self
+// client type on this chain and a client of the counterparty client type on the
+// counterparty chain.
+func CheckClientCompatibility(selfClientType, counterpartyClientType string) error {
+	self := exported.ClientTypeFromString(selfClientType)
+	if self == 0 {
+		return sdkerrors.Wrapf(ErrInvalidClientType, "unknown self client type %s", selfClientType)
+	}
+
+	counterparty := exported.ClientTypeFromString(counterpartyClientType)
+	if counterparty == 0 {
+		return sdkerrors.Wrapf(ErrInvalidClientType, "unknown counterparty client type %s", counterpartyClientType)
+	}
+
+	for _, compatible := range compatibleClientTypes[self] {
+		if counterparty == compatible {
+			return nil
+		}
+	}
+
+	return sdkerrors.Wrapf(
+		ErrInvalidClientType, "self client type %s is not compatible with counterparty client type %s",
+		selfClientType, counterpartyClientType,
+	)
+}
+
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
 func (ics IdentifiedClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var clientState exported.ClientState
@@ -38,3 +310,57 @@ func (ics IdentifiedClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	}
 	return nil
 }
+
+// ConsensusStateEqual returns true if both consensus states have the same client
+// type, height, commitment root and timestamp. The comparison is type-aware: two
+// consensus states of different concrete types are never equal, even if all the
+// compared fields match. Two nil consensus states are equal.
+func ConsensusStateEqual(a, b exported.ConsensusState) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+
+	return a.ClientType() == b.ClientType() &&
+		a.GetHeight() == b.GetHeight() &&
+		a.GetTimestamp() == b.GetTimestamp() &&
+		rootsEqual(a.GetRoot(), b.GetRoot())
+}
+
+// HeadersEqual returns true if both headers have the same client type, height and
+// timestamp and, for the headers that are protobuf messages, the same content,
+// including the signed header and its signatures. The comparison is type-aware:
+// two headers of different concrete types are never equal. Two nil headers are
+// equal.
+func HeadersEqual(a, b exported.Header) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false
+	}
+
+	if a.ClientType() != b.ClientType() ||
+		a.GetHeight() != b.GetHeight() ||
+		a.GetTimestamp() != b.GetTimestamp() {
+		return false
+	}
+
+	msgA, okA := a.(proto.Message)
+	msgB, okB := b.(proto.Message)
+	if okA && okB {
+		return proto.Equal(msgA, msgB)
+	}
+	return true
+}
+
+// rootsEqual returns true if both commitment roots have the same hash. Consensus
+// states without a commitment root return a nil root.
+func rootsEqual(a, b exported.Root) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return bytes.Equal(a.GetHash(), b.GetHash())
+}
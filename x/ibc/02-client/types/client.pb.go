@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/duration"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -341,6 +345,73 @@ func (m *MsgSubmitMisbehaviour) GetSigner() github_com_cosmos_cosmos_sdk_types.A
 	return nil
 }
 
+// MsgFreezeClient defines an sdk.Msg type that freezes a light client at a
+// given height without misbehaviour. It is only accepted on chains that allow
+// manual freezing in their params, such as test networks, and only from the
+// freeze authority.
+type MsgFreezeClient struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// height at which the client is frozen
+	FrozenHeight Height `protobuf:"bytes,2,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height" yaml:"frozen_height"`
+	// signer address
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFreezeClient) Reset()         { *m = MsgFreezeClient{} }
+func (m *MsgFreezeClient) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeClient) ProtoMessage()    {}
+func (*MsgFreezeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_226f80e576f20abd, []int{5}
+}
+func (m *MsgFreezeClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeClient.Merge(m, src)
+}
+func (m *MsgFreezeClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeClient proto.InternalMessageInfo
+
+func (m *MsgFreezeClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *MsgFreezeClient) GetFrozenHeight() Height {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return Height{}
+}
+
+func (m *MsgFreezeClient) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
 // Height is a monotonically increasing data type
 // that can be compared against another Height for the purposes of updating and
 // freezing clients
@@ -360,7 +431,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_226f80e576f20abd, []int{5}
+	return fileDescriptor_226f80e576f20abd, []int{6}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,52 +474,157 @@ func (m *Height) GetEpochHeight() uint64 {
 	return 0
 }
 
+// Params defines the set of IBC client parameters.
+type Params struct {
+	// allow freezing clients without misbehaviour through MsgFreezeClient. It
+	// must only be enabled on test networks.
+	AllowManualFreeze bool `protobuf:"varint,1,opt,name=allow_manual_freeze,json=allowManualFreeze,proto3" json:"allow_manual_freeze,omitempty" yaml:"allow_manual_freeze"`
+	// minimum trusting period of the clients created through MsgCreateClient.
+	// Clients without a trusting period are exempt. Zero disables the check.
+	MinTrustingPeriod time.Duration `protobuf:"bytes,2,opt,name=min_trusting_period,json=minTrustingPeriod,proto3,stdduration" json:"min_trusting_period" yaml:"min_trusting_period"`
+	// address allowed to freeze clients through MsgFreezeClient. Nobody can
+	// freeze clients manually if it is empty.
+	FreezeAuthority string `protobuf:"bytes,3,opt,name=freeze_authority,json=freezeAuthority,proto3" json:"freeze_authority,omitempty" yaml:"freeze_authority"`
+	// allow verifying proofs that don't parse as ICS23 proofs in the legacy
+	// format, the JSON encoding of the merkle proof. ICS23 proofs are always
+	// verified as such.
+	AllowLegacyProofs bool `protobuf:"varint,4,opt,name=allow_legacy_proofs,json=allowLegacyProofs,proto3" json:"allow_legacy_proofs,omitempty" yaml:"allow_legacy_proofs"`
+	// store the consensus states of the clients gzip compressed. They are always
+	// decompressed when read, whichever way they were stored.
+	CompressConsensusStates bool `protobuf:"varint,5,opt,name=compress_consensus_states,json=compressConsensusStates,proto3" json:"compress_consensus_states,omitempty" yaml:"compress_consensus_states"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_226f80e576f20abd, []int{7}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAllowManualFreeze() bool {
+	if m != nil {
+		return m.AllowManualFreeze
+	}
+	return false
+}
+
+func (m *Params) GetMinTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.MinTrustingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetFreezeAuthority() string {
+	if m != nil {
+		return m.FreezeAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetAllowLegacyProofs() bool {
+	if m != nil {
+		return m.AllowLegacyProofs
+	}
+	return false
+}
+
+func (m *Params) GetCompressConsensusStates() bool {
+	if m != nil {
+		return m.CompressConsensusStates
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.client.IdentifiedClientState")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.client.ClientConsensusStates")
 	proto.RegisterType((*MsgCreateClient)(nil), "ibc.client.MsgCreateClient")
 	proto.RegisterType((*MsgUpdateClient)(nil), "ibc.client.MsgUpdateClient")
 	proto.RegisterType((*MsgSubmitMisbehaviour)(nil), "ibc.client.MsgSubmitMisbehaviour")
+	proto.RegisterType((*MsgFreezeClient)(nil), "ibc.client.MsgFreezeClient")
 	proto.RegisterType((*Height)(nil), "ibc.client.Height")
+	proto.RegisterType((*Params)(nil), "ibc.client.Params")
 }
 
 func init() { proto.RegisterFile("ibc/client/client.proto", fileDescriptor_226f80e576f20abd) }
 
 var fileDescriptor_226f80e576f20abd = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x8f, 0x12, 0x41,
-	0x1c, 0x65, 0x80, 0x10, 0x6f, 0x20, 0x72, 0x59, 0x41, 0x10, 0x93, 0x5d, 0x32, 0x15, 0x85, 0xec,
-	0x7a, 0xd8, 0x18, 0x3a, 0xa0, 0x91, 0x44, 0x8c, 0xd9, 0x8b, 0x85, 0xc6, 0xe4, 0xb2, 0x3b, 0x3b,
-	0xb7, 0x3b, 0x91, 0xdd, 0x21, 0x3b, 0xb3, 0x46, 0xbe, 0x80, 0xb5, 0xa5, 0x85, 0x85, 0xa5, 0x1f,
-	0x42, 0x7b, 0x3b, 0xaf, 0xb4, 0xda, 0x18, 0xf8, 0x06, 0x94, 0x56, 0x86, 0x99, 0xc5, 0x83, 0xcb,
-	0x49, 0x71, 0x67, 0x71, 0xd5, 0xcc, 0xef, 0xdf, 0xfb, 0xbd, 0xf7, 0x32, 0x19, 0xd8, 0xa0, 0x2e,
-	0xb6, 0xf0, 0x94, 0x92, 0x48, 0x64, 0x87, 0x39, 0x8b, 0x99, 0x60, 0x1a, 0xa4, 0x2e, 0x36, 0x55,
-	0xa6, 0x55, 0xf3, 0x99, 0xcf, 0x64, 0xda, 0x5a, 0xdf, 0x54, 0x47, 0xeb, 0x9e, 0xcf, 0x98, 0x3f,
-	0x25, 0x96, 0x8c, 0xdc, 0xe4, 0xd4, 0x72, 0xa2, 0xb9, 0x2a, 0xa1, 0x4f, 0x00, 0xd6, 0xc7, 0x1e,
-	0x89, 0x04, 0x3d, 0xa5, 0xc4, 0x1b, 0x49, 0x94, 0x63, 0xe1, 0x08, 0xa2, 0x1d, 0xc1, 0x03, 0x05,
-	0x7a, 0x42, 0xbd, 0x26, 0x68, 0x83, 0xce, 0xc1, 0xb0, 0xb6, 0x4a, 0x8d, 0xc3, 0xb9, 0x13, 0x4e,
-	0xfb, 0xe8, 0x6f, 0x09, 0xd9, 0xb7, 0xd4, 0x7d, 0xec, 0x69, 0xcf, 0x61, 0x25, 0xcb, 0xf3, 0x35,
-	0x44, 0x33, 0xdf, 0x06, 0x9d, 0x72, 0xaf, 0x66, 0xaa, 0xf5, 0xe6, 0x66, 0xbd, 0x39, 0x88, 0xe6,
-	0xc3, 0xc6, 0x2a, 0x35, 0xee, 0xec, 0x60, 0xc9, 0x19, 0x64, 0x97, 0xf1, 0x39, 0x09, 0xf4, 0x05,
-	0xc0, 0xba, 0x22, 0x35, 0x62, 0x11, 0x27, 0x11, 0x4f, 0xb8, 0x2c, 0xf0, 0xab, 0xd0, 0x7b, 0x0d,
-	0x0f, 0xf1, 0x06, 0x45, 0x6d, 0xe3, 0xcd, 0x7c, 0xbb, 0xf0, 0x4f, 0x8a, 0xf7, 0x57, 0xa9, 0xd1,
-	0xc8, 0xf0, 0x2e, 0xcc, 0x21, 0xbb, 0x8a, 0x77, 0x09, 0xa1, 0xaf, 0x79, 0x58, 0x9d, 0x70, 0x7f,
-	0x14, 0x13, 0x47, 0x10, 0xc5, 0xf9, 0x46, 0x78, 0xa8, 0xbd, 0x84, 0xd5, 0x0b, 0xf4, 0x9b, 0x85,
-	0x3d, 0xa0, 0xad, 0x55, 0x6a, 0xdc, 0xbd, 0x54, 0x35, 0xb2, 0x6f, 0xef, 0x8a, 0xd6, 0xc6, 0xb0,
-	0xc4, 0xa9, 0x1f, 0x91, 0xb8, 0x59, 0x6c, 0x83, 0x4e, 0x65, 0x78, 0xf4, 0x3b, 0x35, 0xba, 0x3e,
-	0x15, 0x41, 0xe2, 0x9a, 0x98, 0x85, 0x16, 0x66, 0x3c, 0x64, 0x3c, 0x3b, 0xba, 0xdc, 0x7b, 0x63,
-	0x89, 0xf9, 0x8c, 0x70, 0x73, 0x80, 0xf1, 0xc0, 0xf3, 0x62, 0xc2, 0xb9, 0x9d, 0x01, 0xa0, 0x6f,
-	0x40, 0xda, 0xf7, 0x62, 0xe6, 0x5d, 0xcb, 0xbe, 0x07, 0xb0, 0x14, 0x10, 0xc7, 0x23, 0xf1, 0x3e,
-	0xe3, 0xec, 0xac, 0x67, 0x8b, 0x7f, 0xe1, 0xba, 0xfc, 0x7f, 0x00, 0x58, 0x9f, 0x70, 0xff, 0x38,
-	0x71, 0x43, 0x2a, 0x26, 0x94, 0xbb, 0x24, 0x70, 0xde, 0x52, 0x96, 0xc4, 0x57, 0x51, 0xf1, 0x18,
-	0x56, 0xc2, 0x2d, 0x88, 0xbd, 0x5a, 0x76, 0x3a, 0xff, 0xa7, 0xa2, 0xf7, 0x00, 0x96, 0x9e, 0x10,
-	0xea, 0x07, 0x42, 0xeb, 0xc3, 0x0a, 0x99, 0x31, 0x1c, 0x9c, 0x44, 0x49, 0xe8, 0x92, 0x58, 0xaa,
-	0x28, 0x6e, 0x3f, 0xbf, 0xed, 0x2a, 0xb2, 0xcb, 0x32, 0x7c, 0x26, 0xa3, 0xf3, 0xd9, 0x40, 0x62,
-	0x49, 0x2d, 0x97, 0xcc, 0xaa, 0xea, 0x66, 0x56, 0xed, 0xed, 0x17, 0x3f, 0x7e, 0x36, 0x72, 0xc3,
-	0xa7, 0xdf, 0x17, 0x3a, 0x38, 0x5b, 0xe8, 0xe0, 0xd7, 0x42, 0x07, 0x1f, 0x96, 0x7a, 0xee, 0x6c,
-	0xa9, 0xe7, 0x7e, 0x2e, 0xf5, 0xdc, 0xab, 0xde, 0x5e, 0x65, 0xef, 0xac, 0xf5, 0x97, 0xf9, 0xb0,
-	0xd7, 0xcd, 0x7e, 0x4d, 0xa9, 0xd4, 0x2d, 0x49, 0xf7, 0x1e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0xec, 0xf5, 0xcc, 0x58, 0x50, 0x05, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x3d, 0x6f, 0xd3, 0x4e,
+	0x18, 0x8f, 0x9b, 0xfc, 0xa3, 0xf6, 0x9a, 0x3f, 0x69, 0xdd, 0x94, 0xa4, 0x01, 0xd9, 0xd1, 0x09,
+	0xa1, 0x0e, 0xd4, 0xa1, 0x65, 0x41, 0xdd, 0x92, 0xa2, 0x8a, 0x4a, 0x4d, 0x15, 0xb9, 0x74, 0x00,
+	0x21, 0x19, 0xbf, 0x5c, 0x9c, 0x13, 0xb1, 0x2f, 0xf8, 0x6c, 0x20, 0xfd, 0x00, 0xcc, 0x8c, 0x1d,
+	0x18, 0x18, 0xf9, 0x10, 0x30, 0x22, 0x75, 0xa3, 0x23, 0x93, 0x41, 0xad, 0xc4, 0x07, 0xc8, 0xc8,
+	0x84, 0x72, 0x77, 0x21, 0x2f, 0x4d, 0x33, 0xb4, 0x1d, 0x98, 0xec, 0x7b, 0x5e, 0x7e, 0xcf, 0xef,
+	0xf7, 0x3c, 0xe7, 0x3b, 0x83, 0x3c, 0xb6, 0xec, 0xb2, 0xdd, 0xc2, 0xc8, 0x0f, 0xc5, 0x43, 0x6b,
+	0x07, 0x24, 0x24, 0x32, 0xc0, 0x96, 0xad, 0x71, 0x4b, 0x31, 0xe7, 0x12, 0x97, 0x30, 0x73, 0xb9,
+	0xf7, 0xc6, 0x23, 0x8a, 0x2b, 0x2e, 0x21, 0x6e, 0x0b, 0x95, 0xd9, 0xca, 0x8a, 0x1a, 0x65, 0xd3,
+	0xef, 0x08, 0x97, 0x32, 0xee, 0x72, 0xa2, 0xc0, 0x0c, 0x31, 0xf1, 0xb9, 0x1f, 0x7e, 0x90, 0xc0,
+	0xf2, 0x8e, 0x83, 0xfc, 0x10, 0x37, 0x30, 0x72, 0xb6, 0x58, 0x95, 0xfd, 0xd0, 0x0c, 0x91, 0xbc,
+	0x0e, 0xe6, 0x78, 0x51, 0x03, 0x3b, 0x05, 0xa9, 0x24, 0xad, 0xce, 0x55, 0x73, 0xdd, 0x58, 0x5d,
+	0xe8, 0x98, 0x5e, 0x6b, 0x13, 0xfe, 0x75, 0x41, 0x7d, 0x96, 0xbf, 0xef, 0x38, 0x72, 0x1d, 0x64,
+	0x84, 0x9d, 0xf6, 0x20, 0x0a, 0x33, 0x25, 0x69, 0x75, 0x7e, 0x23, 0xa7, 0x71, 0x0e, 0x5a, 0x9f,
+	0x83, 0x56, 0xf1, 0x3b, 0xd5, 0x7c, 0x37, 0x56, 0x97, 0x46, 0xb0, 0x58, 0x0e, 0xd4, 0xe7, 0xed,
+	0x01, 0x09, 0xf8, 0x49, 0x02, 0xcb, 0x9c, 0xd4, 0x16, 0xf1, 0x29, 0xf2, 0x69, 0x44, 0x99, 0x83,
+	0x5e, 0x86, 0xde, 0x73, 0xb0, 0x60, 0xf7, 0x51, 0x78, 0x35, 0x5a, 0x98, 0x29, 0x25, 0x2f, 0xa4,
+	0x78, 0xab, 0x1b, 0xab, 0x79, 0x81, 0x37, 0x96, 0x07, 0xf5, 0xac, 0x3d, 0x4a, 0x08, 0x7e, 0x9e,
+	0x01, 0xd9, 0x1a, 0x75, 0xb7, 0x02, 0x64, 0x86, 0x88, 0x73, 0xfe, 0x27, 0x7a, 0x28, 0x3f, 0x05,
+	0xd9, 0x31, 0xfa, 0x85, 0xe4, 0x14, 0xd0, 0x62, 0x37, 0x56, 0x6f, 0x4e, 0x54, 0x0d, 0xf5, 0x1b,
+	0xa3, 0xa2, 0xe5, 0x1d, 0x90, 0xa6, 0xd8, 0xf5, 0x51, 0x50, 0x48, 0x95, 0xa4, 0xd5, 0x4c, 0x75,
+	0xfd, 0x77, 0xac, 0xae, 0xb9, 0x38, 0x6c, 0x46, 0x96, 0x66, 0x13, 0xaf, 0x6c, 0x13, 0xea, 0x11,
+	0x2a, 0x1e, 0x6b, 0xd4, 0x79, 0x59, 0x0e, 0x3b, 0x6d, 0x44, 0xb5, 0x8a, 0x6d, 0x57, 0x1c, 0x27,
+	0x40, 0x94, 0xea, 0x02, 0x00, 0x7e, 0x91, 0x58, 0xfb, 0x0e, 0xda, 0xce, 0x95, 0xda, 0x77, 0x0f,
+	0xa4, 0x9b, 0xc8, 0x74, 0x50, 0x30, 0xad, 0x71, 0xba, 0x88, 0x19, 0xe2, 0x9f, 0xbc, 0x2a, 0xff,
+	0x6f, 0x12, 0x58, 0xae, 0x51, 0x77, 0x3f, 0xb2, 0x3c, 0x1c, 0xd6, 0x30, 0xb5, 0x50, 0xd3, 0x7c,
+	0x8d, 0x49, 0x14, 0x5c, 0x46, 0xc5, 0x43, 0x90, 0xf1, 0x86, 0x20, 0xa6, 0x6a, 0x19, 0x89, 0xbc,
+	0x4e, 0x45, 0xbf, 0xf8, 0x44, 0xb6, 0x03, 0x84, 0x0e, 0xaf, 0x30, 0x91, 0x03, 0xf0, 0x7f, 0x23,
+	0x20, 0x87, 0xc8, 0x37, 0x9a, 0x08, 0xbb, 0xcd, 0x50, 0x88, 0x91, 0xb5, 0xc1, 0xb1, 0xa6, 0x3d,
+	0x66, 0x9e, 0xea, 0xed, 0xe3, 0x58, 0x4d, 0x74, 0x63, 0x35, 0xc7, 0xe1, 0x46, 0xd2, 0xa0, 0x9e,
+	0xe1, 0x6b, 0x1e, 0x7b, 0x9d, 0x42, 0xdf, 0x49, 0x20, 0x2d, 0x50, 0x37, 0x41, 0x06, 0xb5, 0x89,
+	0xdd, 0x34, 0xfc, 0xc8, 0xb3, 0x50, 0xc0, 0x24, 0xa6, 0x86, 0xbf, 0xb3, 0x61, 0x2f, 0xd4, 0xe7,
+	0xd9, 0x72, 0x8f, 0xad, 0x06, 0xb9, 0x43, 0x3a, 0x27, 0xe4, 0xf6, 0xe5, 0xf0, 0x5c, 0x5e, 0x77,
+	0x33, 0x75, 0xf4, 0x51, 0x4d, 0xc0, 0xaf, 0x49, 0x90, 0xae, 0x9b, 0x81, 0xe9, 0x51, 0x79, 0x0f,
+	0x2c, 0x99, 0xad, 0x16, 0x79, 0x63, 0x78, 0xa6, 0x1f, 0x99, 0x2d, 0xa3, 0xc1, 0xa6, 0xc0, 0xf8,
+	0xcc, 0x56, 0x95, 0x6e, 0xac, 0x16, 0x39, 0xe6, 0x84, 0x20, 0xa8, 0x2f, 0x32, 0x6b, 0x8d, 0x19,
+	0xf9, 0xf8, 0xe4, 0x57, 0x60, 0xc9, 0xc3, 0xbe, 0x11, 0x06, 0x11, 0x0d, 0xb1, 0xef, 0x1a, 0x6d,
+	0x14, 0x60, 0xe2, 0x88, 0x59, 0xac, 0x9c, 0xdb, 0x58, 0x8f, 0xc4, 0x2d, 0x51, 0xbd, 0x2b, 0x46,
+	0x22, 0xca, 0x4d, 0xc0, 0x80, 0x47, 0x3f, 0x54, 0x49, 0x5f, 0xf4, 0xb0, 0xff, 0x44, 0x38, 0xea,
+	0xcc, 0x2e, 0x6f, 0x83, 0x05, 0x4e, 0xc8, 0x30, 0xa3, 0xb0, 0x49, 0x02, 0x1c, 0x76, 0xd8, 0xac,
+	0xe6, 0x86, 0x0f, 0xd6, 0xf1, 0x08, 0xa8, 0x67, 0xb9, 0xa9, 0xd2, 0xb7, 0x0c, 0x5a, 0xd1, 0x42,
+	0xae, 0x69, 0x77, 0x8c, 0x76, 0x40, 0x48, 0x83, 0x16, 0x52, 0x93, 0x5b, 0x31, 0x12, 0xd4, 0x6f,
+	0xc5, 0x2e, 0x33, 0xd6, 0x99, 0x4d, 0x7e, 0x01, 0x56, 0x6c, 0xe2, 0xb5, 0x7b, 0x5b, 0xc0, 0x38,
+	0x77, 0x1f, 0xfc, 0xc7, 0x50, 0xef, 0x74, 0x63, 0xb5, 0xd4, 0x3f, 0x03, 0x2f, 0x08, 0x85, 0x7a,
+	0xbe, 0xef, 0x1b, 0xbb, 0x9b, 0xaa, 0xbb, 0xc7, 0xa7, 0x8a, 0x74, 0x72, 0xaa, 0x48, 0x3f, 0x4f,
+	0x15, 0xe9, 0xfd, 0x99, 0x92, 0x38, 0x39, 0x53, 0x12, 0xdf, 0xcf, 0x94, 0xc4, 0xb3, 0x8d, 0xa9,
+	0x3b, 0xf4, 0x6d, 0xb9, 0xf7, 0x0f, 0x70, 0x7f, 0x63, 0x4d, 0xfc, 0x06, 0xb0, 0x1d, 0x6b, 0xa5,
+	0xd9, 0x54, 0x1e, 0xfc, 0x19, 0x00, 0x10, 0xbb, 0x8d, 0x49, 0x21, 0x08, 0x00, 0x00,
 }
 
 func (m *IdentifiedClientState) Marshal() (dAtA []byte, err error) {
@@ -696,6 +872,53 @@ func (m *MsgSubmitMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFreezeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Height) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -729,6 +952,74 @@ func (m *Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompressConsensusStates {
+		i--
+		if m.CompressConsensusStates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AllowLegacyProofs {
+		i--
+		if m.AllowLegacyProofs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FreezeAuthority) > 0 {
+		i -= len(m.FreezeAuthority)
+		copy(dAtA[i:], m.FreezeAuthority)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.FreezeAuthority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinTrustingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTrustingPeriod):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintClient(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.AllowManualFreeze {
+		i--
+		if m.AllowManualFreeze {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintClient(dAtA []byte, offset int, v uint64) int {
 	offset -= sovClient(v)
 	base := offset
@@ -843,6 +1134,25 @@ func (m *MsgSubmitMisbehaviour) Size() (n int) {
 	return n
 }
 
+func (m *MsgFreezeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovClient(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
 func (m *Height) Size() (n int) {
 	if m == nil {
 		return 0
@@ -858,6 +1168,30 @@ func (m *Height) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowManualFreeze {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTrustingPeriod)
+	n += 1 + l + sovClient(uint64(l))
+	l = len(m.FreezeAuthority)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if m.AllowLegacyProofs {
+		n += 2
+	}
+	if m.CompressConsensusStates {
+		n += 2
+	}
+	return n
+}
+
 func sovClient(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1605,6 +1939,158 @@ func (m *MsgSubmitMisbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFreezeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Height) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1696,6 +2182,184 @@ func (m *Height) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowManualFreeze", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowManualFreeze = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinTrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezeAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLegacyProofs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowLegacyProofs = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompressConsensusStates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompressConsensusStates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipClient(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
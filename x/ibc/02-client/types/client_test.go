@@ -0,0 +1,358 @@
+package types_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/light-clients/solomachine/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func TestValidateHeaderChainID(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	val := tmtypes.NewValidator(pubKey, 10)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{val})
+
+	header := ibctmtypes.CreateTestHeader(chainID, height, height-1, time.Now().UTC(), valSet, valSet, []tmtypes.PrivValidator{privVal})
+
+	testCases := []struct {
+		name          string
+		clientChainID string
+		expPass       bool
+	}{
+		{"matching chain-id", chainID, true},
+		{"mismatched chain-id", "otherchain", false},
+		{"empty client chain-id", "", false},
+	}
+
+	for _, tc := range testCases {
+		clientState := ibctmtypes.NewClientState(
+			tc.clientChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(),
+		)
+
+		err := types.ValidateHeaderChainID(clientState, header)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateHeaderChainIDWithoutChainID(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	val := tmtypes.NewValidator(pubKey, 10)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{val})
+
+	header := ibctmtypes.CreateTestHeader(chainID, height, height-1, time.Now().UTC(), valSet, valSet, []tmtypes.PrivValidator{privVal})
+
+	// solo machine client states don't track a chain-id
+	clientState := solomachinetypes.NewClientState(&solomachinetypes.ConsensusState{})
+	require.NoError(t, types.ValidateHeaderChainID(clientState, header))
+}
+
+func TestValidateHeaderConsensusTypeMatch(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	val := tmtypes.NewValidator(pubKey, 10)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{val})
+
+	now := time.Now().UTC()
+	tmHeader := ibctmtypes.CreateTestHeader(chainID, height, height-1, now, valSet, valSet, []tmtypes.PrivValidator{privVal})
+	tmConsensusState := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("root")), types.NewHeight(0, height-1), valSet.Hash())
+	solomachine := ibctesting.NewSolomachine(t, "solomachine")
+
+	testCases := []struct {
+		name           string
+		consensusState exported.ConsensusState
+		header         exported.Header
+		expPass        bool
+	}{
+		{"matching tendermint types", tmConsensusState, tmHeader, true},
+		{"matching solo machine types", solomachine.ConsensusState(), solomachine.CreateHeader(), true},
+		{"tendermint header for solo machine client", solomachine.ConsensusState(), tmHeader, false},
+		{"solo machine header for tendermint client", tmConsensusState, solomachine.CreateHeader(), false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateHeaderConsensusTypeMatch(tc.consensusState, tc.header)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, errors.Is(err, types.ErrInvalidHeader), "%s: %v", tc.name, err)
+		}
+	}
+}
+
+func TestValidateClientNotFrozen(t *testing.T) {
+	newClientState := func(frozenHeight uint64) exported.ClientState {
+		clientState := ibctmtypes.NewClientState(
+			chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, types.NewHeight(0, 20), commitmenttypes.GetSDKSpecs(),
+		)
+		clientState.FrozenHeight = types.NewHeight(0, frozenHeight)
+		return clientState
+	}
+
+	testCases := []struct {
+		name        string
+		clientState exported.ClientState
+		height      uint64
+		expPass     bool
+	}{
+		{"client not frozen", newClientState(0), 15, true},
+		{"proof height below the frozen height", newClientState(10), 9, true},
+		{"proof height at the frozen height", newClientState(10), 10, false},
+		{"proof height above the frozen height", newClientState(10), 15, false},
+		{"localhost client is never frozen", localhosttypes.NewClientState(chainID, types.NewHeight(0, 20)), 15, true},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateClientNotFrozen(tc.clientState, tc.height)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, errors.Is(err, types.ErrClientFrozen), tc.name)
+		}
+	}
+}
+
+func TestValidateMinTrustingPeriod(t *testing.T) {
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, types.NewHeight(0, 20), commitmenttypes.GetSDKSpecs(),
+	)
+
+	testCases := []struct {
+		name              string
+		clientState       exported.ClientState
+		minTrustingPeriod time.Duration
+		expPass           bool
+	}{
+		{"no minimum", clientState, 0, true},
+		{"trusting period above the minimum", clientState, ibctesting.TrustingPeriod - time.Hour, true},
+		{"trusting period at the minimum", clientState, ibctesting.TrustingPeriod, true},
+		{"trusting period below the minimum", clientState, ibctesting.TrustingPeriod + time.Hour, false},
+		{"localhost client is exempt", localhosttypes.NewClientState(chainID, types.NewHeight(0, 20)), ibctesting.TrustingPeriod, true},
+		{"solo machine client is exempt", ibctesting.NewSolomachine(t, "solomachine").ClientState(), ibctesting.TrustingPeriod, true},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateMinTrustingPeriod(tc.clientState, tc.minTrustingPeriod)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, errors.Is(err, types.ErrInvalidClient), tc.name)
+		}
+	}
+}
+
+func TestValidateClientStateUpdate(t *testing.T) {
+	previous := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, types.NewHeight(0, 20), commitmenttypes.GetSDKSpecs(),
+	)
+
+	// updated returns a copy of the previous client state with the given changes
+	updated := func(malleate func(*ibctmtypes.ClientState)) exported.ClientState {
+		clientState := *previous
+		malleate(&clientState)
+		return &clientState
+	}
+
+	solomachine := ibctesting.NewSolomachine(t, "solomachine")
+	solomachineUpdated := *solomachine.ClientState()
+	solomachineUpdated.ConsensusState = solomachine.ConsensusState()
+	solomachineUpdated.ConsensusState.Sequence++
+	solomachineUpdated.ConsensusState.Timestamp++
+
+	testCases := []struct {
+		name     string
+		previous exported.ClientState
+		updated  exported.ClientState
+		expPass  bool
+	}{
+		{"latest height advanced", previous, updated(func(cs *ibctmtypes.ClientState) { cs.LatestHeight = types.NewHeight(0, 25) }), true},
+		{"past height update", previous, updated(func(cs *ibctmtypes.ClientState) {}), true},
+		{"solo machine consensus state updated", solomachine.ClientState(), &solomachineUpdated, true},
+		{"nil client state", previous, nil, false},
+		{"client type changed", previous, localhosttypes.NewClientState(chainID, types.NewHeight(0, 25)), false},
+		{"chain-id changed", previous, updated(func(cs *ibctmtypes.ClientState) { cs.ChainId = "otherchain" }), false},
+		{"proof specs changed", previous, updated(func(cs *ibctmtypes.ClientState) { cs.ProofSpecs = cs.ProofSpecs[:1] }), false},
+		{"client frozen", previous, updated(func(cs *ibctmtypes.ClientState) { cs.FrozenHeight = types.NewHeight(0, 21) }), false},
+		{"latest height decreased", previous, updated(func(cs *ibctmtypes.ClientState) { cs.LatestHeight = types.NewHeight(0, 19) }), false},
+		{"trusting period changed", previous, updated(func(cs *ibctmtypes.ClientState) { cs.TrustingPeriod++ }), false},
+		{"delay period changed along with the latest height", previous, updated(func(cs *ibctmtypes.ClientState) {
+			cs.LatestHeight = types.NewHeight(0, 25)
+			cs.DelayBlockPeriod = 10
+		}), false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateClientStateUpdate(tc.previous, tc.updated)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, errors.Is(err, types.ErrInvalidClientStateUpdate), "%s: %v", tc.name, err)
+		}
+	}
+
+	// the tendermint client reports the changed fields
+	err := types.ValidateClientStateUpdate(previous, updated(func(cs *ibctmtypes.ClientState) {
+		cs.TrustingPeriod++
+		cs.MaxClockDrift++
+	}))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "TrustingPeriod, MaxClockDrift")
+}
+
+func TestValidateClientBundle(t *testing.T) {
+	now := time.Now().UTC()
+	clientState := ibctmtypes.NewClientState(
+		chainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, types.NewHeight(0, 20), commitmenttypes.GetSDKSpecs(),
+	)
+	newConsensusState := func(height uint64) *ibctmtypes.ConsensusState {
+		return ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("root")), types.NewHeight(0, height), bytes.Repeat([]byte{1}, 32))
+	}
+	solomachine := ibctesting.NewSolomachine(t, "solomachine")
+
+	invalidClientState := *clientState
+	invalidClientState.ChainId = ""
+
+	testCases := []struct {
+		name           string
+		clientState    exported.ClientState
+		consensusState exported.ConsensusState
+		expErr         error
+	}{
+		{"consistent bundle", clientState, newConsensusState(20), nil},
+		{"consensus state below the latest height", clientState, newConsensusState(10), nil},
+		{"consistent solo machine bundle", solomachine.ClientState(), solomachine.ConsensusState(), nil},
+		{"nil client state", nil, newConsensusState(20), types.ErrInvalidClient},
+		{"nil consensus state", clientState, nil, types.ErrInvalidConsensus},
+		{"invalid client state", &invalidClientState, newConsensusState(20), ibctmtypes.ErrInvalidChainID},
+		{"invalid consensus state", clientState, newConsensusState(0), types.ErrInvalidConsensus},
+		{"mismatched client type", clientState, solomachine.ConsensusState(), types.ErrInvalidClientType},
+		{"consensus state above the latest height", clientState, newConsensusState(21), types.ErrInvalidHeight},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateClientBundle(tc.clientState, tc.consensusState)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, errors.Is(err, tc.expErr), "%s: %v", tc.name, err)
+		}
+	}
+}
+
+func TestCheckClientCompatibility(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		selfClientType         string
+		counterpartyClientType string
+		expPass                bool
+	}{
+		{"tendermint with tendermint", exported.ClientTypeTendermint, exported.ClientTypeTendermint, true},
+		{"solo machine with tendermint", exported.ClientTypeSoloMachine, exported.ClientTypeTendermint, true},
+		{"localhost with localhost", exported.ClientTypeLocalHost, exported.ClientTypeLocalHost, true},
+		{"tendermint with solo machine", exported.ClientTypeTendermint, exported.ClientTypeSoloMachine, false},
+		{"tendermint with localhost", exported.ClientTypeTendermint, exported.ClientTypeLocalHost, false},
+		{"unknown self client type", "ethereum", exported.ClientTypeTendermint, false},
+		{"unknown counterparty client type", exported.ClientTypeTendermint, "ethereum", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.CheckClientCompatibility(tc.selfClientType, tc.counterpartyClientType)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestHeadersEqual(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+
+	val := tmtypes.NewValidator(pubKey, 10)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{val})
+	signers := []tmtypes.PrivValidator{privVal}
+	now := time.Now().UTC()
+
+	header := ibctmtypes.CreateTestHeader(chainID, height, height-1, now, valSet, valSet, signers)
+	identical := ibctmtypes.CreateTestHeader(chainID, height, height-1, now, valSet, valSet, signers)
+	otherHeight := ibctmtypes.CreateTestHeader(chainID, height+1, height-1, now, valSet, valSet, signers)
+	otherTimestamp := ibctmtypes.CreateTestHeader(chainID, height, height-1, now.Add(time.Second), valSet, valSet, signers)
+	otherTrustedHeight := ibctmtypes.CreateTestHeader(chainID, height, height-2, now, valSet, valSet, signers)
+	soloMachine := ibctesting.NewSolomachine(t, "solomachine").CreateHeader()
+
+	testCases := []struct {
+		name  string
+		a, b  exported.Header
+		equal bool
+	}{
+		{"identical headers", header, identical, true},
+		{"same header", header, header, true},
+		{"headers differing only in height", header, otherHeight, false},
+		{"differing timestamps", header, otherTimestamp, false},
+		{"differing content at the same height and timestamp", header, otherTrustedHeight, false},
+		{"differing types", header, soloMachine, false},
+		{"one nil header", header, nil, false},
+		{"both nil headers", nil, nil, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.equal, types.HeadersEqual(tc.a, tc.b), tc.name)
+		require.Equal(t, tc.equal, types.HeadersEqual(tc.b, tc.a), tc.name)
+	}
+}
+
+func TestConsensusStateEqual(t *testing.T) {
+	now := time.Now().UTC()
+	consState := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("root")), clientHeight, []byte("next_vals_hash"))
+	identical := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("root")), clientHeight, []byte("next_vals_hash"))
+	otherRoot := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("other root")), clientHeight, []byte("next_vals_hash"))
+	otherTimestamp := ibctmtypes.NewConsensusState(now.Add(time.Second), commitmenttypes.NewMerkleRoot([]byte("root")), clientHeight, []byte("next_vals_hash"))
+	otherHeight := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("root")), clientHeight.Increment(), []byte("next_vals_hash"))
+	soloMachine := &solomachinetypes.ConsensusState{Timestamp: uint64(now.UnixNano())}
+
+	testCases := []struct {
+		name  string
+		a, b  exported.ConsensusState
+		equal bool
+	}{
+		{"identical states", consState, identical, true},
+		{"same state", consState, consState, true},
+		{"identical states without root", soloMachine, &solomachinetypes.ConsensusState{Timestamp: uint64(now.UnixNano())}, true},
+		{"differing roots", consState, otherRoot, false},
+		{"differing timestamps", consState, otherTimestamp, false},
+		{"differing heights", consState, otherHeight, false},
+		{"differing types", consState, soloMachine, false},
+		{"one nil state", consState, nil, false},
+		{"both nil states", nil, nil, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.equal, types.ConsensusStateEqual(tc.a, tc.b), tc.name)
+		require.Equal(t, tc.equal, types.ConsensusStateEqual(tc.b, tc.a), tc.name)
+	}
+}
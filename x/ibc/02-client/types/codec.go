@@ -10,18 +10,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
+// Names under which the client interfaces are registered in the interface registry.
+const (
+	ClientStateInterfaceName    = "cosmos_sdk.ibc.v1.client.ClientState"
+	ConsensusStateInterfaceName = "cosmos_sdk.ibc.v1.client.ConsensusState"
+	HeaderInterfaceName         = "cosmos_sdk.ibc.v1.client.Header"
+)
+
 // RegisterInterfaces registers the client interfaces to protobuf Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterInterface(
-		"cosmos_sdk.ibc.v1.client.ClientState",
+		ClientStateInterfaceName,
 		(*exported.ClientState)(nil),
 	)
 	registry.RegisterInterface(
-		"cosmos_sdk.ibc.v1.client.ConsensusState",
+		ConsensusStateInterfaceName,
 		(*exported.ConsensusState)(nil),
 	)
 	registry.RegisterInterface(
-		"cosmos_sdk.ibc.v1.client.Header",
+		HeaderInterfaceName,
 		(*exported.Header)(nil),
 	)
 	registry.RegisterInterface(
@@ -41,6 +48,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCreateClient{},
 		&MsgUpdateClient{},
 		&MsgSubmitMisbehaviour{},
+		&MsgFreezeClient{},
 	)
 }
 
@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
@@ -120,6 +123,36 @@ func TestPackConsensusState(t *testing.T) {
 	}
 }
 
+func TestConsensusStateSize(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	now := time.Now().UTC()
+
+	small := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot([]byte("hash")), clientHeight, nil)
+	large := ibctmtypes.NewConsensusState(now, commitmenttypes.NewMerkleRoot(bytes.Repeat([]byte("hash"), 256)), clientHeight, bytes.Repeat([]byte{1}, 32))
+
+	smallSize, err := types.ConsensusStateSize(cdc, small, false)
+	require.NoError(t, err)
+	require.Equal(t, len(types.MustMarshalConsensusState(cdc, small)), smallSize)
+
+	largeSize, err := types.ConsensusStateSize(cdc, large, false)
+	require.NoError(t, err)
+	require.Equal(t, len(types.MustMarshalConsensusState(cdc, large)), largeSize)
+
+	// the compressed size is the size of the consensus state as stored compressed
+	compressedSize, err := types.ConsensusStateSize(cdc, large, true)
+	require.NoError(t, err)
+	compressed, err := types.CompressConsensusState(types.MustMarshalConsensusState(cdc, large))
+	require.NoError(t, err)
+	require.Equal(t, len(compressed), compressedSize)
+	require.Less(t, compressedSize, largeSize)
+
+	// the root and next validators hash account for the size difference
+	require.GreaterOrEqual(t, largeSize-smallSize, 256*4-4+32)
+
+	_, err = types.ConsensusStateSize(cdc, nil, false)
+	require.Error(t, err)
+}
+
 func TestPackHeader(t *testing.T) {
 	chain := ibctesting.NewTestChain(t, "cosmoshub")
 
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+)
+
+// gzipMagic is the header of gzip compressed data. The protobuf Any encoding of a
+// consensus state always starts with the tag of its type URL field, so compressed
+// and uncompressed consensus states can't be mistaken for one another.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// CompressConsensusState returns the gzip compressed form of the given encoded
+// consensus state, as stored by the client keeper when consensus state
+// compression is enabled.
+func CompressConsensusState(bz []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	// the header is left empty so that the same consensus state is always
+	// compressed to the same bytes
+	w, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(bz); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecompressConsensusState returns the canonical encoding of a consensus state
+// stored either compressed or uncompressed. Uncompressed bytes are returned as is.
+func DecompressConsensusState(bz []byte) ([]byte, error) {
+	if !IsCompressedConsensusState(bz) {
+		return bz, nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(bz))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+// IsCompressedConsensusState returns true if the given stored consensus state is
+// compressed.
+func IsCompressedConsensusState(bz []byte) bool {
+	return bytes.HasPrefix(bz, gzipMagic)
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+func TestCompressConsensusState(t *testing.T) {
+	cdc := simapp.MakeEncodingConfig().Marshaler
+	consensusState := ibctmtypes.NewConsensusState(
+		time.Now().UTC(), commitmenttypes.NewMerkleRoot(bytes.Repeat([]byte("hash"), 256)), clientHeight, bytes.Repeat([]byte{1}, 32),
+	)
+
+	canonical := types.MustMarshalConsensusState(cdc, consensusState)
+	require.False(t, types.IsCompressedConsensusState(canonical))
+
+	compressed, err := types.CompressConsensusState(canonical)
+	require.NoError(t, err)
+	require.True(t, types.IsCompressedConsensusState(compressed))
+	require.Less(t, len(compressed), len(canonical))
+
+	// the same consensus state is always compressed to the same bytes
+	recompressed, err := types.CompressConsensusState(canonical)
+	require.NoError(t, err)
+	require.Equal(t, compressed, recompressed)
+
+	decompressed, err := types.DecompressConsensusState(compressed)
+	require.NoError(t, err)
+	require.Equal(t, canonical, decompressed)
+
+	// uncompressed consensus states are returned as is
+	decompressed, err = types.DecompressConsensusState(canonical)
+	require.NoError(t, err)
+	require.Equal(t, canonical, decompressed)
+
+	// both encodings decode to the same consensus state
+	for _, bz := range [][]byte{canonical, compressed} {
+		decoded, err := types.UnmarshalConsensusState(cdc, bz)
+		require.NoError(t, err)
+		require.Equal(t, consensusState, decoded)
+	}
+
+	// truncated compressed consensus state
+	_, err = types.DecompressConsensusState(compressed[:len(compressed)/2])
+	require.Error(t, err)
+
+	_, err = types.UnmarshalConsensusState(cdc, compressed[:len(compressed)/2])
+	require.Error(t, err)
+}
@@ -77,10 +77,33 @@ func MarshalConsensusState(cdc codec.BinaryMarshaler, consensusStateI exported.C
 	return codec.MarshalAny(cdc, consensusStateI)
 }
 
+// ConsensusStateSize returns the length in bytes of the encoded consensus state,
+// as stored by the client keeper with or without compression.
+func ConsensusStateSize(cdc codec.BinaryMarshaler, consensusState exported.ConsensusState, compressed bool) (int, error) {
+	bz, err := MarshalConsensusState(cdc, consensusState)
+	if err != nil {
+		return 0, err
+	}
+
+	if compressed {
+		bz, err = CompressConsensusState(bz)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(bz), nil
+}
+
 // UnmarshalConsensusState returns an ConsensusState interface from raw encoded clientState
-// bytes of a Proto-based ConsensusState type. An error is returned upon decoding
-// failure.
+// bytes of a Proto-based ConsensusState type, stored either compressed or uncompressed.
+// An error is returned upon decoding failure.
 func UnmarshalConsensusState(cdc codec.BinaryMarshaler, bz []byte) (exported.ConsensusState, error) {
+	bz, err := DecompressConsensusState(bz)
+	if err != nil {
+		return nil, err
+	}
+
 	var consensusState exported.ConsensusState
 	if err := codec.UnmarshalAny(cdc, &consensusState, bz); err != nil {
 		return nil, err
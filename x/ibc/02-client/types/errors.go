@@ -26,4 +26,9 @@ var (
 	ErrFailedPacketAckAbsenceVerification     = sdkerrors.Register(SubModuleName, 19, "packet acknowledgement absence verification failed")
 	ErrFailedNextSeqRecvVerification          = sdkerrors.Register(SubModuleName, 20, "next sequence receive verification failed")
 	ErrSelfConsensusStateNotFound             = sdkerrors.Register(SubModuleName, 21, "self consensus state not found")
+	ErrInvalidHeight                          = sdkerrors.Register(SubModuleName, 22, "invalid height")
+	ErrManualFreezeDisabled                   = sdkerrors.Register(SubModuleName, 23, "manual client freezing is disabled")
+	ErrClientInUse                            = sdkerrors.Register(SubModuleName, 24, "light client is used by connections")
+	ErrInvalidClientStateUpdate               = sdkerrors.Register(SubModuleName, 25, "invalid client state update")
+	ErrProofVerificationFailed                = sdkerrors.Register(SubModuleName, 26, "proof verification failed")
 )
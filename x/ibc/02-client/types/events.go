@@ -18,6 +18,7 @@ var (
 	EventTypeCreateClient       = "create_client"
 	EventTypeUpdateClient       = "update_client"
 	EventTypeSubmitMisbehaviour = "client_misbehaviour"
+	EventTypeFreezeClient       = "freeze_client"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -89,6 +89,7 @@ func DefaultGenesisState() GenesisState {
 		Clients:          []IdentifiedClientState{},
 		ClientsConsensus: ClientsConsensusStates{},
 		CreateLocalhost:  true,
+		Params:           DefaultParams(),
 	}
 }
 
@@ -137,5 +138,5 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
-	return nil
+	return gs.Params.Validate()
 }
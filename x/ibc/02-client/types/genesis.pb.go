@@ -31,6 +31,8 @@ type GenesisState struct {
 	ClientsConsensus ClientsConsensusStates `protobuf:"bytes,2,rep,name=clients_consensus,json=clientsConsensus,proto3,castrepeated=ClientsConsensusStates" json:"clients_consensus" yaml:"clients_consensus"`
 	// create localhost on initialization
 	CreateLocalhost bool `protobuf:"varint,3,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty" yaml:"create_localhost"`
+	// client submodule parameters
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +89,13 @@ func (m *GenesisState) GetCreateLocalhost() bool {
 	return false
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.client.GenesisState")
 }
@@ -94,27 +103,28 @@ func init() {
 func init() { proto.RegisterFile("ibc/client/genesis.proto", fileDescriptor_2eb5d7ff040be5c2) }
 
 var fileDescriptor_2eb5d7ff040be5c2 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0x4c, 0x4a, 0xd6,
-	0x4f, 0xce, 0xc9, 0x4c, 0xcd, 0x2b, 0xd1, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xca, 0x4c, 0x4a, 0xd6, 0x83, 0xc8, 0x48, 0x89, 0x23, 0xa9,
-	0x82, 0x50, 0x10, 0x45, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11,
-	0x55, 0x5a, 0xc6, 0xc4, 0xc5, 0xe3, 0x0e, 0x31, 0x2c, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x91,
-	0x8b, 0x1d, 0xa2, 0xad, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x51, 0x0f, 0x61, 0xba,
-	0x9e, 0x67, 0x4a, 0x6a, 0x5e, 0x49, 0x66, 0x5a, 0x66, 0x6a, 0x8a, 0x33, 0x58, 0x00, 0xac, 0xc7,
-	0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0x98, 0x3e, 0xa1, 0x2e, 0x46, 0x2e, 0x41, 0x28, 0x3b,
-	0x3e, 0x39, 0x3f, 0xaf, 0x38, 0x35, 0xaf, 0xb8, 0xb4, 0x58, 0x82, 0x09, 0xd3, 0x34, 0x88, 0x19,
-	0xce, 0x30, 0x25, 0x60, 0xc3, 0x8a, 0x9d, 0xac, 0x40, 0xa6, 0x7d, 0xba, 0x27, 0x2f, 0x51, 0x99,
-	0x98, 0x9b, 0x63, 0xa5, 0x84, 0x61, 0x92, 0xd2, 0xaa, 0xfb, 0xf2, 0x62, 0x10, 0xad, 0xc5, 0x68,
-	0x7a, 0x83, 0x04, 0x92, 0xd1, 0xc4, 0x85, 0xdc, 0xb8, 0x04, 0x92, 0x8b, 0x52, 0x13, 0x4b, 0x52,
-	0xe3, 0x73, 0xf2, 0x93, 0x13, 0x73, 0x32, 0xf2, 0x8b, 0x4b, 0x24, 0x98, 0x15, 0x18, 0x35, 0x38,
-	0x9c, 0xa4, 0x3f, 0xdd, 0x93, 0x17, 0x87, 0xda, 0x81, 0xa6, 0x42, 0x29, 0x88, 0x1f, 0x22, 0xe4,
-	0x03, 0x13, 0x71, 0xf2, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xa3,
-	0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc,
-	0x62, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0xa1, 0x0f, 0x8a, 0x10, 0x03, 0x23, 0x5d, 0x68,
-	0x9c, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x43, 0xdf, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0xe0, 0x1c, 0x5d, 0xa3, 0xd4, 0x01, 0x00, 0x00,
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x6a, 0xf2, 0x40,
+	0x14, 0x85, 0x33, 0x2a, 0xfe, 0x3f, 0x63, 0xa1, 0x36, 0x94, 0x1a, 0x2c, 0x24, 0x36, 0x2b, 0x37,
+	0x26, 0x92, 0xee, 0xdc, 0x35, 0x42, 0x4b, 0xc1, 0x45, 0xb1, 0xbb, 0x6e, 0x24, 0x19, 0xa7, 0x71,
+	0x68, 0x92, 0x11, 0xef, 0x08, 0xf5, 0x15, 0xba, 0xea, 0x73, 0xf4, 0x49, 0x5c, 0xba, 0x74, 0x65,
+	0x8b, 0xbe, 0x81, 0x4f, 0x50, 0x9c, 0x19, 0xa9, 0xe8, 0xea, 0x5e, 0xce, 0x3d, 0xe7, 0x0b, 0x99,
+	0x83, 0x2d, 0x16, 0x13, 0x9f, 0xa4, 0x8c, 0xe6, 0xc2, 0x4f, 0x68, 0x4e, 0x81, 0x81, 0x37, 0x9e,
+	0x70, 0xc1, 0x4d, 0xcc, 0x62, 0xe2, 0xa9, 0x4b, 0xbd, 0x76, 0xe0, 0x52, 0x43, 0x99, 0xea, 0x97,
+	0x09, 0x4f, 0xb8, 0x5c, 0xfd, 0xdd, 0xa6, 0x54, 0x77, 0x59, 0xc0, 0x67, 0x0f, 0x0a, 0xf6, 0x2c,
+	0x22, 0x41, 0xcd, 0x3b, 0xfc, 0x4f, 0xc5, 0xc0, 0x42, 0x8d, 0x62, 0xb3, 0x12, 0xdc, 0x78, 0x7f,
+	0x74, 0xef, 0x71, 0x48, 0x73, 0xc1, 0x5e, 0x19, 0x1d, 0x76, 0xa5, 0x20, 0x33, 0x61, 0x69, 0xbe,
+	0x72, 0x8c, 0xfe, 0x3e, 0x67, 0x7e, 0x20, 0x7c, 0xa1, 0xf7, 0x01, 0xe1, 0x39, 0xd0, 0x1c, 0xa6,
+	0x60, 0x15, 0x4e, 0x69, 0x8a, 0xd1, 0xdd, 0x5b, 0x24, 0x0c, 0xc2, 0xce, 0x8e, 0xb6, 0x5d, 0x39,
+	0xd6, 0x2c, 0xca, 0xd2, 0x8e, 0x7b, 0x42, 0x72, 0xbf, 0xbe, 0x9d, 0x2b, 0x15, 0x85, 0xa3, 0x6c,
+	0xbf, 0x4a, 0x8e, 0x74, 0xf3, 0x1e, 0x57, 0xc9, 0x84, 0x46, 0x82, 0x0e, 0x52, 0x4e, 0xa2, 0x74,
+	0xc4, 0x41, 0x58, 0xc5, 0x06, 0x6a, 0xfe, 0x0f, 0xaf, 0xb7, 0x2b, 0xa7, 0xa6, 0xbf, 0x71, 0xe4,
+	0x70, 0xfb, 0xe7, 0x4a, 0xea, 0xed, 0x15, 0xb3, 0x8d, 0xcb, 0xe3, 0x68, 0x12, 0x65, 0x60, 0x95,
+	0x1a, 0xa8, 0x59, 0x09, 0xcc, 0xc3, 0x1f, 0x79, 0x92, 0x17, 0xfd, 0x0e, 0xda, 0x17, 0xf6, 0xe6,
+	0x6b, 0x1b, 0x2d, 0xd6, 0x36, 0xfa, 0x59, 0xdb, 0xe8, 0x73, 0x63, 0x1b, 0x8b, 0x8d, 0x6d, 0x2c,
+	0x37, 0xb6, 0xf1, 0x12, 0x24, 0x4c, 0x8c, 0xa6, 0xb1, 0x47, 0x78, 0xe6, 0x13, 0x0e, 0x19, 0x07,
+	0x3d, 0x5a, 0x30, 0x7c, 0xf3, 0xdf, 0xfd, 0x5d, 0x85, 0xed, 0xa0, 0xa5, 0x5b, 0x14, 0xb3, 0x31,
+	0x85, 0xb8, 0x2c, 0xfb, 0xba, 0xfd, 0x1d, 0x00, 0x2d, 0x35, 0x18, 0x35, 0x06, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +147,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.CreateLocalhost {
 		i--
 		if m.CreateLocalhost {
@@ -210,6 +230,8 @@ func (m *GenesisState) Size() (n int) {
 	if m.CreateLocalhost {
 		n += 2
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -336,6 +358,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CreateLocalhost = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -160,6 +160,24 @@ func TestValidateGenesis(t *testing.T) {
 			),
 			expPass: false,
 		},
+		{
+			name: "negative min trusting period",
+			genState: types.GenesisState{
+				Clients:          []types.IdentifiedClientState{},
+				ClientsConsensus: types.ClientsConsensusStates{},
+				Params:           types.NewParams(false, -time.Hour, "", false, false),
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid freeze authority",
+			genState: types.GenesisState{
+				Clients:          []types.IdentifiedClientState{},
+				ClientsConsensus: types.ClientsConsensusStates{},
+				Params:           types.NewParams(true, 0, "authority", false, false),
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,12 +1,26 @@
 package types
 
 import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
-var _ exported.Height = (*Height)(nil)
+var (
+	_ exported.Height          = (*Height)(nil)
+	_ encoding.TextUnmarshaler = (*Height)(nil)
+	_ json.Unmarshaler         = (*Height)(nil)
+)
 
 // NewHeight is a constructor for the IBC height type
 func NewHeight(epochNumber, epochHeight uint64) Height {
@@ -16,6 +30,42 @@ func NewHeight(epochNumber, epochHeight uint64) Height {
 	}
 }
 
+// ParseHeight parses a height from its string representation, as returned by
+// Height.String (eg: "epoch-1-height-500").
+func ParseHeight(heightStr string) (Height, error) {
+	split := strings.Split(heightStr, "-")
+	if len(split) != 4 || split[0] != "epoch" || split[2] != "height" {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "expected format epoch-{number}-height-{height}, got %s", heightStr)
+	}
+
+	return parseHeightParts(heightStr, split[1], split[3])
+}
+
+// ParseHeightShort parses a height from its short string representation
+// {epoch number}-{epoch height} (eg: "1-500").
+func ParseHeightShort(heightStr string) (Height, error) {
+	split := strings.Split(heightStr, "-")
+	if len(split) != 2 {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "expected format {epoch number}-{epoch height}, got %s", heightStr)
+	}
+
+	return parseHeightParts(heightStr, split[0], split[1])
+}
+
+func parseHeightParts(heightStr, epochNumberStr, epochHeightStr string) (Height, error) {
+	epochNumber, err := strconv.ParseUint(epochNumberStr, 10, 64)
+	if err != nil {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "invalid epoch number in %s: %s", heightStr, err)
+	}
+
+	epochHeight, err := strconv.ParseUint(epochHeightStr, 10, 64)
+	if err != nil {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "invalid epoch height in %s: %s", heightStr, err)
+	}
+
+	return NewHeight(epochNumber, epochHeight), nil
+}
+
 /// Compare implements a method to compare two heights. When comparing two heights a, b
 // we can call a.Compare(b) which will return
 // -1 if a < b
@@ -29,18 +79,86 @@ func (h Height) Compare(other exported.Height) int64 {
 	if !ok {
 		panic(fmt.Sprintf("cannot compare against invalid height type: %T. expected height type: %T", other, h))
 	}
-	var cmp int64
-	if h.EpochNumber != height.EpochNumber {
-		cmp = int64(h.EpochNumber) - int64(height.EpochNumber)
-	} else {
-		cmp = int64(h.EpochHeight) - int64(height.EpochHeight)
+	// compare the unsigned values directly, subtracting them may overflow
+	switch {
+	case h.EpochNumber < height.EpochNumber:
+		return -1
+	case h.EpochNumber > height.EpochNumber:
+		return 1
+	case h.EpochHeight < height.EpochHeight:
+		return -1
+	case h.EpochHeight > height.EpochHeight:
+		return 1
+	default:
+		return 0
 	}
-	if cmp < 0 {
+}
+
+// CompareHeights returns -1, 0 or 1 if the height a is respectively lower than,
+// equal to or greater than the height b. It follows the cmp.Compare convention
+// so that it can be passed to slices.SortFunc to sort a []Height directly.
+func CompareHeights(a, b Height) int {
+	return int(a.Compare(b))
+}
+
+// HeightKey is the 128 bit comparison key of a height, as returned by Height.Key:
+// the epoch number in the high 64 bits and the epoch height in the low 64 bits.
+// Go has no 128 bit integer type, so both halves are held as uint64. Keys are
+// comparable with == and can be used as map keys.
+type HeightKey struct {
+	Hi uint64
+	Lo uint64
+}
+
+// Key returns the 128 bit comparison key of the height. Callers comparing the
+// same heights repeatedly, eg: in packet timeout loops, can precompute the keys
+// once and compare them with HeightKey.Compare, which orders keys as Compare
+// orders heights without going through the exported.Height interface.
+func (h Height) Key() HeightKey {
+	return HeightKey{Hi: h.EpochNumber, Lo: h.EpochHeight}
+}
+
+// Compare returns -1, 0 or 1 if the key k is respectively lower than, equal to
+// or greater than the other key, as an unsigned 128 bit integer comparison.
+func (k HeightKey) Compare(other HeightKey) int {
+	switch {
+	case k.Hi < other.Hi:
 		return -1
-	} else if cmp > 0 {
+	case k.Hi > other.Hi:
 		return 1
+	case k.Lo < other.Lo:
+		return -1
+	case k.Lo > other.Lo:
+		return 1
+	default:
+		return 0
 	}
-	return 0
+}
+
+// CompareKey compares the height to the other height through their comparison
+// keys. It returns the same result as Compare.
+func (h Height) CompareKey(other Height) int {
+	return h.Key().Compare(other.Key())
+}
+
+// ComparePtr compares the heights pointed to by a and b as Height.Compare does.
+// A nil height is neither treated as the zero height nor ordered before non-nil
+// heights: if any of the pointers is nil, an error is returned.
+func ComparePtr(a, b *Height) (int64, error) {
+	if a == nil || b == nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "cannot compare nil heights (%v, %v)", a, b)
+	}
+	return a.Compare(*b), nil
+}
+
+// EQPtr returns true if the heights pointed to by a and b are equal. An error is
+// returned if any of the pointers is nil, even if both of them are nil.
+func EQPtr(a, b *Height) (bool, error) {
+	cmp, err := ComparePtr(a, b)
+	if err != nil {
+		return false, err
+	}
+	return cmp == 0, nil
 }
 
 // LT Helper comparison function returns true if h < other
@@ -75,6 +193,84 @@ func (h Height) String() string {
 	return fmt.Sprintf("epoch-%d-height-%d", h.EpochNumber, h.EpochHeight)
 }
 
+// HumanString returns a string representation of Height meant for display, with
+// the epoch height rendered with thousands separators, eg: epoch-0-height-1,234,567.
+func (h Height) HumanString() string {
+	digits := strconv.FormatUint(h.EpochHeight, 10)
+
+	var sb strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(digit)
+	}
+
+	return fmt.Sprintf("epoch-%d-height-%s", h.EpochNumber, sb.String())
+}
+
+// Bytes returns the epoch-aware binary encoding of the height: the big endian
+// epoch number followed by the big endian epoch height. The lexicographic order
+// of the encoding matches the order of the heights, which makes it suitable to
+// build store keys iterated by height.
+func (h Height) Bytes() []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, h.EpochNumber)
+	binary.BigEndian.PutUint64(bz[8:], h.EpochHeight)
+	return bz
+}
+
+// HeightFromBytes decodes a height from its binary encoding as returned by Bytes.
+// An error is returned unless the encoding is exactly 16 bytes long, so that
+// truncated or padded keys are never decoded to a wrong height.
+func HeightFromBytes(bz []byte) (Height, error) {
+	if len(bz) != 16 {
+		return Height{}, sdkerrors.Wrapf(
+			ErrInvalidHeight,
+			"height encoding must be 16 bytes, 8 for the epoch number and 8 for the epoch height, got %d", len(bz),
+		)
+	}
+
+	return NewHeight(binary.BigEndian.Uint64(bz), binary.BigEndian.Uint64(bz[8:])), nil
+}
+
+// EpochKeyRange returns the bounds of the heights of the given epoch in the
+// binary encoding returned by Bytes: start is the encoding of {epoch, 0}, included,
+// and end the encoding of {epoch+1, 0}, excluded. They can be used directly as the
+// bounds of a store iterator over keys ordered by height. The end is nil for the
+// last possible epoch, iterating up to the end of the store.
+func EpochKeyRange(epoch uint64) (start, end []byte) {
+	start = NewHeight(epoch, 0).Bytes()
+	if epoch == math.MaxUint64 {
+		return start, nil
+	}
+	return start, NewHeight(epoch+1, 0).Bytes()
+}
+
+// MigrateHeight returns the epoch-aware height of a legacy height, stored as a
+// bare uint64 before heights had an epoch, given the epoch it belongs to.
+func MigrateHeight(legacy uint64, epoch uint64) Height {
+	return NewHeight(epoch, legacy)
+}
+
+// HeightFromBlockHeight returns the epoch-aware height of a block given its
+// height counted from the start of the chain across all of its epochs. The epoch
+// schedule holds the number of blocks of each completed epoch, in order: block
+// heights up to the length of the first entry belong to epoch 0, the following
+// ones to epoch 1 and so forth. Block heights past all the completed epochs
+// belong to the current epoch, numbered len(epochSchedule). The epoch height is
+// the height of the block within its epoch, starting at 1.
+func HeightFromBlockHeight(blockHeight uint64, epochSchedule []uint64) Height {
+	for epoch, length := range epochSchedule {
+		if blockHeight <= length {
+			return NewHeight(uint64(epoch), blockHeight)
+		}
+		blockHeight -= length
+	}
+
+	return NewHeight(uint64(len(epochSchedule)), blockHeight)
+}
+
 // Decrement will return a decremented height from the given height. If this is not possible,
 // an error is returned
 // Decrement will return a new height with the EpochHeight decremented
@@ -93,7 +289,447 @@ func (h Height) Increment() Height {
 	return NewHeight(h.EpochNumber, h.EpochHeight+1)
 }
 
+// TryIncrement returns a height with the same epoch number and an incremented
+// epoch height. Unlike Increment, it returns an error instead of wrapping the
+// epoch height around to zero if it is already at its maximum value.
+func (h Height) TryIncrement() (Height, error) {
+	if h.EpochHeight == math.MaxUint64 {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "cannot increment %s: epoch height overflows", h)
+	}
+	return NewHeight(h.EpochNumber, h.EpochHeight+1), nil
+}
+
+// SubHeights returns a new height with the given delta subtracted from the
+// EpochHeight. The epoch number is left unchanged. The subtraction saturates
+// at an EpochHeight of 1, the minimum valid block height, instead of wrapping
+// around when delta is greater than or equal to the current EpochHeight.
+func (h Height) SubHeights(delta uint64) Height {
+	if delta >= h.EpochHeight {
+		return NewHeight(h.EpochNumber, 1)
+	}
+	return NewHeight(h.EpochNumber, h.EpochHeight-delta)
+}
+
+// AddHeights returns a new height with the given delta added to the EpochHeight.
+// The epoch number is left unchanged. The addition saturates at the maximum
+// uint64 EpochHeight instead of wrapping around when EpochHeight + delta would
+// overflow. Use TryAddHeights to detect the overflow.
+func (h Height) AddHeights(delta uint64) Height {
+	added, err := h.TryAddHeights(delta)
+	if err != nil {
+		return NewHeight(h.EpochNumber, math.MaxUint64)
+	}
+	return added
+}
+
+// TryAddHeights returns a new height with the given delta added to the
+// EpochHeight. Unlike AddHeights, it returns an error instead of saturating if
+// EpochHeight + delta would exceed the maximum uint64 value.
+func (h Height) TryAddHeights(delta uint64) (Height, error) {
+	if delta > math.MaxUint64-h.EpochHeight {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "cannot add %d to %s: epoch height overflows", delta, h)
+	}
+	return NewHeight(h.EpochNumber, h.EpochHeight+delta), nil
+}
+
+// SignedDiff returns the number of blocks from the other height to this height
+// within the same epoch: positive if this height is greater, negative if it is
+// lower and zero if both are equal. Block heights of different epochs are not
+// comparable, so an error is returned across epochs, as well as if the distance
+// overflows an int64.
+func (h Height) SignedDiff(other Height) (int64, error) {
+	if h.EpochNumber != other.EpochNumber {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "cannot compute the distance across epochs (%s to %s)", other, h)
+	}
+
+	if h.EpochHeight >= other.EpochHeight {
+		diff := h.EpochHeight - other.EpochHeight
+		if diff > math.MaxInt64 {
+			return 0, sdkerrors.Wrapf(ErrInvalidHeight, "distance from %s to %s overflows", other, h)
+		}
+		return int64(diff), nil
+	}
+
+	diff := other.EpochHeight - h.EpochHeight
+	// the lowest int64 has no positive counterpart
+	if diff > math.MaxInt64+1 {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "distance from %s to %s overflows", other, h)
+	}
+	return -int64(diff - 1) - 1, nil
+}
+
+// BlockHeightInt64 returns the epoch height as the int64 block height used by the
+// Tendermint RPC. An error is returned if it exceeds the maximum int64 value
+// instead of silently overflowing to a negative height.
+func (h Height) BlockHeightInt64() (int64, error) {
+	if h.EpochHeight > math.MaxInt64 {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "epoch height of %s overflows an int64 block height", h)
+	}
+	return int64(h.EpochHeight), nil
+}
+
+// NewHeightFromInt64 returns the height of the given epoch at an int64 block
+// height, as used by the Tendermint RPC. An error is returned for a negative
+// block height.
+func NewHeightFromInt64(epochNumber uint64, blockHeight int64) (Height, error) {
+	if blockHeight < 0 {
+		return Height{}, sdkerrors.Wrapf(ErrInvalidHeight, "block height cannot be negative, got %d", blockHeight)
+	}
+	return NewHeight(epochNumber, uint64(blockHeight)), nil
+}
+
+// EpochProgress returns the fraction of an epoch of the given length that has
+// elapsed at this height, computed as EpochHeight/epochLength and clamped to the
+// range [0, 1]. A zero epochLength is treated as an unknown epoch length and
+// returns 0.
+func (h Height) EpochProgress(epochLength uint64) float64 {
+	if epochLength == 0 {
+		return 0
+	}
+	if h.EpochHeight >= epochLength {
+		return 1
+	}
+	return float64(h.EpochHeight) / float64(epochLength)
+}
+
+// EstimateTimeTo returns the estimated time until the target height is reached,
+// computed as the number of blocks between both heights multiplied by the given
+// average block time. Block times of other epochs are unknown, so the target must
+// be in the same epoch and must not be lower than this height.
+func (h Height) EstimateTimeTo(target Height, avgBlockTime time.Duration) (time.Duration, error) {
+	if avgBlockTime <= 0 {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "average block time must be positive, got %s", avgBlockTime)
+	}
+	if h.EpochNumber != target.EpochNumber {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "cannot estimate time across epochs (%s to %s)", h, target)
+	}
+	if target.LT(h) {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "target height %s is lower than height %s", target, h)
+	}
+
+	gap := target.EpochHeight - h.EpochHeight
+	if gap > uint64(math.MaxInt64/avgBlockTime) {
+		return 0, sdkerrors.Wrapf(ErrInvalidHeight, "estimated time to target height %s overflows", target)
+	}
+
+	return time.Duration(gap) * avgBlockTime, nil
+}
+
+// TimeoutHeight returns the packet timeout height found by adding blocksAhead
+// blocks to the current height within the current epoch. A zero blocksAhead
+// returns the zero height, which disables the timeout height of a packet. The
+// epoch height saturates at the maximum uint64 value.
+func TimeoutHeight(current Height, blocksAhead uint64) Height {
+	if blocksAhead == 0 {
+		return Height{}
+	}
+	if blocksAhead > math.MaxUint64-current.EpochHeight {
+		return NewHeight(current.EpochNumber, math.MaxUint64)
+	}
+	return NewHeight(current.EpochNumber, current.EpochHeight+blocksAhead)
+}
+
+// IsTimedOut returns true if a packet with the given timeout height has timed out
+// at the current height, i.e. if the current height is greater than or equal to
+// the timeout height. A zero timeout height is disabled and never times out.
+func IsTimedOut(current, timeout Height) bool {
+	if timeout.IsZero() {
+		return false
+	}
+	return current.Compare(timeout) >= 0
+}
+
+// heightAmino defines the Amino representation of a Height. Both fields are
+// always emitted so that the legacy wire format doesn't depend on the protobuf
+// generated struct tags.
+type heightAmino struct {
+	EpochNumber uint64 `json:"epoch_number"`
+	EpochHeight uint64 `json:"epoch_height"`
+}
+
+// MarshalAmino defines custom encoding scheme for the legacy Amino codec
+func (h Height) MarshalAmino() (heightAmino, error) {
+	return heightAmino{
+		EpochNumber: h.EpochNumber,
+		EpochHeight: h.EpochHeight,
+	}, nil
+}
+
+// UnmarshalAmino defines custom decoding scheme for the legacy Amino codec
+func (h *Height) UnmarshalAmino(amino heightAmino) error {
+	h.EpochNumber = amino.EpochNumber
+	h.EpochHeight = amino.EpochHeight
+	return nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. It accepts both
+// the short ("1-500") and the full ("epoch-1-height-500") string representations.
+func (h *Height) UnmarshalText(text []byte) error {
+	heightStr := strings.TrimSpace(string(text))
+
+	height, err := ParseHeightShort(heightStr)
+	if err != nil {
+		height, err = ParseHeight(heightStr)
+		if err != nil {
+			return err
+		}
+	}
+
+	*h = height
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Besides the JSON object
+// form, with either snake_case or camelCase keys, it accepts a JSON string holding any of the formats supported by
+// UnmarshalText and the two elements array shorthand [epoch, height]. The form is
+// detected from the leading token. It is required as encoding/json rejects JSON
+// objects for types implementing encoding.TextUnmarshaler.
+func (h *Height) UnmarshalJSON(bz []byte) error {
+	trimmed := bytes.TrimSpace(bz)
+	if len(trimmed) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeight, "empty JSON height")
+	}
+
+	var epochNumber, epochHeight json.Number
+
+	switch trimmed[0] {
+	case '"':
+		var heightStr string
+		if err := json.Unmarshal(trimmed, &heightStr); err != nil {
+			return err
+		}
+		return h.UnmarshalText([]byte(heightStr))
+
+	case '[':
+		var height []json.Number
+		if err := json.Unmarshal(trimmed, &height); err != nil {
+			return err
+		}
+		if len(height) != 2 {
+			return sdkerrors.Wrapf(ErrInvalidHeight, "expected [epoch, height] array, got %d elements", len(height))
+		}
+		epochNumber, epochHeight = height[0], height[1]
+
+	default:
+		// the epoch fields are decoded as numbers to support both the JSON numbers of
+		// encoding/json and the quoted integers of the Amino and protobuf JSON encodings.
+		// The camelCase keys used by JavaScript tooling are accepted as aliases of the
+		// snake_case keys, which take precedence when both are set.
+		var height struct {
+			EpochNumber      json.Number `json:"epoch_number"`
+			EpochHeight      json.Number `json:"epoch_height"`
+			EpochNumberCamel json.Number `json:"epochNumber"`
+			EpochHeightCamel json.Number `json:"epochHeight"`
+		}
+		if err := json.Unmarshal(trimmed, &height); err != nil {
+			return err
+		}
+		epochNumber, epochHeight = height.EpochNumber, height.EpochHeight
+		if epochNumber == "" {
+			epochNumber = height.EpochNumberCamel
+		}
+		if epochHeight == "" {
+			epochHeight = height.EpochHeightCamel
+		}
+	}
+
+	number, err := parseJSONNumber(epochNumber)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHeight, "invalid epoch number %s: %s", epochNumber, err)
+	}
+
+	height, err := parseJSONNumber(epochHeight)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidHeight, "invalid epoch height %s: %s", epochHeight, err)
+	}
+
+	*h = NewHeight(number, height)
+	return nil
+}
+
+// parseJSONNumber parses an unsigned integer from a JSON number. Omitted numbers
+// are parsed as zero.
+func parseJSONNumber(num json.Number) (uint64, error) {
+	if num == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(num.String(), 10, 64)
+}
+
 // IsZero returns true if height epoch and epoch-height are both 0
 func (h Height) IsZero() bool {
 	return h.EpochNumber == 0 && h.EpochHeight == 0
 }
+
+// IsGenesis returns true if the epoch-height is 1, the minimum valid block height,
+// regardless of the epoch number. Unlike IsZero, which reports an unset height,
+// IsGenesis reports the first trusted height of a chain. As the epoch-height is
+// reset to 1 on every upgrade, the first height of any epoch is a genesis height.
+func (h Height) IsGenesis() bool {
+	return h.EpochHeight == 1
+}
+
+// Heights defines a list of heights. It implements sort.Interface, ordering the
+// heights in ascending order.
+type Heights []Height
+
+// Len implements sort.Interface
+func (h Heights) Len() int { return len(h) }
+
+// Less implements sort.Interface
+func (h Heights) Less(i, j int) bool { return h[i].LT(h[j]) }
+
+// Swap implements sort.Interface
+func (h Heights) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+// Dedup returns a copy of the heights sorted in ascending order, without
+// duplicates. The receiver isn't modified.
+func (h Heights) Dedup() Heights {
+	if len(h) == 0 {
+		return Heights{}
+	}
+
+	sorted := make(Heights, len(h))
+	copy(sorted, h)
+	sort.Sort(sorted)
+
+	deduped := sorted[:1]
+	for _, height := range sorted[1:] {
+		if !height.EQ(deduped[len(deduped)-1]) {
+			deduped = append(deduped, height)
+		}
+	}
+
+	return deduped
+}
+
+// FloorOf returns the greatest height of the list lower than or equal to the
+// target height. It returns false if all the heights are greater than the target
+// or if the list is empty. The list doesn't need to be sorted.
+func (h Heights) FloorOf(target Height) (Height, bool) {
+	var (
+		floor Height
+		found bool
+	)
+	for _, height := range h {
+		if height.LTE(target) && (!found || height.GT(floor)) {
+			floor, found = height, true
+		}
+	}
+	return floor, found
+}
+
+// CeilOf returns the lowest height of the list greater than or equal to the
+// target height. It returns false if all the heights are lower than the target
+// or if the list is empty. The list doesn't need to be sorted.
+func (h Heights) CeilOf(target Height) (Height, bool) {
+	var (
+		ceil  Height
+		found bool
+	)
+	for _, height := range h {
+		if height.GTE(target) && (!found || height.LT(ceil)) {
+			ceil, found = height, true
+		}
+	}
+	return ceil, found
+}
+
+// Stats returns the lowest and greatest heights of the list, the number of heights
+// and the total block span of the list. Block heights of different epochs are not
+// comparable, so the span is the sum over each epoch of the distance between its
+// lowest and greatest heights: the blocks between epochs aren't counted, and an
+// epoch with a single height spans no blocks. The span saturates at the maximum
+// uint64 value. The list doesn't need to be sorted and zero values are returned
+// for an empty list.
+func (h Heights) Stats() (min, max Height, count int, spanBlocks uint64) {
+	if len(h) == 0 {
+		return Height{}, Height{}, 0, 0
+	}
+
+	// lowest and greatest heights of each epoch
+	epochMin := make(map[uint64]uint64)
+	epochMax := make(map[uint64]uint64)
+
+	min, max = h[0], h[0]
+	for _, height := range h {
+		if height.LT(min) {
+			min = height
+		}
+		if height.GT(max) {
+			max = height
+		}
+
+		if lowest, ok := epochMin[height.EpochNumber]; !ok || height.EpochHeight < lowest {
+			epochMin[height.EpochNumber] = height.EpochHeight
+		}
+		if greatest, ok := epochMax[height.EpochNumber]; !ok || height.EpochHeight > greatest {
+			epochMax[height.EpochNumber] = height.EpochHeight
+		}
+	}
+
+	for epoch, lowest := range epochMin {
+		span := epochMax[epoch] - lowest
+		if span > math.MaxUint64-spanBlocks {
+			return min, max, len(h), math.MaxUint64
+		}
+		spanBlocks += span
+	}
+
+	return min, max, len(h), spanBlocks
+}
+
+// HeightRange defines an inclusive range of heights of a single epoch.
+type HeightRange struct {
+	Start Height `json:"start" yaml:"start"`
+	End   Height `json:"end" yaml:"end"`
+}
+
+// HeightsCover returns true if every height in the inclusive range [from, to] is
+// contained in states, the heights of the consensus states stored for a client.
+// Otherwise it returns false along with the ranges of missing heights in
+// ascending order. Only the stored heights are walked, so the cost doesn't
+// depend on the width of the range and at most len(states)+1 gaps are returned.
+// The heights of an epoch other than the one of from can't be enumerated, so a
+// range spanning several epochs is never covered and no gaps are returned. An
+// empty range, where from is greater than to, is always covered. The states
+// don't need to be sorted.
+func HeightsCover(states Heights, from, to Height) (gaps []HeightRange, ok bool) {
+	if from.GT(to) {
+		return nil, true
+	}
+	if from.EpochNumber != to.EpochNumber {
+		return nil, false
+	}
+
+	var inRange Heights
+	for _, height := range states {
+		if height.GTE(from) && height.LTE(to) {
+			inRange = append(inRange, height)
+		}
+	}
+	sort.Sort(inRange)
+
+	// next is the lowest height of the range not known to be covered yet
+	next := from.EpochHeight
+	for _, height := range inRange {
+		if height.EpochHeight < next {
+			// duplicate height
+			continue
+		}
+		if height.EpochHeight > next {
+			gaps = append(gaps, HeightRange{
+				Start: NewHeight(from.EpochNumber, next),
+				End:   NewHeight(from.EpochNumber, height.EpochHeight-1),
+			})
+		}
+		// return before incrementing to avoid overflowing when to is the maximum height
+		if height.EpochHeight == to.EpochHeight {
+			return gaps, len(gaps) == 0
+		}
+		next = height.EpochHeight + 1
+	}
+
+	gaps = append(gaps, HeightRange{Start: NewHeight(from.EpochNumber, next), End: to})
+	return gaps, false
+}
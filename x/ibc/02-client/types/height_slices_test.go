@@ -0,0 +1,46 @@
+//go:build go1.21
+// +build go1.21
+
+package types_test
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+func TestCompareHeightsSortFunc(t *testing.T) {
+	heights := []types.Height{
+		types.NewHeight(2, 1),
+		types.NewHeight(0, 10),
+		types.NewHeight(1, math.MaxUint64),
+		types.NewHeight(0, 2),
+		types.NewHeight(2, 1),
+		types.NewHeight(1, 5),
+		types.NewHeight(math.MaxUint64, 0),
+	}
+
+	slices.SortFunc(heights, types.CompareHeights)
+
+	require.Equal(t, []types.Height{
+		types.NewHeight(0, 2),
+		types.NewHeight(0, 10),
+		types.NewHeight(1, 5),
+		types.NewHeight(1, math.MaxUint64),
+		types.NewHeight(2, 1),
+		types.NewHeight(2, 1),
+		types.NewHeight(math.MaxUint64, 0),
+	}, heights)
+	require.True(t, slices.IsSortedFunc(heights, types.CompareHeights))
+
+	// the order matches the one of the Heights wrapper
+	reversed := slices.Clone(heights)
+	slices.Reverse(reversed)
+	sort.Sort(types.Heights(reversed))
+	require.Equal(t, heights, reversed)
+}
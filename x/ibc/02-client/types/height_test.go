@@ -1,10 +1,20 @@
 package types_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
-	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 )
 
 func TestCompareHeights(t *testing.T) {
@@ -19,6 +29,8 @@ func TestCompareHeights(t *testing.T) {
 		{"epoch height 1 is lesser", types.NewHeight(3, 4), types.NewHeight(3, 9), -1},
 		{"epoch height 1 is greater", types.NewHeight(3, 8), types.NewHeight(3, 3), 1},
 		{"height is equal", types.NewHeight(4, 4), types.NewHeight(4, 4), 0},
+		{"large epoch number 1 is greater", types.NewHeight(math.MaxUint64, 1), types.NewHeight(1, 1), 1},
+		{"large epoch height 1 is greater", types.NewHeight(1, math.MaxUint64), types.NewHeight(1, 1), 1},
 	}
 
 	for i, tc := range testCases {
@@ -35,6 +47,84 @@ func TestCompareHeights(t *testing.T) {
 			require.True(t, compare == 1, "case %d: %s should return positive value on comparison, got: %d",
 				i, tc.name, compare)
 		}
+
+		require.Equal(t, int(tc.compareSign), types.CompareHeights(tc.height1, tc.height2), "case %d: %s", i, tc.name)
+	}
+}
+
+// Regression test: Compare used to subtract the epoch numbers or heights as
+// int64, which wraps around for values above math.MaxInt64 and inverted the
+// result of the comparison.
+func TestCompareAboveMaxInt64(t *testing.T) {
+	aboveMaxInt64 := uint64(math.MaxInt64) + 1
+
+	testCases := []struct {
+		name        string
+		height1     types.Height
+		height2     types.Height
+		compareSign int64
+	}{
+		{"epoch number above max int64 vs zero", types.NewHeight(aboveMaxInt64, 1), types.NewHeight(0, 1), 1},
+		{"zero vs epoch number above max int64", types.NewHeight(0, 1), types.NewHeight(aboveMaxInt64, 1), -1},
+		{"epoch numbers around max int64", types.NewHeight(math.MaxInt64, 1), types.NewHeight(aboveMaxInt64, 1), -1},
+		{"max epoch number vs one", types.NewHeight(math.MaxUint64, 1), types.NewHeight(1, 1), 1},
+		{"epoch height above max int64 vs zero", types.NewHeight(1, aboveMaxInt64), types.NewHeight(1, 0), 1},
+		{"zero vs epoch height above max int64", types.NewHeight(1, 0), types.NewHeight(1, aboveMaxInt64), -1},
+		{"epoch heights around max int64", types.NewHeight(1, aboveMaxInt64), types.NewHeight(1, math.MaxInt64), 1},
+		{"max epoch height vs one", types.NewHeight(1, 1), types.NewHeight(1, math.MaxUint64), -1},
+		{"equal above max int64", types.NewHeight(aboveMaxInt64, math.MaxUint64), types.NewHeight(aboveMaxInt64, math.MaxUint64), 0},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.compareSign, tc.height1.Compare(tc.height2), tc.name)
+		require.Equal(t, -tc.compareSign, tc.height2.Compare(tc.height1), tc.name)
+	}
+}
+
+func TestCompareKey(t *testing.T) {
+	heights := []types.Height{
+		types.NewHeight(0, 0),
+		types.NewHeight(0, 1),
+		types.NewHeight(0, math.MaxUint64),
+		types.NewHeight(1, 0),
+		types.NewHeight(1, 5),
+		types.NewHeight(3, 4),
+		types.NewHeight(3, 9),
+		types.NewHeight(math.MaxUint64, 0),
+		types.NewHeight(math.MaxUint64, math.MaxUint64),
+	}
+
+	// the keys order every pair of heights as Compare does
+	for _, a := range heights {
+		for _, b := range heights {
+			require.Equal(t, int(a.Compare(b)), a.CompareKey(b), "%s vs %s", a, b)
+			require.Equal(t, a.CompareKey(b), a.Key().Compare(b.Key()), "%s vs %s", a, b)
+			require.Equal(t, a.EQ(b), a.Key() == b.Key(), "%s vs %s", a, b)
+		}
+	}
+
+	require.Equal(t, types.HeightKey{Hi: 3, Lo: 9}, types.NewHeight(3, 9).Key())
+}
+
+func BenchmarkHeightCompare(b *testing.B) {
+	h1, h2 := types.NewHeight(3, 100), types.NewHeight(3, 101)
+	for i := 0; i < b.N; i++ {
+		h1.Compare(h2)
+	}
+}
+
+func BenchmarkHeightCompareKey(b *testing.B) {
+	h1, h2 := types.NewHeight(3, 100), types.NewHeight(3, 101)
+	for i := 0; i < b.N; i++ {
+		h1.CompareKey(h2)
+	}
+}
+
+func BenchmarkHeightKeyCompare(b *testing.B) {
+	// the keys are precomputed once, as in a timeout loop
+	k1, k2 := types.NewHeight(3, 100).Key(), types.NewHeight(3, 101).Key()
+	for i := 0; i < b.N; i++ {
+		k1.Compare(k2)
 	}
 }
 
@@ -53,3 +143,697 @@ func TestDecrement(t *testing.T) {
 	require.Equal(t, types.Height{}, actual, "invalid decrement returned non-zero height: %s", actual)
 	require.False(t, success, "invalid decrement passed")
 }
+
+func TestTryIncrement(t *testing.T) {
+	incremented, err := types.NewHeight(3, 3).TryIncrement()
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeight(3, 4), incremented)
+
+	incremented, err = types.NewHeight(3, math.MaxUint64-1).TryIncrement()
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeight(3, math.MaxUint64), incremented)
+
+	incremented, err = types.NewHeight(3, math.MaxUint64).TryIncrement()
+	require.Error(t, err, "increment at the maximum epoch height did not overflow")
+	require.True(t, errors.Is(err, types.ErrInvalidHeight))
+	require.Equal(t, types.Height{}, incremented)
+}
+
+func TestIsGenesis(t *testing.T) {
+	testCases := []struct {
+		name      string
+		height    types.Height
+		isGenesis bool
+	}{
+		{"first height of the first epoch", types.NewHeight(0, 1), true},
+		{"first height of a later epoch", types.NewHeight(3, 1), true},
+		{"zero height", types.NewHeight(0, 0), false},
+		{"later height", types.NewHeight(1, 5), false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.isGenesis, tc.height.IsGenesis(), tc.name)
+	}
+}
+
+func TestSubHeights(t *testing.T) {
+	testCases := []struct {
+		name     string
+		height   types.Height
+		delta    uint64
+		expected types.Height
+	}{
+		{"subtract within epoch", types.NewHeight(2, 10), 3, types.NewHeight(2, 7)},
+		{"subtract zero", types.NewHeight(2, 10), 0, types.NewHeight(2, 10)},
+		{"subtract to floor", types.NewHeight(2, 10), 9, types.NewHeight(2, 1)},
+		{"subtract equal to height saturates", types.NewHeight(2, 10), 10, types.NewHeight(2, 1)},
+		{"subtract larger than height saturates", types.NewHeight(2, 10), 100, types.NewHeight(2, 1)},
+	}
+
+	for _, tc := range testCases {
+		actual := tc.height.SubHeights(tc.delta)
+		require.Equal(t, tc.expected, actual, "case %s: subtracting %d from %s did not return expected height", tc.name, tc.delta, tc.height)
+	}
+}
+
+func TestAddHeights(t *testing.T) {
+	testCases := []struct {
+		name      string
+		height    types.Height
+		delta     uint64
+		expected  types.Height
+		saturated bool
+	}{
+		{"add within epoch", types.NewHeight(2, 10), 3, types.NewHeight(2, 13), false},
+		{"add zero", types.NewHeight(2, 10), 0, types.NewHeight(2, 10), false},
+		{"add to zero height", types.NewHeight(0, 0), 5, types.NewHeight(0, 5), false},
+		{"add up to the maximum", types.NewHeight(2, 10), math.MaxUint64 - 10, types.NewHeight(2, math.MaxUint64), false},
+		{"add zero at the maximum", types.NewHeight(2, math.MaxUint64), 0, types.NewHeight(2, math.MaxUint64), false},
+		{"add one past the maximum", types.NewHeight(2, 10), math.MaxUint64 - 9, types.NewHeight(2, math.MaxUint64), true},
+		{"add one at the maximum", types.NewHeight(2, math.MaxUint64), 1, types.NewHeight(2, math.MaxUint64), true},
+		{"add the maximum", types.NewHeight(2, 1), math.MaxUint64, types.NewHeight(2, math.MaxUint64), true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, tc.height.AddHeights(tc.delta), tc.name)
+
+		added, err := tc.height.TryAddHeights(tc.delta)
+		if tc.saturated {
+			require.True(t, errors.Is(err, types.ErrInvalidHeight), tc.name)
+			require.Equal(t, types.Height{}, added, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expected, added, tc.name)
+		}
+	}
+}
+
+func TestEpochProgress(t *testing.T) {
+	testCases := []struct {
+		name        string
+		height      types.Height
+		epochLength uint64
+		expected    float64
+	}{
+		{"start of epoch", types.NewHeight(1, 0), 100, 0},
+		{"middle of epoch", types.NewHeight(1, 50), 100, 0.5},
+		{"end of epoch", types.NewHeight(1, 100), 100, 1},
+		{"height over epoch length is clamped", types.NewHeight(1, 150), 100, 1},
+		{"zero epoch length", types.NewHeight(1, 50), 0, 0},
+	}
+
+	for _, tc := range testCases {
+		actual := tc.height.EpochProgress(tc.epochLength)
+		require.Equal(t, tc.expected, actual, "case %s: unexpected progress for %s with epoch length %d", tc.name, tc.height, tc.epochLength)
+	}
+}
+
+func TestSignedDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		height   types.Height
+		other    types.Height
+		expected int64
+		expPass  bool
+	}{
+		{"positive", types.NewHeight(1, 160), types.NewHeight(1, 100), 60, true},
+		{"negative", types.NewHeight(1, 100), types.NewHeight(1, 160), -60, true},
+		{"zero", types.NewHeight(1, 100), types.NewHeight(1, 100), 0, true},
+		{"cross-epoch", types.NewHeight(2, 1), types.NewHeight(1, 100), 0, false},
+		{"max positive", types.NewHeight(0, math.MaxInt64), types.NewHeight(0, 0), math.MaxInt64, true},
+		{"min negative", types.NewHeight(0, 0), types.NewHeight(0, math.MaxInt64+1), math.MinInt64, true},
+		{"positive overflow", types.NewHeight(0, math.MaxInt64+1), types.NewHeight(0, 0), 0, false},
+		{"negative overflow", types.NewHeight(0, 0), types.NewHeight(0, math.MaxUint64), 0, false},
+	}
+
+	for _, tc := range testCases {
+		actual, err := tc.height.SignedDiff(tc.other)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expected, actual, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestBlockHeightInt64(t *testing.T) {
+	testCases := []struct {
+		name     string
+		height   types.Height
+		expected int64
+		expPass  bool
+	}{
+		{"zero", types.NewHeight(0, 0), 0, true},
+		{"in range", types.NewHeight(1, 100), 100, true},
+		{"max int64", types.NewHeight(1, math.MaxInt64), math.MaxInt64, true},
+		{"overflow", types.NewHeight(1, math.MaxInt64+1), 0, false},
+		{"max uint64", types.NewHeight(1, math.MaxUint64), 0, false},
+	}
+
+	for _, tc := range testCases {
+		actual, err := tc.height.BlockHeightInt64()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expected, actual, tc.name)
+
+			// the conversion round trips
+			height, err := types.NewHeightFromInt64(tc.height.EpochNumber, actual)
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.height, height, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.True(t, errors.Is(err, types.ErrInvalidHeight), tc.name)
+		}
+	}
+
+	_, err := types.NewHeightFromInt64(1, -1)
+	require.Error(t, err)
+	_, err = types.NewHeightFromInt64(1, math.MinInt64)
+	require.Error(t, err)
+}
+
+func TestEstimateTimeTo(t *testing.T) {
+	testCases := []struct {
+		name         string
+		height       types.Height
+		target       types.Height
+		avgBlockTime time.Duration
+		expected     time.Duration
+		expPass      bool
+	}{
+		{"forward target", types.NewHeight(1, 100), types.NewHeight(1, 160), 5 * time.Second, 5 * time.Minute, true},
+		{"same height", types.NewHeight(1, 100), types.NewHeight(1, 100), 5 * time.Second, 0, true},
+		{"reversed target", types.NewHeight(1, 100), types.NewHeight(1, 99), 5 * time.Second, 0, false},
+		{"target in next epoch", types.NewHeight(1, 100), types.NewHeight(2, 1), 5 * time.Second, 0, false},
+		{"target in previous epoch", types.NewHeight(1, 100), types.NewHeight(0, 200), 5 * time.Second, 0, false},
+		{"zero average block time", types.NewHeight(1, 100), types.NewHeight(1, 160), 0, 0, false},
+		{"negative average block time", types.NewHeight(1, 100), types.NewHeight(1, 160), -time.Second, 0, false},
+		{"overflow", types.NewHeight(1, 0), types.NewHeight(1, math.MaxUint64), time.Second, 0, false},
+	}
+
+	for _, tc := range testCases {
+		actual, err := tc.height.EstimateTimeTo(tc.target, tc.avgBlockTime)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expected, actual, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestHeightAmino(t *testing.T) {
+	cdc := codec.New()
+
+	testCases := []struct {
+		name    string
+		height  types.Height
+		expJSON string
+	}{
+		{"zero height", types.Height{}, `{"epoch_number":"0","epoch_height":"0"}`},
+		{"epoch zero", types.NewHeight(0, 10), `{"epoch_number":"0","epoch_height":"10"}`},
+		{"non-zero epoch", types.NewHeight(3, 10), `{"epoch_number":"3","epoch_height":"10"}`},
+	}
+
+	for _, tc := range testCases {
+		bz, err := cdc.MarshalJSON(tc.height)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expJSON, string(bz), tc.name)
+
+		var height types.Height
+		require.NoError(t, cdc.UnmarshalJSON(bz, &height), tc.name)
+		require.Equal(t, tc.height, height, tc.name)
+
+		bz, err = cdc.MarshalBinaryBare(tc.height)
+		require.NoError(t, err, tc.name)
+
+		height = types.Height{}
+		require.NoError(t, cdc.UnmarshalBinaryBare(bz, &height), tc.name)
+		require.Equal(t, tc.height, height, tc.name)
+	}
+}
+
+func TestParseHeight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		heightStr string
+		parse     func(string) (types.Height, error)
+		expHeight types.Height
+		expPass   bool
+	}{
+		{"full format", "epoch-1-height-500", types.ParseHeight, types.NewHeight(1, 500), true},
+		{"full format from String", types.NewHeight(3, 7).String(), types.ParseHeight, types.NewHeight(3, 7), true},
+		{"full format missing height", "epoch-1-500", types.ParseHeight, types.Height{}, false},
+		{"full format invalid epoch", "epoch-a-height-500", types.ParseHeight, types.Height{}, false},
+		{"short format", "1-500", types.ParseHeightShort, types.NewHeight(1, 500), true},
+		{"short format zero epoch", "0-10", types.ParseHeightShort, types.NewHeight(0, 10), true},
+		{"short format negative height", "1--500", types.ParseHeightShort, types.Height{}, false},
+		{"short format missing epoch", "500", types.ParseHeightShort, types.Height{}, false},
+		{"short format invalid height", "1-abc", types.ParseHeightShort, types.Height{}, false},
+	}
+
+	for _, tc := range testCases {
+		height, err := tc.parse(tc.heightStr)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expHeight, height, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestHeightUnmarshalText(t *testing.T) {
+	// relayerConfig mimics a config struct populated by a library relying on text
+	// unmarshaling, such as env or flag parsers.
+	type relayerConfig struct {
+		StartHeight types.Height `json:"start_height"`
+	}
+
+	os.Setenv("IBC_START_HEIGHT", "1-500")
+	defer os.Unsetenv("IBC_START_HEIGHT")
+
+	var config relayerConfig
+	require.NoError(t, config.StartHeight.UnmarshalText([]byte(os.Getenv("IBC_START_HEIGHT"))))
+	require.Equal(t, types.NewHeight(1, 500), config.StartHeight)
+
+	require.NoError(t, config.StartHeight.UnmarshalText([]byte("epoch-2-height-3")))
+	require.Equal(t, types.NewHeight(2, 3), config.StartHeight)
+
+	require.Error(t, config.StartHeight.UnmarshalText([]byte("1/500")))
+
+	// JSON configs accept both the string and the object forms
+	require.NoError(t, json.Unmarshal([]byte(`{"start_height":"4-40"}`), &config))
+	require.Equal(t, types.NewHeight(4, 40), config.StartHeight)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"start_height":{"epoch_number":5,"epoch_height":50}}`), &config))
+	require.Equal(t, types.NewHeight(5, 50), config.StartHeight)
+
+	require.Error(t, json.Unmarshal([]byte(`{"start_height":"invalid"}`), &config))
+}
+
+func TestHeightUnmarshalJSON(t *testing.T) {
+	// the object form round trips
+	for _, height := range []types.Height{{}, types.NewHeight(0, 10), types.NewHeight(3, math.MaxUint64)} {
+		bz, err := json.Marshal(height)
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(bz), "{"), "marshaled %s to %s", height, bz)
+
+		var decoded types.Height
+		require.NoError(t, json.Unmarshal(bz, &decoded))
+		require.Equal(t, height, decoded)
+	}
+
+	// the default output remains snake_case
+	bz, err := json.Marshal(types.NewHeight(1, 2))
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"epoch_number"`)
+	require.Contains(t, string(bz), `"epoch_height"`)
+	require.NotContains(t, string(bz), "epochNumber")
+
+	testCases := []struct {
+		name      string
+		json      string
+		expHeight types.Height
+		expPass   bool
+	}{
+		{"array", `[1,500]`, types.NewHeight(1, 500), true},
+		{"array with whitespace", ` [ 0 , 7 ] `, types.NewHeight(0, 7), true},
+		{"array of quoted integers", `["2","20"]`, types.NewHeight(2, 20), true},
+		{"max array", `[18446744073709551615,18446744073709551615]`, types.NewHeight(math.MaxUint64, math.MaxUint64), true},
+		{"object", `{"epoch_number":"4","epoch_height":"40"}`, types.NewHeight(4, 40), true},
+		{"camelCase object", `{"epochNumber":"4","epochHeight":"40"}`, types.NewHeight(4, 40), true},
+		{"camelCase object with numbers", `{"epochNumber":4,"epochHeight":40}`, types.NewHeight(4, 40), true},
+		{"snake_case keys take precedence", `{"epoch_number":4,"epochNumber":5,"epochHeight":40}`, types.NewHeight(4, 40), true},
+		{"string", `"epoch-5-height-50"`, types.NewHeight(5, 50), true},
+		{"array with one element", `[1]`, types.Height{}, false},
+		{"array with three elements", `[1,2,3]`, types.Height{}, false},
+		{"empty array", `[]`, types.Height{}, false},
+		{"array with negative height", `[1,-2]`, types.Height{}, false},
+		{"array with fractional height", `[1,2.5]`, types.Height{}, false},
+		{"array of non numbers", `["a","b"]`, types.Height{}, false},
+		{"empty", ``, types.Height{}, false},
+	}
+
+	for _, tc := range testCases {
+		var height types.Height
+		err := height.UnmarshalJSON([]byte(tc.json))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expHeight, height, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestTimeoutHeight(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     types.Height
+		blocksAhead uint64
+		expected    types.Height
+	}{
+		{"blocks ahead within epoch", types.NewHeight(1, 100), 50, types.NewHeight(1, 150)},
+		{"zero blocks ahead disables timeout", types.NewHeight(1, 100), 0, types.Height{}},
+		{"saturates at max epoch height", types.NewHeight(1, math.MaxUint64-1), 10, types.NewHeight(1, math.MaxUint64)},
+	}
+
+	for _, tc := range testCases {
+		actual := types.TimeoutHeight(tc.current, tc.blocksAhead)
+		require.Equal(t, tc.expected, actual, "case %s: unexpected timeout height", tc.name)
+	}
+}
+
+func TestIsTimedOut(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  types.Height
+		timeout  types.Height
+		expected bool
+	}{
+		{"current height below timeout", types.NewHeight(1, 99), types.NewHeight(1, 100), false},
+		{"current height equal to timeout", types.NewHeight(1, 100), types.NewHeight(1, 100), true},
+		{"current height above timeout", types.NewHeight(1, 101), types.NewHeight(1, 100), true},
+		{"current epoch above timeout epoch", types.NewHeight(2, 1), types.NewHeight(1, 100), true},
+		{"current epoch below timeout epoch", types.NewHeight(0, 1000), types.NewHeight(1, 100), false},
+		{"zero timeout is disabled", types.NewHeight(5, 1000), types.Height{}, false},
+	}
+
+	for _, tc := range testCases {
+		actual := types.IsTimedOut(tc.current, tc.timeout)
+		require.Equal(t, tc.expected, actual, "case %s: unexpected timed out result", tc.name)
+	}
+}
+
+func TestComparePtr(t *testing.T) {
+	low, high := types.NewHeight(1, 1), types.NewHeight(1, 2)
+
+	testCases := []struct {
+		name   string
+		a, b   *types.Height
+		expCmp int64
+		expEQ  bool
+		expErr bool
+	}{
+		{"both non-nil lower", &low, &high, -1, false, false},
+		{"both non-nil greater", &high, &low, 1, false, false},
+		{"both non-nil equal", &low, &low, 0, true, false},
+		{"first nil", nil, &low, 0, false, true},
+		{"second nil", &low, nil, 0, false, true},
+		{"both nil", nil, nil, 0, false, true},
+	}
+
+	for _, tc := range testCases {
+		cmp, err := types.ComparePtr(tc.a, tc.b)
+		eq, eqErr := types.EQPtr(tc.a, tc.b)
+
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+			require.Error(t, eqErr, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.NoError(t, eqErr, tc.name)
+		require.Equal(t, tc.expCmp, cmp, tc.name)
+		require.Equal(t, tc.expEQ, eq, tc.name)
+	}
+}
+
+func TestHeightsDedup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		heights  types.Heights
+		expDedup types.Heights
+	}{
+		{"nil heights", nil, types.Heights{}},
+		{"already unique", types.Heights{types.NewHeight(0, 1), types.NewHeight(0, 2), types.NewHeight(1, 1)}, types.Heights{types.NewHeight(0, 1), types.NewHeight(0, 2), types.NewHeight(1, 1)}},
+		{"unsorted unique", types.Heights{types.NewHeight(1, 1), types.NewHeight(0, 2), types.NewHeight(0, 1)}, types.Heights{types.NewHeight(0, 1), types.NewHeight(0, 2), types.NewHeight(1, 1)}},
+		{"duplicates in single epoch", types.Heights{types.NewHeight(1, 3), types.NewHeight(1, 3), types.NewHeight(1, 2), types.NewHeight(1, 3)}, types.Heights{types.NewHeight(1, 2), types.NewHeight(1, 3)}},
+		{"duplicates spanning epochs", types.Heights{types.NewHeight(2, 1), types.NewHeight(1, 5), types.NewHeight(0, 9), types.NewHeight(1, 5), types.NewHeight(2, 1), types.NewHeight(0, 9), types.NewHeight(1, 2)}, types.Heights{types.NewHeight(0, 9), types.NewHeight(1, 2), types.NewHeight(1, 5), types.NewHeight(2, 1)}},
+	}
+
+	for _, tc := range testCases {
+		input := append(types.Heights(nil), tc.heights...)
+
+		require.Equal(t, tc.expDedup, tc.heights.Dedup(), tc.name)
+		require.Equal(t, input, tc.heights, "input modified: %s", tc.name)
+	}
+}
+
+func TestHeightsFloorOfCeilOf(t *testing.T) {
+	heights := types.Heights{
+		types.NewHeight(0, 5), types.NewHeight(0, 10), types.NewHeight(1, 2), types.NewHeight(1, 7),
+	}
+
+	testCases := []struct {
+		name     string
+		target   types.Height
+		expFloor types.Height
+		floorOk  bool
+		expCeil  types.Height
+		ceilOk   bool
+	}{
+		{"below all heights", types.NewHeight(0, 1), types.Height{}, false, types.NewHeight(0, 5), true},
+		{"matching the lowest height", types.NewHeight(0, 5), types.NewHeight(0, 5), true, types.NewHeight(0, 5), true},
+		{"between heights of an epoch", types.NewHeight(0, 7), types.NewHeight(0, 5), true, types.NewHeight(0, 10), true},
+		{"between epochs", types.NewHeight(1, 1), types.NewHeight(0, 10), true, types.NewHeight(1, 2), true},
+		{"matching a height", types.NewHeight(1, 2), types.NewHeight(1, 2), true, types.NewHeight(1, 2), true},
+		{"matching the greatest height", types.NewHeight(1, 7), types.NewHeight(1, 7), true, types.NewHeight(1, 7), true},
+		{"above all heights", types.NewHeight(2, 1), types.NewHeight(1, 7), true, types.Height{}, false},
+	}
+
+	for _, tc := range testCases {
+		floor, ok := heights.FloorOf(tc.target)
+		require.Equal(t, tc.floorOk, ok, tc.name)
+		require.Equal(t, tc.expFloor, floor, tc.name)
+
+		ceil, ok := heights.CeilOf(tc.target)
+		require.Equal(t, tc.ceilOk, ok, tc.name)
+		require.Equal(t, tc.expCeil, ceil, tc.name)
+	}
+
+	_, ok := types.Heights{}.FloorOf(types.NewHeight(0, 1))
+	require.False(t, ok)
+	_, ok = types.Heights{}.CeilOf(types.NewHeight(0, 1))
+	require.False(t, ok)
+}
+
+func TestHeightsStats(t *testing.T) {
+	testCases := []struct {
+		name          string
+		heights       types.Heights
+		expMin        types.Height
+		expMax        types.Height
+		expCount      int
+		expSpanBlocks uint64
+	}{
+		{"empty list", types.Heights{}, types.Height{}, types.Height{}, 0, 0},
+		{"single height", types.Heights{types.NewHeight(1, 5)}, types.NewHeight(1, 5), types.NewHeight(1, 5), 1, 0},
+		{
+			"single epoch",
+			types.Heights{types.NewHeight(1, 12), types.NewHeight(1, 5), types.NewHeight(1, 20)},
+			types.NewHeight(1, 5), types.NewHeight(1, 20), 3, 15,
+		},
+		{
+			"single epoch with duplicates",
+			types.Heights{types.NewHeight(1, 5), types.NewHeight(1, 5), types.NewHeight(1, 8)},
+			types.NewHeight(1, 5), types.NewHeight(1, 8), 3, 3,
+		},
+		{
+			"multiple epochs",
+			types.Heights{
+				types.NewHeight(2, 3), types.NewHeight(0, 100), types.NewHeight(0, 10),
+				types.NewHeight(2, 1), types.NewHeight(1, 50),
+			},
+			// the blocks between epochs aren't counted: (100-10) + 0 + (3-1)
+			types.NewHeight(0, 10), types.NewHeight(2, 3), 5, 92,
+		},
+		{
+			"span saturates",
+			types.Heights{
+				types.NewHeight(0, 0), types.NewHeight(0, math.MaxUint64),
+				types.NewHeight(1, 0), types.NewHeight(1, 1),
+			},
+			types.NewHeight(0, 0), types.NewHeight(1, 1), 4, math.MaxUint64,
+		},
+	}
+
+	for _, tc := range testCases {
+		min, max, count, spanBlocks := tc.heights.Stats()
+		require.Equal(t, tc.expMin, min, tc.name)
+		require.Equal(t, tc.expMax, max, tc.name)
+		require.Equal(t, tc.expCount, count, tc.name)
+		require.Equal(t, tc.expSpanBlocks, spanBlocks, tc.name)
+	}
+}
+
+func TestHeightsCover(t *testing.T) {
+	states := types.Heights{
+		types.NewHeight(1, 1), types.NewHeight(1, 2), types.NewHeight(1, 3),
+		types.NewHeight(1, 5), types.NewHeight(1, 6), types.NewHeight(1, 8),
+		types.NewHeight(2, 4), types.NewHeight(3, math.MaxUint64),
+	}
+
+	gap := func(epoch, start, end uint64) types.HeightRange {
+		return types.HeightRange{Start: types.NewHeight(epoch, start), End: types.NewHeight(epoch, end)}
+	}
+
+	testCases := []struct {
+		name    string
+		states  types.Heights
+		from    types.Height
+		to      types.Height
+		expGaps []types.HeightRange
+		expOk   bool
+	}{
+		{"full coverage", states, types.NewHeight(1, 1), types.NewHeight(1, 3), nil, true},
+		{"single height", states, types.NewHeight(2, 4), types.NewHeight(2, 4), nil, true},
+		{"single gap", states, types.NewHeight(1, 2), types.NewHeight(1, 6), []types.HeightRange{gap(1, 4, 4)}, false},
+		{"multiple gaps", states, types.NewHeight(1, 3), types.NewHeight(1, 9), []types.HeightRange{gap(1, 4, 4), gap(1, 7, 7), gap(1, 9, 9)}, false},
+		{"gap in other epoch", states, types.NewHeight(2, 3), types.NewHeight(2, 4), []types.HeightRange{gap(2, 3, 3)}, false},
+		{"unsorted with duplicates", types.Heights{types.NewHeight(1, 3), types.NewHeight(1, 1), types.NewHeight(1, 3)}, types.NewHeight(1, 1), types.NewHeight(1, 4), []types.HeightRange{gap(1, 2, 2), gap(1, 4, 4)}, false},
+		{"huge range", states, types.NewHeight(1, 1), types.NewHeight(1, math.MaxUint64), []types.HeightRange{gap(1, 4, 4), gap(1, 7, 7), gap(1, 9, math.MaxUint64)}, false},
+		{"huge range without states", nil, types.NewHeight(1, 1), types.NewHeight(1, math.MaxUint64), []types.HeightRange{gap(1, 1, math.MaxUint64)}, false},
+		{"maximum height covered", states, types.NewHeight(3, math.MaxUint64-1), types.NewHeight(3, math.MaxUint64), []types.HeightRange{gap(3, math.MaxUint64-1, math.MaxUint64-1)}, false},
+		{"empty range", states, types.NewHeight(1, 4), types.NewHeight(1, 3), nil, true},
+		{"range spanning epochs", states, types.NewHeight(1, 8), types.NewHeight(2, 4), nil, false},
+	}
+
+	for _, tc := range testCases {
+		gaps, ok := types.HeightsCover(tc.states, tc.from, tc.to)
+		require.Equal(t, tc.expOk, ok, tc.name)
+		require.Equal(t, tc.expGaps, gaps, tc.name)
+	}
+}
+
+func TestHeightBytes(t *testing.T) {
+	// heights in ascending order
+	heights := []types.Height{
+		types.NewHeight(0, 0), types.NewHeight(0, 2), types.NewHeight(0, 10), types.NewHeight(0, 256),
+		types.NewHeight(1, 1), types.NewHeight(1, math.MaxUint64), types.NewHeight(2, 0),
+	}
+
+	for i := 1; i < len(heights); i++ {
+		require.Len(t, heights[i].Bytes(), 16)
+		require.Equal(t, -1, bytes.Compare(heights[i-1].Bytes(), heights[i].Bytes()), "%s not lower than %s", heights[i-1], heights[i])
+	}
+
+	for _, height := range heights {
+		parsed, err := types.HeightFromBytes(height.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, height, parsed)
+	}
+
+	_, err := types.HeightFromBytes([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestHeightFromBytesLength(t *testing.T) {
+	valid := types.NewHeight(1, 256).Bytes()
+
+	testCases := []struct {
+		name    string
+		bz      []byte
+		expPass bool
+	}{
+		{"16 bytes", valid, true},
+		{"too short", valid[:15], false},
+		{"epoch number only", valid[:8], false},
+		{"too long", append(types.NewHeight(1, 256).Bytes(), 0), false},
+		{"two encoded heights", append(types.NewHeight(1, 256).Bytes(), valid...), false},
+		{"empty", []byte{}, false},
+		{"nil", nil, false},
+	}
+
+	for _, tc := range testCases {
+		height, err := types.HeightFromBytes(tc.bz)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, types.NewHeight(1, 256), height, tc.name)
+		} else {
+			require.True(t, errors.Is(err, types.ErrInvalidHeight), tc.name)
+			require.Contains(t, err.Error(), fmt.Sprintf("got %d", len(tc.bz)), tc.name)
+			require.Equal(t, types.Height{}, height, tc.name)
+		}
+	}
+}
+
+func TestEpochKeyRange(t *testing.T) {
+	inRange := func(height types.Height, start, end []byte) bool {
+		bz := height.Bytes()
+		return bytes.Compare(bz, start) >= 0 && (end == nil || bytes.Compare(bz, end) < 0)
+	}
+
+	start, end := types.EpochKeyRange(1)
+	for _, height := range []types.Height{
+		types.NewHeight(1, 0), types.NewHeight(1, 1), types.NewHeight(1, 256), types.NewHeight(1, math.MaxUint64),
+	} {
+		require.True(t, inRange(height, start, end), "%s not in range of epoch 1", height)
+	}
+	for _, height := range []types.Height{
+		types.NewHeight(0, 0), types.NewHeight(0, math.MaxUint64), types.NewHeight(2, 0), types.NewHeight(2, 1),
+	} {
+		require.False(t, inRange(height, start, end), "%s in range of epoch 1", height)
+	}
+
+	start, end = types.EpochKeyRange(math.MaxUint64)
+	require.Nil(t, end)
+	require.True(t, inRange(types.NewHeight(math.MaxUint64, math.MaxUint64), start, end))
+	require.False(t, inRange(types.NewHeight(math.MaxUint64-1, math.MaxUint64), start, end))
+}
+
+func TestMigrateHeight(t *testing.T) {
+	require.Equal(t, types.NewHeight(0, 100), types.MigrateHeight(100, 0))
+	require.Equal(t, types.NewHeight(3, 100), types.MigrateHeight(100, 3))
+}
+
+func TestHeightFromBlockHeight(t *testing.T) {
+	// epochs 0, 1 and 2 are completed, epoch 3 is the current one
+	schedule := []uint64{100, 50, 200}
+
+	testCases := []struct {
+		name        string
+		blockHeight uint64
+		schedule    []uint64
+		expHeight   types.Height
+	}{
+		{"first epoch", 10, schedule, types.NewHeight(0, 10)},
+		{"first block", 1, schedule, types.NewHeight(0, 1)},
+		{"last block of the first epoch", 100, schedule, types.NewHeight(0, 100)},
+		{"first block of a middle epoch", 101, schedule, types.NewHeight(1, 1)},
+		{"middle epoch", 120, schedule, types.NewHeight(1, 20)},
+		{"last block of the last completed epoch", 350, schedule, types.NewHeight(2, 200)},
+		{"latest epoch", 351, schedule, types.NewHeight(3, 1)},
+		{"far into the latest epoch", 10350, schedule, types.NewHeight(3, 10000)},
+		{"no completed epochs", 42, nil, types.NewHeight(0, 42)},
+		{"max block height", math.MaxUint64, schedule, types.NewHeight(3, math.MaxUint64-350)},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expHeight, types.HeightFromBlockHeight(tc.blockHeight, tc.schedule), tc.name)
+	}
+}
+
+func TestHeightHumanString(t *testing.T) {
+	testCases := []struct {
+		height types.Height
+		expStr string
+	}{
+		{types.NewHeight(0, 0), "epoch-0-height-0"},
+		{types.NewHeight(0, 999), "epoch-0-height-999"},
+		{types.NewHeight(0, 1000), "epoch-0-height-1,000"},
+		{types.NewHeight(2, 1234567), "epoch-2-height-1,234,567"},
+		{types.NewHeight(1000, 123456), "epoch-1000-height-123,456"},
+		{types.NewHeight(0, math.MaxUint64), "epoch-0-height-18,446,744,073,709,551,615"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expStr, tc.height.HumanString())
+	}
+
+	// the default representation is unchanged
+	require.Equal(t, "epoch-2-height-1234567", types.NewHeight(2, 1234567).String())
+}
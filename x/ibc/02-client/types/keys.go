@@ -9,4 +9,8 @@ const (
 
 	// QuerierRoute is the querier route for IBC client
 	QuerierRoute string = SubModuleName
+
+	// ConsensusHeightIndexUpgrade is the name of the upgrade indexing the consensus
+	// states stored before the index of consensus states ordered by height existed
+	ConsensusHeightIndexUpgrade string = "ibc-client-consensus-height-index"
 )
@@ -13,12 +13,14 @@ const (
 	TypeMsgCreateClient       string = "create_client"
 	TypeMsgUpdateClient       string = "update_client"
 	TypeMsgSubmitMisbehaviour string = "submit_misbehaviour"
+	TypeMsgFreezeClient       string = "freeze_client"
 )
 
 var (
 	_ sdk.Msg = &MsgCreateClient{}
 	_ sdk.Msg = &MsgUpdateClient{}
 	_ sdk.Msg = &MsgSubmitMisbehaviour{}
+	_ sdk.Msg = &MsgFreezeClient{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgCreateClient{}
 	_ codectypes.UnpackInterfacesMessage = MsgUpdateClient{}
@@ -48,6 +50,42 @@ func NewMsgCreateClient(
 	}, nil
 }
 
+// BuildCreateClientMsg assembles a MsgCreateClient from a client state and a
+// consensus state, such as the one returned by a node consensus state query. It
+// returns an error if the client or consensus state type doesn't match the given
+// client type or if the resulting message fails basic validation.
+func BuildCreateClientMsg(
+	id, clientType string, consensusState exported.ConsensusState, clientState exported.ClientState, signer sdk.AccAddress,
+) (*MsgCreateClient, error) {
+	if clientState == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidClient, "client state cannot be nil")
+	}
+	if consensusState == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidConsensus, "consensus state cannot be nil")
+	}
+	if clientState.ClientType().String() != clientType {
+		return nil, sdkerrors.Wrapf(
+			ErrInvalidClientType, "client state type %s doesn't match client type %s", clientState.ClientType(), clientType,
+		)
+	}
+	if consensusState.ClientType().String() != clientType {
+		return nil, sdkerrors.Wrapf(
+			ErrInvalidClientType, "consensus state type %s doesn't match client type %s", consensusState.ClientType(), clientType,
+		)
+	}
+
+	msg, err := NewMsgCreateClient(id, clientState, consensusState, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
 // Route implements sdk.Msg
 func (msg MsgCreateClient) Route() string {
 	return host.RouterKey
@@ -239,3 +277,42 @@ func (msg MsgSubmitMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 
 	return nil
 }
+
+// NewMsgFreezeClient creates a new MsgFreezeClient instance.
+func NewMsgFreezeClient(clientID string, frozenHeight Height, signer sdk.AccAddress) *MsgFreezeClient {
+	return &MsgFreezeClient{
+		ClientId:     clientID,
+		FrozenHeight: frozenHeight,
+		Signer:       signer,
+	}
+}
+
+// Route returns the MsgFreezeClient's route.
+func (msg MsgFreezeClient) Route() string { return host.RouterKey }
+
+// Type returns the MsgFreezeClient's type.
+func (msg MsgFreezeClient) Type() string {
+	return TypeMsgFreezeClient
+}
+
+// ValidateBasic performs basic (non-state-dependant) validation on a MsgFreezeClient.
+func (msg MsgFreezeClient) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer address cannot be empty")
+	}
+	if msg.FrozenHeight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidHeight, "frozen height cannot be zero")
+	}
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// GetSignBytes returns the raw bytes a signer is expected to sign when submitting
+// a MsgFreezeClient message.
+func (msg MsgFreezeClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the single expected signer for a MsgFreezeClient.
+func (msg MsgFreezeClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
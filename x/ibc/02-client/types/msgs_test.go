@@ -191,6 +191,42 @@ func (suite *TypesTestSuite) TestMsgCreateClient_ValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestBuildCreateClientMsg() {
+	tendermintClient := ibctmtypes.NewClientState(suite.chain.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs())
+	tendermintConsState := suite.chain.CreateTMClientHeader().ConsensusState()
+	soloMachine := ibctesting.NewSolomachine(suite.T(), "solomachine")
+	signer := suite.chain.SenderAccount.GetAddress()
+
+	cases := []struct {
+		name           string
+		clientID       string
+		clientType     string
+		consensusState exported.ConsensusState
+		clientState    exported.ClientState
+		expPass        bool
+	}{
+		{"valid - tendermint client", "tendermint", exported.ClientTypeTendermint, tendermintConsState, tendermintClient, true},
+		{"valid - solomachine client", soloMachine.ClientID, exported.ClientTypeSoloMachine, soloMachine.ConsensusState(), soloMachine.ClientState(), true},
+		{"client type doesn't match client state", "tendermint", exported.ClientTypeSoloMachine, soloMachine.ConsensusState(), tendermintClient, false},
+		{"client type doesn't match consensus state", "tendermint", exported.ClientTypeTendermint, soloMachine.ConsensusState(), tendermintClient, false},
+		{"nil consensus state", "tendermint", exported.ClientTypeTendermint, nil, tendermintClient, false},
+		{"nil client state", "tendermint", exported.ClientTypeTendermint, tendermintConsState, nil, false},
+		{"invalid client id", "", exported.ClientTypeTendermint, tendermintConsState, tendermintClient, false},
+		{"invalid client state", "tendermint", exported.ClientTypeTendermint, tendermintConsState, &ibctmtypes.ClientState{}, false},
+	}
+
+	for _, tc := range cases {
+		msg, err := types.BuildCreateClientMsg(tc.clientID, tc.clientType, tc.consensusState, tc.clientState, signer)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.clientID, msg.ClientId, tc.name)
+			suite.Require().Equal(signer, msg.Signer, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 // tests that different header within MsgUpdateClient can be marshaled
 // and unmarshaled.
 func (suite *TypesTestSuite) TestMarshalMsgUpdateClient() {
@@ -475,3 +511,27 @@ func (suite *TypesTestSuite) TestMsgSubmitMisbehaviour_ValidateBasic() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgFreezeClient_ValidateBasic() {
+	signer := suite.chain.SenderAccount.GetAddress()
+
+	cases := []struct {
+		name    string
+		msg     *types.MsgFreezeClient
+		expPass bool
+	}{
+		{"valid msg", types.NewMsgFreezeClient("tendermint", types.NewHeight(0, 10), signer), true},
+		{"invalid client-id", types.NewMsgFreezeClient("", types.NewHeight(0, 10), signer), false},
+		{"zero frozen height", types.NewMsgFreezeClient("tendermint", types.Height{}, signer), false},
+		{"empty signer", types.NewMsgFreezeClient("tendermint", types.NewHeight(0, 10), nil), false},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
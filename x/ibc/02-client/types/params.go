@@ -0,0 +1,133 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultAllowManualFreeze disabled
+	DefaultAllowManualFreeze = false
+	// DefaultMinTrustingPeriod disables the minimum trusting period check
+	DefaultMinTrustingPeriod time.Duration = 0
+	// DefaultFreezeAuthority is empty so that nobody can freeze clients manually
+	DefaultFreezeAuthority = ""
+	// DefaultAllowLegacyProofs disabled
+	DefaultAllowLegacyProofs = false
+	// DefaultCompressConsensusStates disabled
+	DefaultCompressConsensusStates = false
+)
+
+var (
+	// KeyAllowManualFreeze is store's key for AllowManualFreeze Params
+	KeyAllowManualFreeze = []byte("AllowManualFreeze")
+	// KeyMinTrustingPeriod is store's key for MinTrustingPeriod Params
+	KeyMinTrustingPeriod = []byte("MinTrustingPeriod")
+	// KeyFreezeAuthority is store's key for FreezeAuthority Params
+	KeyFreezeAuthority = []byte("FreezeAuthority")
+	// KeyAllowLegacyProofs is store's key for AllowLegacyProofs Params
+	KeyAllowLegacyProofs = []byte("AllowLegacyProofs")
+	// KeyCompressConsensusStates is store's key for CompressConsensusStates Params
+	KeyCompressConsensusStates = []byte("CompressConsensusStates")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ibc client submodule
+func NewParams(
+	allowManualFreeze bool, minTrustingPeriod time.Duration, freezeAuthority string,
+	allowLegacyProofs, compressConsensusStates bool,
+) Params {
+	return Params{
+		AllowManualFreeze:       allowManualFreeze,
+		MinTrustingPeriod:       minTrustingPeriod,
+		FreezeAuthority:         freezeAuthority,
+		AllowLegacyProofs:       allowLegacyProofs,
+		CompressConsensusStates: compressConsensusStates,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc client submodule
+func DefaultParams() Params {
+	return NewParams(
+		DefaultAllowManualFreeze, DefaultMinTrustingPeriod, DefaultFreezeAuthority,
+		DefaultAllowLegacyProofs, DefaultCompressConsensusStates,
+	)
+}
+
+// Validate all ibc client submodule parameters
+func (p Params) Validate() error {
+	if err := validateEnabled(p.AllowManualFreeze); err != nil {
+		return err
+	}
+
+	if err := validateMinTrustingPeriod(p.MinTrustingPeriod); err != nil {
+		return err
+	}
+
+	if err := validateFreezeAuthority(p.FreezeAuthority); err != nil {
+		return err
+	}
+
+	if err := validateEnabled(p.AllowLegacyProofs); err != nil {
+		return err
+	}
+
+	return validateEnabled(p.CompressConsensusStates)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowManualFreeze, &p.AllowManualFreeze, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMinTrustingPeriod, &p.MinTrustingPeriod, validateMinTrustingPeriod),
+		paramtypes.NewParamSetPair(KeyFreezeAuthority, &p.FreezeAuthority, validateFreezeAuthority),
+		paramtypes.NewParamSetPair(KeyAllowLegacyProofs, &p.AllowLegacyProofs, validateEnabled),
+		paramtypes.NewParamSetPair(KeyCompressConsensusStates, &p.CompressConsensusStates, validateEnabled),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinTrustingPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("minimum trusting period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateFreezeAuthority(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid freeze authority address %s: %w", v, err)
+	}
+
+	return nil
+}
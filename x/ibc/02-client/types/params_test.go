@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	authority := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams(true, time.Hour, authority, false, false).Validate())
+	require.Error(t, types.NewParams(false, -time.Hour, "", false, false).Validate())
+	require.Error(t, types.NewParams(true, 0, "authority", false, false).Validate())
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -157,6 +158,13 @@ func (m *QueryClientStateResponse) GetProofHeight() uint64 {
 type QueryClientStatesRequest struct {
 	// pagination request
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// order_by_height paginates the client states by descending latest height,
+	// with ties ordered by client identifier, instead of by client identifier
+	// only. Pages are read from an index, so the highest clients are returned
+	// without loading every client state. Unlike identifier ordered pages, a
+	// client updated between two page requests moves within the ordering and may
+	// be skipped or returned twice.
+	OrderByHeight bool `protobuf:"varint,2,opt,name=order_by_height,json=orderByHeight,proto3" json:"order_by_height,omitempty"`
 }
 
 func (m *QueryClientStatesRequest) Reset()         { *m = QueryClientStatesRequest{} }
@@ -199,6 +207,13 @@ func (m *QueryClientStatesRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryClientStatesRequest) GetOrderByHeight() bool {
+	if m != nil {
+		return m.OrderByHeight
+	}
+	return false
+}
+
 // QueryClientStatesResponse is the response type for the Query/ClientStates RPC
 // method.
 type QueryClientStatesResponse struct {
@@ -393,6 +408,250 @@ func (m *QueryConsensusStateResponse) GetProofHeight() uint64 {
 	return 0
 }
 
+// QueryConsensusStateWithPredecessorRequest is the request type for the
+// Query/ConsensusStateWithPredecessor RPC method.
+type QueryConsensusStateWithPredecessorRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state height
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryConsensusStateWithPredecessorRequest) Reset() {
+	*m = QueryConsensusStateWithPredecessorRequest{}
+}
+func (m *QueryConsensusStateWithPredecessorRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsensusStateWithPredecessorRequest) ProtoMessage() {}
+func (*QueryConsensusStateWithPredecessorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{6}
+}
+func (m *QueryConsensusStateWithPredecessorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateWithPredecessorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateWithPredecessorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateWithPredecessorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateWithPredecessorRequest.Merge(m, src)
+}
+func (m *QueryConsensusStateWithPredecessorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateWithPredecessorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateWithPredecessorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateWithPredecessorRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStateWithPredecessorRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConsensusStateWithPredecessorRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryConsensusStateWithPredecessorResponse is the response type for the
+// Query/ConsensusStateWithPredecessor RPC method.
+type QueryConsensusStateWithPredecessorResponse struct {
+	// consensus state associated with the client identifier at the given height
+	ConsensusState *types.Any `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// consensus state with the greatest height strictly lower than the given height. It is
+	// null if there is no such consensus state.
+	Predecessor *types.Any `protobuf:"bytes,2,opt,name=predecessor,proto3" json:"predecessor,omitempty"`
+	// height at which the consensus states were retrieved
+	ProofHeight uint64 `protobuf:"varint,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) Reset() {
+	*m = QueryConsensusStateWithPredecessorResponse{}
+}
+func (m *QueryConsensusStateWithPredecessorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsensusStateWithPredecessorResponse) ProtoMessage() {}
+func (*QueryConsensusStateWithPredecessorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{7}
+}
+func (m *QueryConsensusStateWithPredecessorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateWithPredecessorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateWithPredecessorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStateWithPredecessorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateWithPredecessorResponse.Merge(m, src)
+}
+func (m *QueryConsensusStateWithPredecessorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateWithPredecessorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateWithPredecessorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateWithPredecessorResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStateWithPredecessorResponse) GetConsensusState() *types.Any {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return nil
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) GetPredecessor() *types.Any {
+	if m != nil {
+		return m.Predecessor
+	}
+	return nil
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
+// QueryOldestValidConsensusStateRequest is the request type for the
+// Query/OldestValidConsensusState RPC method.
+type QueryOldestValidConsensusStateRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *QueryOldestValidConsensusStateRequest) Reset()         { *m = QueryOldestValidConsensusStateRequest{} }
+func (m *QueryOldestValidConsensusStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestValidConsensusStateRequest) ProtoMessage()    {}
+func (*QueryOldestValidConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{8}
+}
+func (m *QueryOldestValidConsensusStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOldestValidConsensusStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOldestValidConsensusStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOldestValidConsensusStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestValidConsensusStateRequest.Merge(m, src)
+}
+func (m *QueryOldestValidConsensusStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOldestValidConsensusStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestValidConsensusStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOldestValidConsensusStateRequest proto.InternalMessageInfo
+
+func (m *QueryOldestValidConsensusStateRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+// QueryOldestValidConsensusStateResponse is the response type for the
+// Query/OldestValidConsensusState RPC method.
+type QueryOldestValidConsensusStateResponse struct {
+	// oldest consensus state of the client within its trusting period
+	ConsensusState *types.Any `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// height of the consensus state
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// height at which the consensus state was retrieved
+	ProofHeight uint64 `protobuf:"varint,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+}
+
+func (m *QueryOldestValidConsensusStateResponse) Reset() {
+	*m = QueryOldestValidConsensusStateResponse{}
+}
+func (m *QueryOldestValidConsensusStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOldestValidConsensusStateResponse) ProtoMessage()    {}
+func (*QueryOldestValidConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{9}
+}
+func (m *QueryOldestValidConsensusStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOldestValidConsensusStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOldestValidConsensusStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOldestValidConsensusStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOldestValidConsensusStateResponse.Merge(m, src)
+}
+func (m *QueryOldestValidConsensusStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOldestValidConsensusStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOldestValidConsensusStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOldestValidConsensusStateResponse proto.InternalMessageInfo
+
+func (m *QueryOldestValidConsensusStateResponse) GetConsensusState() *types.Any {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return nil
+}
+
+func (m *QueryOldestValidConsensusStateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryOldestValidConsensusStateResponse) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
 // QueryConsensusStatesRequest is the request type for the Query/ConsensusStates RPC method.
 type QueryConsensusStatesRequest struct {
 	// client identifier
@@ -405,7 +664,7 @@ func (m *QueryConsensusStatesRequest) Reset()         { *m = QueryConsensusState
 func (m *QueryConsensusStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConsensusStatesRequest) ProtoMessage()    {}
 func (*QueryConsensusStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_320a7d3a97b17345, []int{6}
+	return fileDescriptor_320a7d3a97b17345, []int{10}
 }
 func (m *QueryConsensusStatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +719,7 @@ func (m *QueryConsensusStatesResponse) Reset()         { *m = QueryConsensusStat
 func (m *QueryConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConsensusStatesResponse) ProtoMessage()    {}
 func (*QueryConsensusStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_320a7d3a97b17345, []int{7}
+	return fileDescriptor_320a7d3a97b17345, []int{11}
 }
 func (m *QueryConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -503,786 +762,4412 @@ func (m *QueryConsensusStatesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.client.QueryClientStateRequest")
-	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.client.QueryClientStateResponse")
-	proto.RegisterType((*QueryClientStatesRequest)(nil), "ibc.client.QueryClientStatesRequest")
-	proto.RegisterType((*QueryClientStatesResponse)(nil), "ibc.client.QueryClientStatesResponse")
-	proto.RegisterType((*QueryConsensusStateRequest)(nil), "ibc.client.QueryConsensusStateRequest")
-	proto.RegisterType((*QueryConsensusStateResponse)(nil), "ibc.client.QueryConsensusStateResponse")
-	proto.RegisterType((*QueryConsensusStatesRequest)(nil), "ibc.client.QueryConsensusStatesRequest")
-	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.client.QueryConsensusStatesResponse")
+// QueryRecentConsensusStatesRequest is the request type for the
+// Query/RecentConsensusStates RPC method.
+type QueryRecentConsensusStatesRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// minimum timestamp of the consensus states, in UNIX nanoseconds
+	MinTimestamp uint64 `protobuf:"varint,2,opt,name=min_timestamp,json=minTimestamp,proto3" json:"min_timestamp,omitempty"`
+	// pagination request, applied to the filtered consensus states
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/client/query.proto", fileDescriptor_320a7d3a97b17345) }
-
-var fileDescriptor_320a7d3a97b17345 = []byte{
-	// 688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x18, 0xc6, 0x99, 0xe5, 0x47, 0xe0, 0xdd, 0x05, 0xbe, 0x99, 0x10, 0x58, 0x0a, 0xdf, 0xcd, 0xb2,
-	0x28, 0xac, 0x26, 0xcc, 0xc0, 0x1a, 0x7f, 0xc5, 0x18, 0x83, 0x26, 0x28, 0x89, 0x07, 0xac, 0x37,
-	0x2f, 0xa4, 0xed, 0x0e, 0xbb, 0x8d, 0xd0, 0x29, 0xcc, 0x2c, 0x91, 0x10, 0x2e, 0x1c, 0xbc, 0x6a,
-	0xe2, 0xdd, 0x93, 0x07, 0x13, 0x3d, 0xfa, 0x47, 0x78, 0x24, 0xf1, 0xe2, 0xd1, 0x80, 0x27, 0xff,
-	0x0a, 0xd3, 0x99, 0x29, 0xb4, 0x50, 0x29, 0x1a, 0x3d, 0x95, 0xbe, 0xef, 0xcc, 0x3c, 0x1f, 0x9e,
-	0xe7, 0x9d, 0x2e, 0x8c, 0xfa, 0xae, 0x47, 0xbd, 0x75, 0x9f, 0x05, 0x92, 0x6e, 0x76, 0xd8, 0xd6,
-	0x0e, 0x09, 0xb7, 0xb8, 0xe4, 0x18, 0x7c, 0xd7, 0x23, 0xba, 0x6e, 0x5d, 0xf5, 0xb8, 0xd8, 0xe0,
-	0x82, 0xba, 0x8e, 0x60, 0x7a, 0x11, 0xdd, 0x5e, 0x70, 0x99, 0x74, 0x16, 0x68, 0xe8, 0xb4, 0xfc,
-	0xc0, 0x91, 0x3e, 0x0f, 0xf4, 0x3e, 0x6b, 0x2c, 0x71, 0x9e, 0x7e, 0x98, 0xc6, 0x78, 0x8b, 0xf3,
-	0xd6, 0x3a, 0xa3, 0xea, 0xcd, 0xed, 0xac, 0x51, 0x27, 0x30, 0x5a, 0xd6, 0xa4, 0x69, 0x39, 0xa1,
-	0x4f, 0x9d, 0x20, 0xe0, 0x52, 0x1d, 0x28, 0x74, 0xb7, 0x76, 0x03, 0xc6, 0x9e, 0x44, 0x9a, 0x0f,
-	0xd4, 0x69, 0x4f, 0xa5, 0x23, 0x99, 0xcd, 0x36, 0x3b, 0x4c, 0x48, 0x3c, 0x01, 0x03, 0x5a, 0x63,
-	0xd5, 0x6f, 0x96, 0x51, 0x15, 0xd5, 0x07, 0xec, 0x7e, 0x5d, 0x58, 0x6e, 0xd6, 0x3e, 0x20, 0x28,
-	0x9f, 0xdd, 0x28, 0x42, 0x1e, 0x08, 0x86, 0x6f, 0x42, 0xc9, 0xec, 0x14, 0x51, 0x5d, 0x6d, 0x2e,
-	0x36, 0x46, 0x88, 0x26, 0x21, 0x31, 0x24, 0x59, 0x0c, 0x76, 0xec, 0xa2, 0x77, 0x72, 0x00, 0x1e,
-	0x81, 0xde, 0x70, 0x8b, 0xf3, 0xb5, 0x72, 0xa1, 0x8a, 0xea, 0x25, 0x5b, 0xbf, 0xe0, 0xff, 0x01,
-	0xd4, 0x1f, 0xab, 0xa1, 0x23, 0xdb, 0xe5, 0x6e, 0x45, 0x32, 0xa0, 0x2a, 0x2b, 0x8e, 0x6c, 0xe3,
-	0x29, 0x28, 0xe9, 0x76, 0x9b, 0xf9, 0xad, 0xb6, 0x2c, 0xf7, 0x54, 0x51, 0xbd, 0xc7, 0x2e, 0xaa,
-	0xda, 0x23, 0x55, 0xaa, 0xb9, 0x67, 0x61, 0x45, 0xfc, 0x6f, 0x2e, 0x01, 0x9c, 0xf8, 0x6c, 0x50,
-	0x67, 0x88, 0x0e, 0x85, 0x44, 0xa1, 0x10, 0x9d, 0x9c, 0x09, 0x85, 0xac, 0x38, 0xad, 0xd8, 0x22,
-	0x3b, 0xb1, 0xb3, 0xf6, 0x11, 0xc1, 0x78, 0x86, 0x88, 0xb1, 0x64, 0x09, 0x06, 0x93, 0x96, 0x88,
-	0x32, 0xaa, 0x76, 0xd7, 0x8b, 0x8d, 0x29, 0x72, 0x32, 0x09, 0x64, 0xb9, 0xc9, 0x02, 0xe9, 0xaf,
-	0xf9, 0xac, 0x99, 0x34, 0xb5, 0x94, 0x30, 0x48, 0xe0, 0x87, 0x29, 0xda, 0x82, 0xa2, 0x9d, 0xcd,
-	0xa5, 0xd5, 0x10, 0x29, 0xdc, 0x6d, 0xb0, 0x34, 0x6d, 0xd4, 0x09, 0x44, 0x47, 0x5c, 0x38, 0x7b,
-	0x3c, 0x0a, 0x7d, 0xc6, 0xea, 0x82, 0xb2, 0xda, 0xbc, 0xe1, 0x69, 0x18, 0x5c, 0x8f, 0x20, 0x65,
-	0x9c, 0x44, 0x14, 0x55, 0xbf, 0x5d, 0xd2, 0x45, 0x13, 0xc5, 0x27, 0x04, 0x13, 0x99, 0xc2, 0xc6,
-	0xa8, 0xbb, 0x30, 0xec, 0xc5, 0x9d, 0x0b, 0x8c, 0xcf, 0x90, 0x97, 0x3a, 0xe6, 0x9f, 0x4d, 0xd0,
-	0x7e, 0x36, 0xb6, 0xb8, 0x90, 0x61, 0x4b, 0x19, 0xa1, 0xfd, 0xc9, 0x88, 0xbd, 0x47, 0x30, 0x99,
-	0x0d, 0x61, 0xcc, 0xbb, 0x07, 0xff, 0x9d, 0x32, 0x2f, 0x1e, 0xb4, 0x6c, 0xf7, 0x86, 0xd3, 0xee,
-	0xfd, 0xbd, 0xf1, 0x6a, 0xfc, 0xe8, 0x81, 0x5e, 0x85, 0x8a, 0x5f, 0x21, 0x28, 0x26, 0xe6, 0x19,
-	0x4f, 0x27, 0x47, 0xfe, 0x17, 0xdf, 0x1e, 0xeb, 0xd2, 0xf9, 0x8b, 0xb4, 0x60, 0xed, 0xfa, 0xfe,
-	0x97, 0xef, 0x6f, 0x0a, 0x14, 0xcf, 0xd1, 0xc4, 0x77, 0x31, 0xfe, 0x78, 0xa6, 0xae, 0x1b, 0xdd,
-	0x3d, 0x4e, 0x67, 0x0f, 0xbf, 0x44, 0x50, 0x4a, 0x5e, 0x52, 0x7c, 0xae, 0x5a, 0x1c, 0xb1, 0x75,
-	0x39, 0x67, 0x95, 0x81, 0xba, 0xa2, 0xa0, 0xa6, 0xf1, 0x54, 0x2e, 0x14, 0x7e, 0x87, 0x60, 0x28,
-	0x1d, 0x25, 0x9e, 0x39, 0x2b, 0x92, 0x75, 0x41, 0xad, 0xd9, 0xdc, 0x75, 0x06, 0x67, 0x51, 0xe1,
-	0xdc, 0xc1, 0xb7, 0x33, 0x71, 0x4e, 0x0d, 0x4b, 0xd2, 0x26, 0xba, 0xab, 0x6f, 0xc3, 0x1e, 0x7e,
-	0x8b, 0x60, 0xf8, 0xd4, 0xc4, 0xe1, 0x3c, 0xfd, 0x63, 0xd7, 0xea, 0xf9, 0x0b, 0x0d, 0xe9, 0x2d,
-	0x45, 0xda, 0xc0, 0xf3, 0xbf, 0x4b, 0x7a, 0xff, 0xf1, 0xe7, 0xc3, 0x0a, 0x3a, 0x38, 0xac, 0xa0,
-	0x6f, 0x87, 0x15, 0xf4, 0xfa, 0xa8, 0xd2, 0x75, 0x70, 0x54, 0xe9, 0xfa, 0x7a, 0x54, 0xe9, 0x7a,
-	0xd6, 0x68, 0xf9, 0xb2, 0xdd, 0x71, 0x89, 0xc7, 0x37, 0xa8, 0xf9, 0x9d, 0xd5, 0x8f, 0x39, 0xd1,
-	0x7c, 0x4e, 0x5f, 0x28, 0xa5, 0xf9, 0xc6, 0x9c, 0x11, 0x93, 0x3b, 0x21, 0x13, 0x6e, 0x9f, 0xba,
-	0x22, 0xd7, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x03, 0xb5, 0x39, 0xdd, 0xbd, 0x07, 0x00, 0x00,
+func (m *QueryRecentConsensusStatesRequest) Reset()         { *m = QueryRecentConsensusStatesRequest{} }
+func (m *QueryRecentConsensusStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentConsensusStatesRequest) ProtoMessage()    {}
+func (*QueryRecentConsensusStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{12}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ClientState queries an IBC light client.
-	ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error)
-	// ClientStates queries all the IBC light clients of a chain.
-	ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error)
-	// ConsensusState queries a consensus state associated with a client state at a given height.
-	ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error)
-	// ConsensusStates queries all the consensus state associated with a given client.
-	ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error)
+func (m *QueryRecentConsensusStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRecentConsensusStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentConsensusStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRecentConsensusStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentConsensusStatesRequest.Merge(m, src)
+}
+func (m *QueryRecentConsensusStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentConsensusStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentConsensusStatesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error) {
-	out := new(QueryClientStateResponse)
-	err := c.cc.Invoke(ctx, "/ibc.client.Query/ClientState", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecentConsensusStatesRequest proto.InternalMessageInfo
+
+func (m *QueryRecentConsensusStatesRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error) {
-	out := new(QueryClientStatesResponse)
-	err := c.cc.Invoke(ctx, "/ibc.client.Query/ClientStates", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecentConsensusStatesRequest) GetMinTimestamp() uint64 {
+	if m != nil {
+		return m.MinTimestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error) {
-	out := new(QueryConsensusStateResponse)
-	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecentConsensusStatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error) {
-	out := new(QueryConsensusStatesResponse)
-	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRecentConsensusStatesResponse is the response type for the
+// Query/RecentConsensusStates RPC method.
+type QueryRecentConsensusStatesResponse struct {
+	// consensus states with a timestamp greater than or equal to the minimum
+	// timestamp
+	ConsensusStates []*types.Any `protobuf:"bytes,1,rep,name=consensus_states,json=consensusStates,proto3" json:"consensus_states,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ClientState queries an IBC light client.
-	ClientState(context.Context, *QueryClientStateRequest) (*QueryClientStateResponse, error)
-	// ClientStates queries all the IBC light clients of a chain.
-	ClientStates(context.Context, *QueryClientStatesRequest) (*QueryClientStatesResponse, error)
-	// ConsensusState queries a consensus state associated with a client state at a given height.
-	ConsensusState(context.Context, *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error)
-	// ConsensusStates queries all the consensus state associated with a given client.
-	ConsensusStates(context.Context, *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error)
+func (m *QueryRecentConsensusStatesResponse) Reset()         { *m = QueryRecentConsensusStatesResponse{} }
+func (m *QueryRecentConsensusStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentConsensusStatesResponse) ProtoMessage()    {}
+func (*QueryRecentConsensusStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{13}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRecentConsensusStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) ClientState(ctx context.Context, req *QueryClientStateRequest) (*QueryClientStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientState not implemented")
+func (m *QueryRecentConsensusStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentConsensusStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ClientStates(ctx context.Context, req *QueryClientStatesRequest) (*QueryClientStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientStates not implemented")
+func (m *QueryRecentConsensusStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentConsensusStatesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ConsensusState(ctx context.Context, req *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsensusState not implemented")
+func (m *QueryRecentConsensusStatesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ConsensusStates(ctx context.Context, req *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStates not implemented")
+func (m *QueryRecentConsensusStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentConsensusStatesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryRecentConsensusStatesResponse proto.InternalMessageInfo
 
-func _Query_ClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClientStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClientState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.client.Query/ClientState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClientState(ctx, req.(*QueryClientStateRequest))
+func (m *QueryRecentConsensusStatesResponse) GetConsensusStates() []*types.Any {
+	if m != nil {
+		return m.ConsensusStates
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ClientStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClientStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClientStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.client.Query/ClientStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClientStates(ctx, req.(*QueryClientStatesRequest))
+func (m *QueryRecentConsensusStatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsensusStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConsensusState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.client.Query/ConsensusState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConsensusState(ctx, req.(*QueryConsensusStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryClientTypeCountsRequest is the request type for the Query/ClientTypeCounts
+// RPC method
+type QueryClientTypeCountsRequest struct {
 }
 
-func _Query_ConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsensusStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConsensusStates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.client.Query/ConsensusStates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConsensusStates(ctx, req.(*QueryConsensusStatesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryClientTypeCountsRequest) Reset()         { *m = QueryClientTypeCountsRequest{} }
+func (m *QueryClientTypeCountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientTypeCountsRequest) ProtoMessage()    {}
+func (*QueryClientTypeCountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{14}
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.client.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClientState",
-			Handler:    _Query_ClientState_Handler,
-		},
-		{
-			MethodName: "ClientStates",
-			Handler:    _Query_ClientStates_Handler,
-		},
-		{
-			MethodName: "ConsensusState",
-			Handler:    _Query_ConsensusState_Handler,
-		},
-		{
-			MethodName: "ConsensusStates",
-			Handler:    _Query_ConsensusStates_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/client/query.proto",
+func (m *QueryClientTypeCountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryClientStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClientTypeCountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientTypeCountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryClientTypeCountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientTypeCountsRequest.Merge(m, src)
+}
+func (m *QueryClientTypeCountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientTypeCountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientTypeCountsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryClientTypeCountsRequest proto.InternalMessageInfo
+
+// QueryClientTypeCountsResponse is the response type for the Query/ClientTypeCounts
+// RPC method.
+type QueryClientTypeCountsResponse struct {
+	// number of stored clients indexed by client type
+	ClientTypeCounts map[string]uint64 `protobuf:"bytes,1,rep,name=client_type_counts,json=clientTypeCounts,proto3" json:"client_type_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (m *QueryClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryClientTypeCountsResponse) Reset()         { *m = QueryClientTypeCountsResponse{} }
+func (m *QueryClientTypeCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientTypeCountsResponse) ProtoMessage()    {}
+func (*QueryClientTypeCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{15}
+}
+func (m *QueryClientTypeCountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientTypeCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientTypeCountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClientTypeCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientTypeCountsResponse.Merge(m, src)
+}
+func (m *QueryClientTypeCountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientTypeCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientTypeCountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClientStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientTypeCountsResponse proto.InternalMessageInfo
+
+func (m *QueryClientTypeCountsResponse) GetClientTypeCounts() map[string]uint64 {
+	if m != nil {
+		return m.ClientTypeCounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClientStorageUsageRequest is the request type for the
+// Query/ClientStorageUsage RPC method.
+type QueryClientStorageUsageRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
-func (m *QueryClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProofHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ProofPath) > 0 {
-		i -= len(m.ProofPath)
-		copy(dAtA[i:], m.ProofPath)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofPath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryClientStorageUsageRequest) Reset()         { *m = QueryClientStorageUsageRequest{} }
+func (m *QueryClientStorageUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStorageUsageRequest) ProtoMessage()    {}
+func (*QueryClientStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{16}
+}
+func (m *QueryClientStorageUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStorageUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStorageUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClientStorageUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStorageUsageRequest.Merge(m, src)
+}
+func (m *QueryClientStorageUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStorageUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStorageUsageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClientStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientStorageUsageRequest proto.InternalMessageInfo
+
+func (m *QueryClientStorageUsageRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryClientStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClientStorageUsageResponse is the response type for the
+// Query/ClientStorageUsage RPC method.
+type QueryClientStorageUsageResponse struct {
+	// number of consensus states stored for the client
+	ConsensusStateCount uint64 `protobuf:"varint,1,opt,name=consensus_state_count,json=consensusStateCount,proto3" json:"consensus_state_count,omitempty"`
+	// total size in bytes of the encoded consensus states
+	ConsensusStatesSize uint64 `protobuf:"varint,2,opt,name=consensus_states_size,json=consensusStatesSize,proto3" json:"consensus_states_size,omitempty"`
 }
 
-func (m *QueryClientStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryClientStorageUsageResponse) Reset()         { *m = QueryClientStorageUsageResponse{} }
+func (m *QueryClientStorageUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientStorageUsageResponse) ProtoMessage()    {}
+func (*QueryClientStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{17}
+}
+func (m *QueryClientStorageUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientStorageUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientStorageUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClientStorageUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientStorageUsageResponse.Merge(m, src)
+}
+func (m *QueryClientStorageUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientStorageUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientStorageUsageResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClientStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientStorageUsageResponse proto.InternalMessageInfo
+
+func (m *QueryClientStorageUsageResponse) GetConsensusStateCount() uint64 {
+	if m != nil {
+		return m.ConsensusStateCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryClientStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClientStorageUsageResponse) GetConsensusStatesSize() uint64 {
+	if m != nil {
+		return m.ConsensusStatesSize
+	}
+	return 0
 }
 
-func (m *QueryClientStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryConsensusStateMetadataRequest is the request type for the
+// Query/ConsensusStateMetadata RPC method.
+type QueryConsensusStateMetadataRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// consensus state height
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryConsensusStateMetadataRequest) Reset()         { *m = QueryConsensusStateMetadataRequest{} }
+func (m *QueryConsensusStateMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStateMetadataRequest) ProtoMessage()    {}
+func (*QueryConsensusStateMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{18}
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ClientStates) > 0 {
-		for iNdEx := len(m.ClientStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClientStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateMetadataRequest.Merge(m, src)
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStateMetadataRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsensusStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsensusStateMetadataRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryConsensusStateMetadataResponse is the response type for the
+// Query/ConsensusStateMetadata RPC method.
+type QueryConsensusStateMetadataResponse struct {
+	// height of the consensus state
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp of the consensus state in nanoseconds
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// hash of the commitment root, empty if the consensus state has no root
+	RootHash []byte `protobuf:"bytes,3,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
 }
 
-func (m *QueryConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LatestHeight {
-		i--
-		if m.LatestHeight {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryConsensusStateMetadataResponse) Reset()         { *m = QueryConsensusStateMetadataResponse{} }
+func (m *QueryConsensusStateMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStateMetadataResponse) ProtoMessage()    {}
+func (*QueryConsensusStateMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{19}
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStateMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStateMetadataResponse.Merge(m, src)
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStateMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStateMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStateMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStateMetadataResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QueryConsensusStateMetadataResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryConsensusStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsensusStateMetadataResponse) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct {
 }
 
-func (m *QueryConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProofHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ProofPath) > 0 {
-		i -= len(m.ProofPath)
-		copy(dAtA[i:], m.ProofPath)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofPath)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proof) > 0 {
-		i -= len(m.Proof)
-		copy(dAtA[i:], m.Proof)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{20}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ConsensusState != nil {
-		{
-			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	// params defines the parameters of the client submodule.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{21}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsensusStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsensusStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryConsensusStatesAroundRequest is the request type for the
+// Query/ConsensusStatesAround RPC method.
+type QueryConsensusStatesAroundRequest struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// height the consensus states are queried around
+	Pivot Height `protobuf:"bytes,2,opt,name=pivot,proto3" json:"pivot"`
+	// maximum number of consensus states returned on each side of the pivot height
+	Radius uint64 `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"`
 }
 
-func (m *QueryConsensusStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConsensusStatesAroundRequest) Reset()         { *m = QueryConsensusStatesAroundRequest{} }
+func (m *QueryConsensusStatesAroundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStatesAroundRequest) ProtoMessage()    {}
+func (*QueryConsensusStatesAroundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{22}
+}
+func (m *QueryConsensusStatesAroundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStatesAroundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStatesAroundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryConsensusStatesAroundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStatesAroundRequest.Merge(m, src)
+}
+func (m *QueryConsensusStatesAroundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStatesAroundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStatesAroundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStatesAroundRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStatesAroundRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsensusStatesAroundRequest) GetPivot() Height {
+	if m != nil {
+		return m.Pivot
 	}
-	return dAtA[:n], nil
+	return Height{}
 }
 
-func (m *QueryConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsensusStatesAroundRequest) GetRadius() uint64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
 }
 
-func (m *QueryConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryConsensusStatesAroundResponse is the response type for the
+// Query/ConsensusStatesAround RPC method.
+type QueryConsensusStatesAroundResponse struct {
+	// consensus states around the pivot height, ordered by height
+	ConsensusStates []*types.Any `protobuf:"bytes,1,rep,name=consensus_states,json=consensusStates,proto3" json:"consensus_states,omitempty" yaml:"consensus_states"`
+}
+
+func (m *QueryConsensusStatesAroundResponse) Reset()         { *m = QueryConsensusStatesAroundResponse{} }
+func (m *QueryConsensusStatesAroundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStatesAroundResponse) ProtoMessage()    {}
+func (*QueryConsensusStatesAroundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320a7d3a97b17345, []int{23}
+}
+func (m *QueryConsensusStatesAroundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStatesAroundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStatesAroundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ConsensusStates) > 0 {
-		for iNdEx := len(m.ConsensusStates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConsensusStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryConsensusStatesAroundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStatesAroundResponse.Merge(m, src)
+}
+func (m *QueryConsensusStatesAroundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStatesAroundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStatesAroundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStatesAroundResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStatesAroundResponse) GetConsensusStates() []*types.Any {
+	if m != nil {
+		return m.ConsensusStates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func init() {
+	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.client.QueryClientStateRequest")
+	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.client.QueryClientStateResponse")
+	proto.RegisterType((*QueryClientStatesRequest)(nil), "ibc.client.QueryClientStatesRequest")
+	proto.RegisterType((*QueryClientStatesResponse)(nil), "ibc.client.QueryClientStatesResponse")
+	proto.RegisterType((*QueryConsensusStateRequest)(nil), "ibc.client.QueryConsensusStateRequest")
+	proto.RegisterType((*QueryConsensusStateResponse)(nil), "ibc.client.QueryConsensusStateResponse")
+	proto.RegisterType((*QueryConsensusStateWithPredecessorRequest)(nil), "ibc.client.QueryConsensusStateWithPredecessorRequest")
+	proto.RegisterType((*QueryConsensusStateWithPredecessorResponse)(nil), "ibc.client.QueryConsensusStateWithPredecessorResponse")
+	proto.RegisterType((*QueryOldestValidConsensusStateRequest)(nil), "ibc.client.QueryOldestValidConsensusStateRequest")
+	proto.RegisterType((*QueryOldestValidConsensusStateResponse)(nil), "ibc.client.QueryOldestValidConsensusStateResponse")
+	proto.RegisterType((*QueryConsensusStatesRequest)(nil), "ibc.client.QueryConsensusStatesRequest")
+	proto.RegisterType((*QueryConsensusStatesResponse)(nil), "ibc.client.QueryConsensusStatesResponse")
+	proto.RegisterType((*QueryRecentConsensusStatesRequest)(nil), "ibc.client.QueryRecentConsensusStatesRequest")
+	proto.RegisterType((*QueryRecentConsensusStatesResponse)(nil), "ibc.client.QueryRecentConsensusStatesResponse")
+	proto.RegisterType((*QueryClientTypeCountsRequest)(nil), "ibc.client.QueryClientTypeCountsRequest")
+	proto.RegisterType((*QueryClientTypeCountsResponse)(nil), "ibc.client.QueryClientTypeCountsResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "ibc.client.QueryClientTypeCountsResponse.ClientTypeCountsEntry")
+	proto.RegisterType((*QueryClientStorageUsageRequest)(nil), "ibc.client.QueryClientStorageUsageRequest")
+	proto.RegisterType((*QueryClientStorageUsageResponse)(nil), "ibc.client.QueryClientStorageUsageResponse")
+	proto.RegisterType((*QueryConsensusStateMetadataRequest)(nil), "ibc.client.QueryConsensusStateMetadataRequest")
+	proto.RegisterType((*QueryConsensusStateMetadataResponse)(nil), "ibc.client.QueryConsensusStateMetadataResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.client.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.client.QueryParamsResponse")
+	proto.RegisterType((*QueryConsensusStatesAroundRequest)(nil), "ibc.client.QueryConsensusStatesAroundRequest")
+	proto.RegisterType((*QueryConsensusStatesAroundResponse)(nil), "ibc.client.QueryConsensusStatesAroundResponse")
+}
+
+func init() { proto.RegisterFile("ibc/client/query.proto", fileDescriptor_320a7d3a97b17345) }
+
+var fileDescriptor_320a7d3a97b17345 = []byte{
+	// 1421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x24, 0x21, 0x22, 0x2f, 0x0e, 0x89, 0x86, 0x00, 0x66, 0x09, 0x4e, 0xb2, 0x29, 0xc1,
+	0xa4, 0xca, 0x2e, 0x71, 0xc5, 0xdf, 0x96, 0xa2, 0x24, 0x94, 0x02, 0xa2, 0x6a, 0xba, 0xd0, 0x56,
+	0xad, 0x2a, 0xb9, 0x6b, 0x7b, 0xb0, 0x57, 0xd8, 0xbb, 0x66, 0x67, 0x9c, 0x62, 0x10, 0x17, 0x0e,
+	0x48, 0xed, 0xa5, 0x95, 0x7a, 0xe8, 0x8d, 0x43, 0xd5, 0x43, 0xa5, 0xfe, 0x39, 0x55, 0xea, 0x27,
+	0x68, 0xc5, 0x11, 0x89, 0x4b, 0x4f, 0xa8, 0x0a, 0xfd, 0x04, 0x7c, 0x82, 0x6a, 0x67, 0x66, 0xe3,
+	0xf5, 0xee, 0x7a, 0xbd, 0x09, 0xa9, 0xd4, 0x53, 0x76, 0xdf, 0xbc, 0x37, 0xf3, 0x7b, 0xbf, 0xf7,
+	0xe6, 0xed, 0xcf, 0x81, 0x83, 0x56, 0xa9, 0xac, 0x97, 0xeb, 0x16, 0xb1, 0x99, 0x7e, 0xa7, 0x45,
+	0xdc, 0xb6, 0xd6, 0x74, 0x1d, 0xe6, 0x60, 0xb0, 0x4a, 0x65, 0x4d, 0xd8, 0x95, 0xc5, 0xb2, 0x43,
+	0x1b, 0x0e, 0xd5, 0x4b, 0x26, 0x25, 0xc2, 0x49, 0xdf, 0x58, 0x2e, 0x11, 0x66, 0x2e, 0xeb, 0x4d,
+	0xb3, 0x6a, 0xd9, 0x26, 0xb3, 0x1c, 0x5b, 0xc4, 0x29, 0x87, 0x02, 0xfb, 0x89, 0x3f, 0x72, 0xe1,
+	0x70, 0xd5, 0x71, 0xaa, 0x75, 0xa2, 0xf3, 0xb7, 0x52, 0xeb, 0x96, 0x6e, 0xda, 0xf2, 0x2c, 0x65,
+	0x5a, 0x2e, 0x99, 0x4d, 0x4b, 0x37, 0x6d, 0xdb, 0x61, 0x7c, 0x43, 0x2a, 0x57, 0xa7, 0xaa, 0x4e,
+	0xd5, 0xe1, 0x8f, 0xba, 0xf7, 0x24, 0xac, 0xea, 0x69, 0x38, 0xf4, 0x81, 0x87, 0x64, 0x8d, 0x9f,
+	0x71, 0x83, 0x99, 0x8c, 0x18, 0xe4, 0x4e, 0x8b, 0x50, 0x86, 0x8f, 0xc0, 0xa8, 0x38, 0xb9, 0x68,
+	0x55, 0xb2, 0x68, 0x16, 0xe5, 0x47, 0x8d, 0xbd, 0xc2, 0x70, 0xb5, 0xa2, 0xfe, 0x84, 0x20, 0x1b,
+	0x0d, 0xa4, 0x4d, 0xc7, 0xa6, 0x04, 0x9f, 0x81, 0x8c, 0x8c, 0xa4, 0x9e, 0x9d, 0x07, 0x8f, 0x15,
+	0xa6, 0x34, 0x81, 0x4f, 0xf3, 0xa1, 0x6b, 0x2b, 0x76, 0xdb, 0x18, 0x2b, 0x77, 0x36, 0xc0, 0x53,
+	0xb0, 0xa7, 0xe9, 0x3a, 0xce, 0xad, 0xec, 0xe0, 0x2c, 0xca, 0x67, 0x0c, 0xf1, 0x82, 0x8f, 0x02,
+	0xf0, 0x87, 0x62, 0xd3, 0x64, 0xb5, 0xec, 0x10, 0x47, 0x32, 0xca, 0x2d, 0xeb, 0x26, 0xab, 0xe1,
+	0x39, 0xc8, 0x88, 0xe5, 0x1a, 0xb1, 0xaa, 0x35, 0x96, 0x1d, 0x9e, 0x45, 0xf9, 0x61, 0x63, 0x8c,
+	0xdb, 0xae, 0x70, 0x93, 0xfa, 0x55, 0x0c, 0x5a, 0xea, 0xe7, 0x79, 0x19, 0xa0, 0x43, 0xbf, 0xc4,
+	0xba, 0xa0, 0x89, 0x5a, 0x69, 0x5e, 0xad, 0x34, 0x51, 0x50, 0x59, 0x2b, 0x6d, 0xdd, 0xac, 0xfa,
+	0x1c, 0x19, 0x81, 0x48, 0xbc, 0x00, 0x13, 0x8e, 0x5b, 0x21, 0x6e, 0xb1, 0xd4, 0xf6, 0xa1, 0x78,
+	0x69, 0xec, 0x35, 0xc6, 0xb9, 0x79, 0xb5, 0x2d, 0xc1, 0xfc, 0x8c, 0xe0, 0x70, 0x0c, 0x18, 0xc9,
+	0xdd, 0x65, 0x18, 0x0f, 0x72, 0x47, 0xb3, 0x68, 0x76, 0x28, 0x3f, 0x56, 0x98, 0xd3, 0x3a, 0x8d,
+	0xa4, 0x5d, 0xad, 0x10, 0x9b, 0x59, 0xb7, 0x2c, 0x52, 0x09, 0xb2, 0x9f, 0x09, 0x30, 0x49, 0xf1,
+	0xbb, 0x5d, 0x59, 0x0d, 0xf2, 0xac, 0x8e, 0xf7, 0xcd, 0x4a, 0x80, 0x08, 0xa6, 0xa5, 0x6e, 0x80,
+	0x22, 0xd0, 0x7a, 0x2b, 0x36, 0x6d, 0xd1, 0xd4, 0x4d, 0x82, 0x0f, 0xc2, 0x48, 0x80, 0x88, 0x61,
+	0x43, 0xbe, 0xe1, 0x79, 0x18, 0xaf, 0x7b, 0x20, 0x99, 0xcf, 0xd3, 0x10, 0xe7, 0x29, 0x23, 0x8c,
+	0x92, 0xa6, 0xdf, 0x10, 0x1c, 0x89, 0x3d, 0x58, 0x12, 0x75, 0x01, 0x26, 0xca, 0xfe, 0x4a, 0x8a,
+	0x3e, 0xdb, 0x57, 0xee, 0xda, 0xe6, 0x3f, 0x6b, 0xb5, 0xcf, 0xe1, 0x44, 0x0c, 0xea, 0x8f, 0x2d,
+	0x56, 0x5b, 0x77, 0x49, 0x85, 0x94, 0x09, 0xa5, 0x8e, 0xfb, 0x2a, 0xec, 0xa9, 0x7f, 0x22, 0x58,
+	0x4c, 0x73, 0xc4, 0xee, 0xf0, 0x74, 0x1a, 0xc6, 0x9a, 0x9d, 0x5d, 0xb3, 0x83, 0x09, 0xa1, 0x41,
+	0xc7, 0x08, 0x55, 0x43, 0x51, 0xaa, 0x2e, 0xc1, 0x31, 0x9e, 0xc7, 0xfb, 0xf5, 0x0a, 0xa1, 0xec,
+	0x23, 0xb3, 0x6e, 0x55, 0xb6, 0xdf, 0x64, 0xea, 0xf7, 0x08, 0x16, 0xfa, 0x6d, 0xb3, 0x3b, 0x54,
+	0xf4, 0x6a, 0xe7, 0x14, 0xa9, 0x3e, 0x8c, 0x6f, 0x66, 0x9a, 0xaa, 0x11, 0x2e, 0xc7, 0x5c, 0xe5,
+	0x1d, 0x0c, 0x28, 0xf5, 0x47, 0x04, 0xd3, 0xf1, 0x20, 0x24, 0x3f, 0x17, 0x61, 0x32, 0xc4, 0x8f,
+	0x3f, 0x7e, 0xe2, 0x09, 0x9a, 0xe8, 0x26, 0x68, 0x17, 0x87, 0xce, 0x2f, 0x08, 0xe6, 0x38, 0x54,
+	0x83, 0x94, 0x89, 0xcd, 0x76, 0xc2, 0xda, 0x3c, 0x8c, 0x37, 0x2c, 0xbb, 0xc8, 0xac, 0x06, 0xa1,
+	0xcc, 0x6c, 0x34, 0x65, 0xd1, 0x32, 0x0d, 0xcb, 0xbe, 0xe9, 0xdb, 0x42, 0xd4, 0x0e, 0xed, 0x98,
+	0xda, 0x5f, 0x11, 0xa8, 0x49, 0x78, 0xff, 0x77, 0x04, 0xe7, 0xfc, 0x56, 0xe0, 0x74, 0xdd, 0x6c,
+	0x37, 0xc9, 0x9a, 0xd3, 0xb2, 0x99, 0x4f, 0xad, 0xfa, 0x0c, 0xc1, 0xd1, 0x1e, 0x0e, 0x32, 0x97,
+	0x06, 0x60, 0x49, 0x3e, 0x6b, 0x37, 0x49, 0xb1, 0xcc, 0x57, 0x65, 0x36, 0x17, 0x83, 0x5f, 0xab,
+	0xc4, 0x6d, 0xb4, 0xf0, 0xc2, 0x3b, 0x36, 0x73, 0xdb, 0xc6, 0x64, 0x39, 0x64, 0x56, 0xd6, 0xe0,
+	0x40, 0xac, 0x2b, 0x9e, 0x84, 0xa1, 0xdb, 0xa4, 0x2d, 0xcb, 0xef, 0x3d, 0x7a, 0xa3, 0x7d, 0xc3,
+	0xac, 0xb7, 0x88, 0xac, 0xb8, 0x78, 0x39, 0x3f, 0x78, 0x16, 0xa9, 0x17, 0x20, 0xd7, 0xf5, 0xe5,
+	0x75, 0x5c, 0xb3, 0x4a, 0x3e, 0xa4, 0x9d, 0xa2, 0x26, 0x8f, 0x9a, 0x2f, 0x11, 0xcc, 0xf4, 0x8c,
+	0x97, 0xb4, 0x14, 0xe0, 0x40, 0xa8, 0xc4, 0x82, 0x1a, 0xbe, 0xd9, 0xb0, 0xb1, 0xbf, 0xbb, 0xa2,
+	0x3c, 0x91, 0x98, 0x18, 0x5a, 0xa4, 0xd6, 0x3d, 0x3f, 0x81, 0x50, 0x0c, 0xbd, 0x61, 0xdd, 0x23,
+	0xea, 0x27, 0xb2, 0xe1, 0xba, 0x5b, 0xed, 0x3d, 0xc2, 0xcc, 0x8a, 0xc9, 0xcc, 0x57, 0xfa, 0xc0,
+	0xdc, 0x85, 0xf9, 0xc4, 0xad, 0x65, 0xa6, 0x9d, 0x70, 0xd4, 0x35, 0x0e, 0xa7, 0x61, 0x34, 0x7c,
+	0xe9, 0x3a, 0x06, 0x0f, 0x91, 0xeb, 0x38, 0xac, 0x58, 0x33, 0xa9, 0xf8, 0xc0, 0x66, 0x8c, 0xbd,
+	0x9e, 0xe1, 0x8a, 0x49, 0x6b, 0xea, 0x14, 0x60, 0x7e, 0xf2, 0xba, 0xe9, 0x9a, 0x8d, 0xad, 0x5e,
+	0x5c, 0x81, 0xfd, 0x5d, 0x56, 0x79, 0xfe, 0x22, 0x8c, 0x34, 0xb9, 0x45, 0x0e, 0x71, 0x1c, 0x6c,
+	0x3a, 0xe9, 0x2b, 0x3d, 0xd4, 0xc7, 0xfe, 0x3c, 0x09, 0xdd, 0xcc, 0x15, 0xd7, 0x69, 0xd9, 0x15,
+	0x9f, 0xad, 0xe5, 0x08, 0x5b, 0xab, 0x53, 0x2f, 0x9f, 0xcf, 0x4c, 0xb6, 0xcd, 0x46, 0xfd, 0xbc,
+	0xba, 0xb5, 0xa4, 0x06, 0x38, 0xd4, 0x60, 0x4f, 0xd3, 0xda, 0x70, 0x58, 0x76, 0x30, 0x8a, 0x41,
+	0xcc, 0xfe, 0xd5, 0xe1, 0x27, 0xcf, 0x67, 0x06, 0x0c, 0xe1, 0xe6, 0x91, 0xe6, 0x9a, 0x15, 0xab,
+	0x45, 0xe5, 0x57, 0x42, 0xbe, 0x79, 0x1f, 0x08, 0x35, 0x09, 0xa0, 0xcc, 0xf9, 0xb3, 0xed, 0x0d,
+	0x90, 0xd5, 0x23, 0x2f, 0x9f, 0xcf, 0x1c, 0x92, 0xf0, 0x43, 0x71, 0x6a, 0x64, 0xba, 0x14, 0x1e,
+	0x4d, 0xc2, 0x1e, 0x0e, 0x02, 0x7f, 0x8d, 0x60, 0x2c, 0xa0, 0x2d, 0xf1, 0x7c, 0x8f, 0x0b, 0x1d,
+	0xfc, 0x4c, 0x2b, 0xaf, 0x25, 0x3b, 0x89, 0x14, 0xd4, 0x53, 0x0f, 0x9f, 0xfd, 0xf3, 0xed, 0xa0,
+	0x8e, 0x97, 0xf4, 0xc0, 0x4f, 0x1c, 0xff, 0x77, 0x50, 0x97, 0xf4, 0xd5, 0xef, 0x6f, 0x51, 0xfe,
+	0x00, 0x3f, 0x42, 0x90, 0x59, 0x0b, 0x0a, 0xdc, 0xc4, 0xd3, 0xfc, 0xde, 0x51, 0x8e, 0xf5, 0xf1,
+	0x92, 0xa0, 0x4e, 0x70, 0x50, 0xf3, 0x78, 0xae, 0x2f, 0x28, 0xfc, 0x03, 0x82, 0x7d, 0xdd, 0x45,
+	0xc2, 0x0b, 0xd1, 0x43, 0xe2, 0x74, 0x8c, 0x72, 0xbc, 0xaf, 0x9f, 0x84, 0xb3, 0xc2, 0xe1, 0xbc,
+	0x89, 0xcf, 0xc5, 0xc2, 0x09, 0x15, 0x32, 0x48, 0x93, 0x7e, 0x5f, 0x5c, 0xc2, 0x07, 0x78, 0x13,
+	0xc1, 0xd1, 0x44, 0x81, 0x88, 0x4f, 0xf5, 0x41, 0x13, 0xaf, 0x59, 0x95, 0xd3, 0xdb, 0x0d, 0x93,
+	0x39, 0x19, 0x3c, 0xa7, 0xeb, 0xf8, 0xda, 0x8e, 0x73, 0xd2, 0xbf, 0xb0, 0x58, 0xad, 0x18, 0x14,
+	0x99, 0x4f, 0x10, 0x1c, 0xee, 0x29, 0xfb, 0xf0, 0x72, 0x04, 0x69, 0x3f, 0xa5, 0xa9, 0x14, 0xb6,
+	0x13, 0x22, 0x13, 0xbb, 0xc4, 0x13, 0x7b, 0x1b, 0xbf, 0x15, 0x97, 0x98, 0xc3, 0xc3, 0x8b, 0x1b,
+	0x5e, 0x7c, 0x31, 0x94, 0x65, 0x57, 0x7f, 0x3f, 0x46, 0x30, 0x11, 0xba, 0xfb, 0xb8, 0x5f, 0xbf,
+	0x6c, 0x75, 0x79, 0xbe, 0xbf, 0xa3, 0x04, 0x7b, 0x96, 0x83, 0x2d, 0xe0, 0x93, 0xdb, 0xad, 0x02,
+	0xfe, 0x1d, 0xc1, 0x81, 0x58, 0x75, 0x83, 0x97, 0x22, 0xa7, 0x27, 0xa9, 0x36, 0x45, 0x4b, 0xeb,
+	0x2e, 0x21, 0x5f, 0xe4, 0x90, 0xcf, 0xe1, 0x33, 0x71, 0x90, 0x5d, 0x1e, 0x5a, 0x4c, 0x44, 0xfe,
+	0x1d, 0x82, 0xc9, 0xb0, 0x76, 0xc0, 0xf9, 0x14, 0x12, 0x45, 0xe0, 0x3d, 0x91, 0x5a, 0xcc, 0xa8,
+	0x1a, 0x87, 0x9a, 0xc7, 0x0b, 0x09, 0x63, 0x24, 0xa0, 0x96, 0xbc, 0x59, 0x82, 0xa3, 0x5a, 0x02,
+	0x2f, 0xf6, 0x1c, 0x5a, 0x11, 0xc1, 0xa2, 0xbc, 0x9e, 0xca, 0x37, 0xcd, 0xec, 0xa5, 0x22, 0xa2,
+	0xd8, 0xf2, 0x42, 0xba, 0x08, 0xfc, 0x03, 0xc1, 0xc1, 0x78, 0x31, 0x80, 0xb5, 0x3e, 0x9d, 0x17,
+	0x12, 0x24, 0x8a, 0x9e, 0xda, 0x5f, 0x42, 0xbe, 0xc6, 0x21, 0x5f, 0xc2, 0xab, 0x3b, 0x1f, 0x1b,
+	0x0d, 0x1f, 0x6c, 0x03, 0x46, 0x84, 0x2e, 0xc0, 0xb9, 0x08, 0x8c, 0x2e, 0xc9, 0xa1, 0xcc, 0xf4,
+	0x5c, 0x97, 0xb0, 0x54, 0x0e, 0x6b, 0x1a, 0x2b, 0x71, 0xb0, 0x84, 0xe8, 0xe0, 0x37, 0x26, 0xf6,
+	0x73, 0x1e, 0x73, 0x63, 0x92, 0x74, 0x89, 0xa2, 0xa5, 0x75, 0x4f, 0x73, 0x63, 0x22, 0x4a, 0xd3,
+	0xe4, 0xc1, 0x41, 0xea, 0x56, 0xaf, 0x3f, 0xd9, 0xcc, 0xa1, 0xa7, 0x9b, 0x39, 0xf4, 0xf7, 0x66,
+	0x0e, 0x7d, 0xf3, 0x22, 0x37, 0xf0, 0xf4, 0x45, 0x6e, 0xe0, 0xaf, 0x17, 0xb9, 0x81, 0x4f, 0x0b,
+	0x55, 0x8b, 0xd5, 0x5a, 0x25, 0xad, 0xec, 0x34, 0x74, 0xf9, 0xef, 0x4c, 0xf1, 0x67, 0x89, 0x56,
+	0x6e, 0xeb, 0x77, 0xf9, 0x81, 0x27, 0x0b, 0x4b, 0xf2, 0x4c, 0xaf, 0xd7, 0x69, 0x69, 0x84, 0x4b,
+	0x92, 0x37, 0xfe, 0x1d, 0x00, 0x9f, 0x78, 0xe6, 0x07, 0x24, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ClientState queries an IBC light client.
+	ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error)
+	// ClientStates queries all the IBC light clients of a chain.
+	ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error)
+	// ConsensusState queries a consensus state associated with a client state at a given height.
+	ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error)
+	// ConsensusStateWithPredecessor queries a consensus state associated with a client state at
+	// a given height together with the consensus state stored at the nearest lower height.
+	ConsensusStateWithPredecessor(ctx context.Context, in *QueryConsensusStateWithPredecessorRequest, opts ...grpc.CallOption) (*QueryConsensusStateWithPredecessorResponse, error)
+	// OldestValidConsensusState queries the consensus state of a client with the lowest
+	// height whose timestamp is still within the trusting period of the client.
+	OldestValidConsensusState(ctx context.Context, in *QueryOldestValidConsensusStateRequest, opts ...grpc.CallOption) (*QueryOldestValidConsensusStateResponse, error)
+	// ConsensusStates queries all the consensus state associated with a given client.
+	ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error)
+	// RecentConsensusStates queries the consensus states of a client with a
+	// timestamp greater than or equal to a given minimum timestamp.
+	RecentConsensusStates(ctx context.Context, in *QueryRecentConsensusStatesRequest, opts ...grpc.CallOption) (*QueryRecentConsensusStatesResponse, error)
+	// ClientTypeCounts queries the number of IBC light clients of each client type.
+	ClientTypeCounts(ctx context.Context, in *QueryClientTypeCountsRequest, opts ...grpc.CallOption) (*QueryClientTypeCountsResponse, error)
+	// ClientStorageUsage queries the number of consensus states stored for a client
+	// and their total size in bytes.
+	ClientStorageUsage(ctx context.Context, in *QueryClientStorageUsageRequest, opts ...grpc.CallOption) (*QueryClientStorageUsageResponse, error)
+	// ConsensusStateMetadata queries the height, timestamp and root hash of a consensus
+	// state, without the rest of its data.
+	ConsensusStateMetadata(ctx context.Context, in *QueryConsensusStateMetadataRequest, opts ...grpc.CallOption) (*QueryConsensusStateMetadataResponse, error)
+	// Params queries the settings of the client submodule.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ConsensusStatesAround queries the consensus states of a client around a
+	// pivot height: up to radius states below and above it, along with the state
+	// at the pivot height if it exists.
+	ConsensusStatesAround(ctx context.Context, in *QueryConsensusStatesAroundRequest, opts ...grpc.CallOption) (*QueryConsensusStatesAroundResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ClientState(ctx context.Context, in *QueryClientStateRequest, opts ...grpc.CallOption) (*QueryClientStateResponse, error) {
+	out := new(QueryClientStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ClientState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryClientStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) ClientStates(ctx context.Context, in *QueryClientStatesRequest, opts ...grpc.CallOption) (*QueryClientStatesResponse, error) {
+	out := new(QueryClientStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ClientStates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ConsensusState(ctx context.Context, in *QueryConsensusStateRequest, opts ...grpc.CallOption) (*QueryConsensusStateResponse, error) {
+	out := new(QueryConsensusStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryClientStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ConsensusStateWithPredecessor(ctx context.Context, in *QueryConsensusStateWithPredecessorRequest, opts ...grpc.CallOption) (*QueryConsensusStateWithPredecessorResponse, error) {
+	out := new(QueryConsensusStateWithPredecessorResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusStateWithPredecessor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) OldestValidConsensusState(ctx context.Context, in *QueryOldestValidConsensusStateRequest, opts ...grpc.CallOption) (*QueryOldestValidConsensusStateResponse, error) {
+	out := new(QueryOldestValidConsensusStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/OldestValidConsensusState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStates(ctx context.Context, in *QueryConsensusStatesRequest, opts ...grpc.CallOption) (*QueryConsensusStatesResponse, error) {
+	out := new(QueryConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ProofPath)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RecentConsensusStates(ctx context.Context, in *QueryRecentConsensusStatesRequest, opts ...grpc.CallOption) (*QueryRecentConsensusStatesResponse, error) {
+	out := new(QueryRecentConsensusStatesResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/RecentConsensusStates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovQuery(uint64(m.ProofHeight))
+	return out, nil
+}
+
+func (c *queryClient) ClientTypeCounts(ctx context.Context, in *QueryClientTypeCountsRequest, opts ...grpc.CallOption) (*QueryClientTypeCountsResponse, error) {
+	out := new(QueryClientTypeCountsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ClientTypeCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryClientStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ClientStorageUsage(ctx context.Context, in *QueryClientStorageUsageRequest, opts ...grpc.CallOption) (*QueryClientStorageUsageResponse, error) {
+	out := new(QueryClientStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ClientStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStateMetadata(ctx context.Context, in *QueryConsensusStateMetadataRequest, opts ...grpc.CallOption) (*QueryConsensusStateMetadataResponse, error) {
+	out := new(QueryConsensusStateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusStateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryClientStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ClientStates) > 0 {
-		for _, e := range m.ClientStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) ConsensusStatesAround(ctx context.Context, in *QueryConsensusStatesAroundRequest, opts ...grpc.CallOption) (*QueryConsensusStatesAroundResponse, error) {
+	out := new(QueryConsensusStatesAroundResponse)
+	err := c.cc.Invoke(ctx, "/ibc.client.Query/ConsensusStatesAround", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ClientState queries an IBC light client.
+	ClientState(context.Context, *QueryClientStateRequest) (*QueryClientStateResponse, error)
+	// ClientStates queries all the IBC light clients of a chain.
+	ClientStates(context.Context, *QueryClientStatesRequest) (*QueryClientStatesResponse, error)
+	// ConsensusState queries a consensus state associated with a client state at a given height.
+	ConsensusState(context.Context, *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error)
+	// ConsensusStateWithPredecessor queries a consensus state associated with a client state at
+	// a given height together with the consensus state stored at the nearest lower height.
+	ConsensusStateWithPredecessor(context.Context, *QueryConsensusStateWithPredecessorRequest) (*QueryConsensusStateWithPredecessorResponse, error)
+	// OldestValidConsensusState queries the consensus state of a client with the lowest
+	// height whose timestamp is still within the trusting period of the client.
+	OldestValidConsensusState(context.Context, *QueryOldestValidConsensusStateRequest) (*QueryOldestValidConsensusStateResponse, error)
+	// ConsensusStates queries all the consensus state associated with a given client.
+	ConsensusStates(context.Context, *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error)
+	// RecentConsensusStates queries the consensus states of a client with a
+	// timestamp greater than or equal to a given minimum timestamp.
+	RecentConsensusStates(context.Context, *QueryRecentConsensusStatesRequest) (*QueryRecentConsensusStatesResponse, error)
+	// ClientTypeCounts queries the number of IBC light clients of each client type.
+	ClientTypeCounts(context.Context, *QueryClientTypeCountsRequest) (*QueryClientTypeCountsResponse, error)
+	// ClientStorageUsage queries the number of consensus states stored for a client
+	// and their total size in bytes.
+	ClientStorageUsage(context.Context, *QueryClientStorageUsageRequest) (*QueryClientStorageUsageResponse, error)
+	// ConsensusStateMetadata queries the height, timestamp and root hash of a consensus
+	// state, without the rest of its data.
+	ConsensusStateMetadata(context.Context, *QueryConsensusStateMetadataRequest) (*QueryConsensusStateMetadataResponse, error)
+	// Params queries the settings of the client submodule.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ConsensusStatesAround queries the consensus states of a client around a
+	// pivot height: up to radius states below and above it, along with the state
+	// at the pivot height if it exists.
+	ConsensusStatesAround(context.Context, *QueryConsensusStatesAroundRequest) (*QueryConsensusStatesAroundResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ClientState(ctx context.Context, req *QueryClientStateRequest) (*QueryClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientState not implemented")
+}
+func (*UnimplementedQueryServer) ClientStates(ctx context.Context, req *QueryClientStatesRequest) (*QueryClientStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStates not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusState(ctx context.Context, req *QueryConsensusStateRequest) (*QueryConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusState not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStateWithPredecessor(ctx context.Context, req *QueryConsensusStateWithPredecessorRequest) (*QueryConsensusStateWithPredecessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStateWithPredecessor not implemented")
+}
+func (*UnimplementedQueryServer) OldestValidConsensusState(ctx context.Context, req *QueryOldestValidConsensusStateRequest) (*QueryOldestValidConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OldestValidConsensusState not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStates(ctx context.Context, req *QueryConsensusStatesRequest) (*QueryConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStates not implemented")
+}
+func (*UnimplementedQueryServer) RecentConsensusStates(ctx context.Context, req *QueryRecentConsensusStatesRequest) (*QueryRecentConsensusStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentConsensusStates not implemented")
+}
+func (*UnimplementedQueryServer) ClientTypeCounts(ctx context.Context, req *QueryClientTypeCountsRequest) (*QueryClientTypeCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientTypeCounts not implemented")
+}
+func (*UnimplementedQueryServer) ClientStorageUsage(ctx context.Context, req *QueryClientStorageUsageRequest) (*QueryClientStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientStorageUsage not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStateMetadata(ctx context.Context, req *QueryConsensusStateMetadataRequest) (*QueryConsensusStateMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStateMetadata not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ConsensusStatesAround(ctx context.Context, req *QueryConsensusStatesAroundRequest) (*QueryConsensusStatesAroundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStatesAround not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ClientState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientState(ctx, req.(*QueryClientStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryConsensusStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClientStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ClientStates(ctx, in)
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ClientStates",
 	}
-	if m.LatestHeight {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientStates(ctx, req.(*QueryClientStatesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryConsensusStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ConsensusState != nil {
-		l = m.ConsensusState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusState(ctx, in)
 	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ConsensusState",
 	}
-	l = len(m.ProofPath)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusState(ctx, req.(*QueryConsensusStateRequest))
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovQuery(uint64(m.ProofHeight))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStateWithPredecessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateWithPredecessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStateWithPredecessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ConsensusStateWithPredecessor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStateWithPredecessor(ctx, req.(*QueryConsensusStateWithPredecessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryConsensusStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_OldestValidConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOldestValidConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).OldestValidConsensusState(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/OldestValidConsensusState",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OldestValidConsensusState(ctx, req.(*QueryOldestValidConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryConsensusStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ConsensusStates) > 0 {
-		for _, e := range m.ConsensusStates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStates(ctx, req.(*QueryConsensusStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecentConsensusStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentConsensusStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecentConsensusStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/RecentConsensusStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecentConsensusStates(ctx, req.(*QueryRecentConsensusStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientTypeCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientTypeCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientTypeCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ClientTypeCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientTypeCounts(ctx, req.(*QueryClientTypeCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ClientStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientStorageUsage(ctx, req.(*QueryClientStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStateMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ConsensusStateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStateMetadata(ctx, req.(*QueryConsensusStateMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConsensusStatesAround_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStatesAroundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStatesAround(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.client.Query/ConsensusStatesAround",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStatesAround(ctx, req.(*QueryConsensusStatesAroundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.client.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClientState",
+			Handler:    _Query_ClientState_Handler,
+		},
+		{
+			MethodName: "ClientStates",
+			Handler:    _Query_ClientStates_Handler,
+		},
+		{
+			MethodName: "ConsensusState",
+			Handler:    _Query_ConsensusState_Handler,
+		},
+		{
+			MethodName: "ConsensusStateWithPredecessor",
+			Handler:    _Query_ConsensusStateWithPredecessor_Handler,
+		},
+		{
+			MethodName: "OldestValidConsensusState",
+			Handler:    _Query_OldestValidConsensusState_Handler,
+		},
+		{
+			MethodName: "ConsensusStates",
+			Handler:    _Query_ConsensusStates_Handler,
+		},
+		{
+			MethodName: "RecentConsensusStates",
+			Handler:    _Query_RecentConsensusStates_Handler,
+		},
+		{
+			MethodName: "ClientTypeCounts",
+			Handler:    _Query_ClientTypeCounts_Handler,
+		},
+		{
+			MethodName: "ClientStorageUsage",
+			Handler:    _Query_ClientStorageUsage_Handler,
+		},
+		{
+			MethodName: "ConsensusStateMetadata",
+			Handler:    _Query_ConsensusStateMetadata_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ConsensusStatesAround",
+			Handler:    _Query_ConsensusStatesAround_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/client/query.proto",
+}
+
+func (m *QueryClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProofPath) > 0 {
+		i -= len(m.ProofPath)
+		copy(dAtA[i:], m.ProofPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderByHeight {
+		i--
+		if m.OrderByHeight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientStates) > 0 {
+		for iNdEx := len(m.ClientStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestHeight {
+		i--
+		if m.LatestHeight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProofPath) > 0 {
+		i -= len(m.ProofPath)
+		copy(dAtA[i:], m.ProofPath)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofPath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateWithPredecessorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateWithPredecessorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateWithPredecessorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Predecessor != nil {
+		{
+			size, err := m.Predecessor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestValidConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestValidConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestValidConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOldestValidConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOldestValidConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOldestValidConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusStates) > 0 {
+		for iNdEx := len(m.ConsensusStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentConsensusStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentConsensusStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentConsensusStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentConsensusStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentConsensusStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentConsensusStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusStates) > 0 {
+		for iNdEx := len(m.ConsensusStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientTypeCountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientTypeCountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientTypeCountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientTypeCountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientTypeCountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientTypeCountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientTypeCounts) > 0 {
+		for k := range m.ClientTypeCounts {
+			v := m.ClientTypeCounts[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStorageUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStorageUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStorageUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientStorageUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientStorageUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientStorageUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsensusStatesSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusStatesSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsensusStateCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusStateCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatesAroundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatesAroundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatesAroundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Radius != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Radius))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Pivot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatesAroundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatesAroundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatesAroundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusStates) > 0 {
+		for iNdEx := len(m.ConsensusStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProofPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *QueryClientStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderByHeight {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClientStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientStates) > 0 {
+		for _, e := range m.ClientStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.LatestHeight {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsensusStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProofPath)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateWithPredecessorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateWithPredecessorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Predecessor != nil {
+		l = m.Predecessor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *QueryOldestValidConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOldestValidConsensusStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProofHeight))
+	}
+	return n
+}
+
+func (m *QueryConsensusStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsensusStates) > 0 {
+		for _, e := range m.ConsensusStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecentConsensusStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.MinTimestamp))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecentConsensusStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsensusStates) > 0 {
+		for _, e := range m.ConsensusStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientTypeCountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryClientTypeCountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClientTypeCounts) > 0 {
+		for k, v := range m.ClientTypeCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryClientStorageUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientStorageUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusStateCount != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusStateCount))
+	}
+	if m.ConsensusStatesSize != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusStatesSize))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConsensusStateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsensusStatesAroundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Pivot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Radius != 0 {
+		n += 1 + sovQuery(uint64(m.Radius))
+	}
+	return n
+}
+
+func (m *QueryConsensusStatesAroundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsensusStates) > 0 {
+		for _, e := range m.ConsensusStates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderByHeight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OrderByHeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStates = append(m.ClientStates, &IdentifiedClientState{})
+			if err := m.ClientStates[len(m.ClientStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestHeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateWithPredecessorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateWithPredecessorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateWithPredecessorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStateWithPredecessorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStateWithPredecessorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStateWithPredecessorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Predecessor == nil {
+				m.Predecessor = &types.Any{}
+			}
+			if err := m.Predecessor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOldestValidConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOldestValidConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOldestValidConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOldestValidConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOldestValidConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOldestValidConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, &types.Any{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentConsensusStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimestamp", wireType)
+			}
+			m.MinTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecentConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecentConsensusStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecentConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusStates = append(m.ConsensusStates, &types.Any{})
+			if err := m.ConsensusStates[len(m.ConsensusStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryClientStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientTypeCountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1305,44 +5190,12 @@ func (m *QueryClientStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientTypeCountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientTypeCountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1367,7 +5220,7 @@ func (m *QueryClientStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClientTypeCountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1390,15 +5243,15 @@ func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientTypeCountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientTypeCountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTypeCounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1425,50 +5278,146 @@ func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if m.ClientTypeCounts == nil {
+				m.ClientTypeCounts = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if byteLen < 0 {
+			m.ClientTypeCounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientStorageUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientStorageUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientStorageUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1496,27 +5445,8 @@ func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofPath = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			m.ProofHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1541,7 +5471,7 @@ func (m *QueryClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientStorageUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1564,17 +5494,17 @@ func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientStorageUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientStorageUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStateCount", wireType)
 			}
-			var msglen int
+			m.ConsensusStateCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1584,28 +5514,30 @@ func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConsensusStateCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusStatesSize", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConsensusStatesSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusStatesSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1630,7 +5562,7 @@ func (m *QueryClientStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1653,17 +5585,17 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClientStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStateMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClientStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1673,62 +5605,43 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientStates = append(m.ClientStates, &IdentifiedClientState{})
-			if err := m.ClientStates[len(m.ClientStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1753,7 +5666,7 @@ func (m *QueryClientStatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStateMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1776,17 +5689,17 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStateMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1796,29 +5709,16 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Height = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1828,16 +5728,16 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Timestamp |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1847,12 +5747,26 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LatestHeight = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1877,7 +5791,7 @@ func (m *QueryConsensusStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,87 +5814,70 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1990,43 +5887,28 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			if m.Params == nil {
+				m.Params = &Params{}
 			}
-			m.ProofHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2051,7 +5933,7 @@ func (m *QueryConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStatesAroundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,10 +5956,10 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStatesAroundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStatesAroundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2114,7 +5996,7 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pivot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2141,13 +6023,29 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pivot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Radius", wireType)
+			}
+			m.Radius = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Radius |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2172,7 +6070,7 @@ func (m *QueryConsensusStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsensusStatesAroundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,10 +6093,10 @@ func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsensusStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsensusStatesAroundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsensusStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsensusStatesAroundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2235,42 +6133,6 @@ func (m *QueryConsensusStatesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+// VerificationError is an error returned by a failed state verification of a
+// light client. It is categorized by one of the ErrClientFrozen,
+// ErrConsensusStateNotFound, ErrProofVerificationFailed or the commitment
+// ErrRootMismatch sentinel errors, so that callers can branch on the failure
+// with errors.Is while the underlying error remains matchable as well.
+type VerificationError struct {
+	Category *sdkerrors.Error
+	Err      error
+}
+
+// WrapVerificationError categorizes an error returned by a state verification,
+// as described in VerificationError. Errors that are neither due to a frozen
+// client, a missing consensus state nor a proof for another root are categorized
+// as ErrProofVerificationFailed. It returns nil if the error is nil.
+func WrapVerificationError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var verificationErr *VerificationError
+	if errors.As(err, &verificationErr) {
+		return err
+	}
+
+	category := ErrProofVerificationFailed
+	switch {
+	case errors.Is(err, ErrClientFrozen):
+		category = ErrClientFrozen
+	case errors.Is(err, ErrConsensusStateNotFound):
+		category = ErrConsensusStateNotFound
+	case errors.Is(err, commitmenttypes.ErrRootMismatch):
+		category = commitmenttypes.ErrRootMismatch
+	}
+
+	return &VerificationError{Category: category, Err: err}
+}
+
+// Error implements the error interface.
+func (e *VerificationError) Error() string {
+	if errors.Is(e.Err, e.Category) {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.Category, e.Err)
+}
+
+// Is reports whether the category of the error matches the target. The
+// underlying error is matched through Unwrap.
+func (e *VerificationError) Is(target error) bool {
+	return e.Category.Is(target)
+}
+
+// Unwrap returns the underlying error.
+func (e *VerificationError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the underlying error.
+func (e *VerificationError) Cause() error {
+	return e.Err
+}
+
+// ABCICode returns the ABCI code of the category of the error.
+func (e *VerificationError) ABCICode() uint32 {
+	return e.Category.ABCICode()
+}
+
+// Codespace returns the codespace of the category of the error.
+func (e *VerificationError) Codespace() string {
+	return e.Category.Codespace()
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+func TestWrapVerificationError(t *testing.T) {
+	require.NoError(t, types.WrapVerificationError(nil))
+
+	testCases := []struct {
+		name        string
+		err         error
+		expCategory error
+	}{
+		{"frozen client", types.ErrClientFrozen, types.ErrClientFrozen},
+		{"wrapped frozen client", sdkerrors.Wrap(types.ErrClientFrozen, "client"), types.ErrClientFrozen},
+		{"consensus state not found", sdkerrors.Wrap(types.ErrConsensusStateNotFound, "height 10"), types.ErrConsensusStateNotFound},
+		{"root mismatch", sdkerrors.Wrap(commitmenttypes.ErrRootMismatch, "root"), commitmenttypes.ErrRootMismatch},
+		{"invalid proof", sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof"), types.ErrProofVerificationFailed},
+		{"key present", commitmenttypes.ErrKeyPresent, types.ErrProofVerificationFailed},
+		{"other error", errors.New("other error"), types.ErrProofVerificationFailed},
+	}
+
+	for _, tc := range testCases {
+		err := types.WrapVerificationError(tc.err)
+
+		require.True(t, errors.Is(err, tc.expCategory), tc.name)
+		require.True(t, errors.Is(err, tc.err), tc.name)
+		require.Contains(t, err.Error(), tc.err.Error(), tc.name)
+
+		// the category is preserved through further wrapping and in the ABCI code
+		wrapped := sdkerrors.Wrap(err, "verification")
+		require.True(t, errors.Is(wrapped, tc.expCategory), tc.name)
+
+		codespace, code, _ := sdkerrors.ABCIInfo(wrapped, false)
+		require.Equal(t, tc.expCategory.(*sdkerrors.Error).Codespace(), codespace, tc.name)
+		require.Equal(t, tc.expCategory.(*sdkerrors.Error).ABCICode(), code, tc.name)
+
+		// categorizing twice is a no-op
+		require.Equal(t, wrapped, types.WrapVerificationError(wrapped), tc.name)
+	}
+
+	// a frozen client error isn't repeated in the message
+	require.Equal(t, types.ErrClientFrozen.Error(), types.WrapVerificationError(types.ErrClientFrozen).Error())
+}
@@ -20,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryConnections(),
 		GetCmdQueryConnection(),
 		GetCmdQueryClientConnections(),
+		GetCmdQueryConnectionConsensusState(),
 	)
 
 	return queryCmd
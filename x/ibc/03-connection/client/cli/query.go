@@ -123,3 +123,40 @@ func GetCmdQueryClientConnections() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryConnectionConsensusState defines the command to query the client
+// consensus state against which a connection was verified during its handshake.
+func GetCmdQueryConnectionConsensusState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-state [connection-id]",
+		Short: "Query the consensus state a connection was verified against",
+		Long: `Query the client consensus state, and its height, against which the counterparty state
+of a connection was last verified during the connection handshake.`,
+		Example: fmt.Sprintf("%s query %s %s consensus-state [connection-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryConnectionVerifiedConsensusStateRequest{
+				ConnectionId: args[0],
+			}
+
+			res, err := queryClient.ConnectionVerifiedConsensusState(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(res.ProofHeight))
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,12 +16,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, connPaths := range gs.ClientConnectionPaths {
 		k.SetClientConnectionPaths(ctx, connPaths.ClientId, connPaths.Paths)
 	}
+	for _, verifiedHeight := range gs.ConnectionVerifiedHeights {
+		k.SetConnectionVerifiedHeight(ctx, verifiedHeight.ConnectionId, verifiedHeight.Height)
+	}
 }
 
 // ExportGenesis returns the ibc connection submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Connections:           k.GetAllConnections(ctx),
-		ClientConnectionPaths: k.GetAllClientConnectionPaths(ctx),
+		Connections:               k.GetAllConnections(ctx),
+		ClientConnectionPaths:     k.GetAllClientConnectionPaths(ctx),
+		ConnectionVerifiedHeights: k.GetAllConnectionVerifiedHeights(ctx),
 	}
 }
@@ -174,3 +174,52 @@ func (q Keeper) ConnectionConsensusState(c context.Context, req *types.QueryConn
 
 	return types.NewQueryConnectionConsensusStateResponse(connection.ClientId, anyConsensusState, consensusState.GetHeight(), nil, ctx.BlockHeight()), nil
 }
+
+// ConnectionVerifiedConsensusState implements the Query/ConnectionVerifiedConsensusState gRPC method
+func (q Keeper) ConnectionVerifiedConsensusState(c context.Context, req *types.QueryConnectionVerifiedConsensusStateRequest) (*types.QueryConnectionVerifiedConsensusStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	connection, found := q.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConnectionNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	height, found := q.GetConnectionVerifiedHeight(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrConnectionNotFound, "no verified height recorded for connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	consensusState, found := q.clientKeeper.GetClientConsensusState(ctx, connection.ClientId, height)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "client-id: %s, height: %d", connection.ClientId, height).Error(),
+		)
+	}
+
+	anyConsensusState, err := clienttypes.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConnectionVerifiedConsensusStateResponse{
+		ConsensusState: anyConsensusState,
+		ClientId:       connection.ClientId,
+		VerifiedHeight: height,
+		ProofHeight:    uint64(ctx.BlockHeight()),
+	}, nil
+}
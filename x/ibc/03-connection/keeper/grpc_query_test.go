@@ -406,3 +406,126 @@ func (suite *KeeperTestSuite) TestQueryConnectionConsensusState() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryConnectionVerifiedConsensusState() {
+	var (
+		req               *types.QueryConnectionVerifiedConsensusStateRequest
+		expConsensusState exported.ConsensusState
+		expClientID       string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection ID",
+			func() {
+				req = &types.QueryConnectionVerifiedConsensusStateRequest{
+					ConnectionId: "",
+				}
+			},
+			false,
+		},
+		{
+			"connection not found",
+			func() {
+				req = &types.QueryConnectionVerifiedConsensusStateRequest{
+					ConnectionId: "test-connection-id",
+				}
+			},
+			false,
+		},
+		{
+			"verified height not recorded",
+			func() {
+				_, _, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+				suite.chainA.App.IBCKeeper.ConnectionKeeper.SetConnection(suite.chainA.GetContext(), "connectionid", suite.chainA.GetConnection(connA))
+
+				req = &types.QueryConnectionVerifiedConsensusStateRequest{
+					ConnectionId: "connectionid",
+				}
+			},
+			false,
+		},
+		{
+			"consensus state not found",
+			func() {
+				_, _, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+				suite.chainA.App.IBCKeeper.ConnectionKeeper.SetConnectionVerifiedHeight(suite.chainA.GetContext(), connA.ID, 1000)
+
+				req = &types.QueryConnectionVerifiedConsensusStateRequest{
+					ConnectionId: connA.ID,
+				}
+			},
+			false,
+		},
+		{
+			"success with seeded verified height",
+			func() {
+				clientA, _, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+				clientState := suite.chainA.GetClientState(clientA)
+				suite.chainA.App.IBCKeeper.ConnectionKeeper.SetConnectionVerifiedHeight(suite.chainA.GetContext(), connA.ID, clientState.GetLatestHeight())
+
+				expConsensusState, _ = suite.chainA.GetConsensusState(clientA, clientState.GetLatestHeight())
+				suite.Require().NotNil(expConsensusState)
+				expClientID = clientA
+
+				req = &types.QueryConnectionVerifiedConsensusStateRequest{
+					ConnectionId: connA.ID,
+				}
+			},
+			true,
+		},
+		{
+			"success with height recorded during the handshake",
+			func() {
+				clientA, _, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+				height, found := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetConnectionVerifiedHeight(suite.chainA.GetContext(), connA.ID)
+				suite.Require().True(found)
+
+				expConsensusState, _ = suite.chainA.GetConsensusState(clientA, height)
+				suite.Require().NotNil(expConsensusState)
+				expClientID = clientA
+
+				req = &types.QueryConnectionVerifiedConsensusStateRequest{
+					ConnectionId: connA.ID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ConnectionVerifiedConsensusState(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				consensusState, err := clienttypes.UnpackConsensusState(res.ConsensusState)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expConsensusState, consensusState)
+				suite.Require().Equal(expConsensusState.GetHeight(), res.VerifiedHeight)
+				suite.Require().Equal(expClientID, res.ClientId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
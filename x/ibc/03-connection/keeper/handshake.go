@@ -130,6 +130,7 @@ func (k Keeper) ConnOpenTry(
 	}
 
 	k.SetConnection(ctx, connectionID, connection)
+	k.SetConnectionVerifiedHeight(ctx, connectionID, proofHeight)
 	k.Logger(ctx).Info(fmt.Sprintf("connection %s state updated: %s -> TRYOPEN ", connectionID, previousConnection.State))
 	return nil
 }
@@ -231,6 +232,7 @@ func (k Keeper) ConnOpenAck(
 	connection.State = types.OPEN
 	connection.Versions = []string{encodedVersion}
 	k.SetConnection(ctx, connectionID, connection)
+	k.SetConnectionVerifiedHeight(ctx, connectionID, proofHeight)
 	return nil
 }
 
@@ -273,6 +275,7 @@ func (k Keeper) ConnOpenConfirm(
 	// Update ChainB's connection to Open
 	connection.State = types.OPEN
 	k.SetConnection(ctx, connectionID, connection)
+	k.SetConnectionVerifiedHeight(ctx, connectionID, proofHeight)
 	k.Logger(ctx).Info(fmt.Sprintf("connection %s state updated: TRYOPEN -> OPEN ", connectionID))
 	return nil
 }
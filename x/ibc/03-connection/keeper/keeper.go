@@ -66,6 +66,43 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 	store.Set(host.KeyConnection(connectionID), bz)
 }
 
+// GetConnectionVerifiedHeight returns the height of the client consensus state
+// against which the counterparty state of a connection was last verified during
+// the connection handshake
+func (k Keeper) GetConnectionVerifiedHeight(ctx sdk.Context, connectionID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.KeyConnectionVerifiedHeight(connectionID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetConnectionVerifiedHeight sets the height of the client consensus state against
+// which the counterparty state of a connection was verified
+func (k Keeper) SetConnectionVerifiedHeight(ctx sdk.Context, connectionID string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.KeyConnectionVerifiedHeight(connectionID), sdk.Uint64ToBigEndian(height))
+}
+
+// GetAllConnectionVerifiedHeights returns the verified heights of all the
+// connections whose counterparty state was verified during the handshake.
+func (k Keeper) GetAllConnectionVerifiedHeights(ctx sdk.Context) []types.ConnectionVerifiedHeight {
+	var verifiedHeights []types.ConnectionVerifiedHeight
+	k.IterateConnections(ctx, func(connection types.IdentifiedConnection) bool {
+		height, found := k.GetConnectionVerifiedHeight(ctx, connection.Id)
+		if !found {
+			// continue when the counterparty state wasn't verified yet
+			return false
+		}
+		verifiedHeights = append(verifiedHeights, types.NewConnectionVerifiedHeight(connection.Id, height))
+		return false
+	})
+
+	return verifiedHeights
+}
+
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
 // given height.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height uint64) (uint64, error) {
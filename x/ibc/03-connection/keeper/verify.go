@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
@@ -27,9 +31,11 @@ func (k Keeper) VerifyClientState(
 		return sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "clientID: %s with height: %d", clientID, height)
 	}
 
-	if err := targetClient.VerifyClientState(
-		k.clientKeeper.ClientStore(ctx, clientID), k.cdc, targetConsState.GetRoot(), height,
-		connection.GetCounterparty().GetPrefix(), connection.GetCounterparty().GetClientID(), proof, clientState); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return targetClient.VerifyClientState(
+			k.clientKeeper.ClientStore(ctx, clientID), k.proofCodec(ctx), targetConsState.GetRoot(), height,
+			connection.GetCounterparty().GetPrefix(), connection.GetCounterparty().GetClientID(), proof, clientState)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed client state verification for target client: %s", connection.GetClientID())
 	}
 
@@ -57,10 +63,12 @@ func (k Keeper) VerifyClientConsensusState(
 		return sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "clientID: %s with height: %d", clientID, height)
 	}
 
-	if err := clientState.VerifyClientConsensusState(
-		k.clientKeeper.ClientStore(ctx, clientID), k.cdc, targetConsState.GetRoot(), height,
-		connection.GetCounterparty().GetClientID(), consensusHeight, connection.GetCounterparty().GetPrefix(), proof, consensusState,
-	); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyClientConsensusState(
+			k.clientKeeper.ClientStore(ctx, clientID), k.proofCodec(ctx), targetConsState.GetRoot(), height,
+			connection.GetCounterparty().GetClientID(), consensusHeight, connection.GetCounterparty().GetPrefix(), proof, consensusState,
+		)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed consensus state verification for client (%s)", connection.GetClientID())
 	}
 
@@ -82,10 +90,12 @@ func (k Keeper) VerifyConnectionState(
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID())
 	}
 
-	if err := clientState.VerifyConnectionState(
-		k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof, connectionID, connectionEnd,
-	); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyConnectionState(
+			k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.proofCodec(ctx), height,
+			connection.GetCounterparty().GetPrefix(), proof, connectionID, connectionEnd,
+		)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed connection state verification for client (%s)", connection.GetClientID())
 	}
 
@@ -108,11 +118,13 @@ func (k Keeper) VerifyChannelState(
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID())
 	}
 
-	if err := clientState.VerifyChannelState(
-		k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof,
-		portID, channelID, channel,
-	); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyChannelState(
+			k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.proofCodec(ctx), height,
+			connection.GetCounterparty().GetPrefix(), proof,
+			portID, channelID, channel,
+		)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed channel state verification for client (%s)", connection.GetClientID())
 	}
 
@@ -136,11 +148,13 @@ func (k Keeper) VerifyPacketCommitment(
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID())
 	}
 
-	if err := clientState.VerifyPacketCommitment(
-		k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		sequence, commitmentBytes,
-	); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyPacketCommitment(
+			k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.proofCodec(ctx), height,
+			connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+			sequence, commitmentBytes,
+		)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed packet commitment verification for client (%s)", connection.GetClientID())
 	}
 
@@ -164,11 +178,13 @@ func (k Keeper) VerifyPacketAcknowledgement(
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID())
 	}
 
-	if err := clientState.VerifyPacketAcknowledgement(
-		k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		sequence, acknowledgement,
-	); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyPacketAcknowledgement(
+			k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.proofCodec(ctx), height,
+			connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+			sequence, acknowledgement,
+		)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed packet acknowledgement verification for client (%s)", connection.GetClientID())
 	}
 
@@ -192,12 +208,17 @@ func (k Keeper) VerifyPacketAcknowledgementAbsence(
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID())
 	}
 
-	if err := clientState.VerifyPacketAcknowledgementAbsence(
-		k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		sequence,
-	); err != nil {
-		return sdkerrors.Wrapf(err, "failed packet acknowledgement absence verification for client (%s)", connection.GetClientID())
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyPacketAcknowledgementAbsence(
+			k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.proofCodec(ctx), height,
+			connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+			sequence,
+		)
+	}); err != nil {
+		return sdkerrors.Wrapf(
+			err, "failed packet acknowledgement absence verification for client (%s) at key %s/%s", connection.GetClientID(),
+			connection.GetCounterparty().GetPrefix().Bytes(), host.PacketAcknowledgementPath(portID, channelID, sequence),
+		)
 	}
 
 	return nil
@@ -219,13 +240,124 @@ func (k Keeper) VerifyNextSequenceRecv(
 		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, connection.GetClientID())
 	}
 
-	if err := clientState.VerifyNextSequenceRecv(
-		k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		nextSequenceRecv,
-	); err != nil {
+	if err := verifyWithContext(ctx, func(ctx sdk.Context) error {
+		return clientState.VerifyNextSequenceRecv(
+			k.clientKeeper.ClientStore(ctx, connection.GetClientID()), k.proofCodec(ctx), height,
+			connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+			nextSequenceRecv,
+		)
+	}); err != nil {
 		return sdkerrors.Wrapf(err, "failed next sequence receive verification for client (%s)", connection.GetClientID())
 	}
 
 	return nil
 }
+
+// GetVerificationHeight returns the epoch-aware height of the consensus state of
+// the client of the connection which proofs at the given height are verified
+// against. If no consensus state is stored at that height, the height of the
+// nearest consensus state below it is returned, which proofs must then be
+// queried at. It returns an error if the proofs can't be verified, either because
+// the client is frozen at that height or because no consensus state is stored at
+// or below it.
+func (k Keeper) GetVerificationHeight(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height uint64,
+) (clienttypes.Height, error) {
+	clientID := connection.GetClientID()
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return clienttypes.Height{}, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if err := clienttypes.ValidateClientNotFrozen(clientState, height); err != nil {
+		return clienttypes.Height{}, sdkerrors.Wrapf(err, "client (%s)", clientID)
+	}
+
+	if consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height); found {
+		return clienttypes.ConsensusStateEpochHeight(consensusState, height), nil
+	}
+
+	// the requested height is in the epoch of the latest consensus state of the client
+	epoch := uint64(0)
+	if latest, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight()); found {
+		epoch = clienttypes.ConsensusStateEpochHeight(latest, clientState.GetLatestHeight()).EpochNumber
+	}
+
+	var (
+		verificationHeight clienttypes.Height
+		fallback           bool
+	)
+	k.clientKeeper.IterateConsensusStatesReverse(
+		ctx, clientID, clienttypes.NewHeight(epoch, height),
+		func(h clienttypes.Height, _ exported.ConsensusState) bool {
+			verificationHeight, fallback = h, true
+			return true
+		},
+	)
+
+	if !fallback {
+		return clienttypes.Height{}, sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "clientID: %s at or below height: %d", clientID, height)
+	}
+
+	return verificationHeight, nil
+}
+
+// verifyWithContext runs the given proof verification and returns a timeout
+// error if the context is cancelled or its deadline exceeded before it completes.
+// Failed verifications are categorized with WrapVerificationError so that callers
+// can branch on the failure with errors.Is.
+// Query handlers can bound the verification time by setting a deadline on the
+// context. The verification then runs on its own goroutine, against a branch of
+// the context with a separate gas meter, so that the stores and the gas meter of
+// the caller are never accessed once it returns. The gas consumed is charged to
+// the caller when the verification completes in time. Contexts which can't be
+// cancelled, as during block execution, run the verification on the calling
+// goroutine, unbounded.
+func verifyWithContext(ctx sdk.Context, verify func(ctx sdk.Context) error) error {
+	goCtx := ctx.Context()
+	if goCtx == nil || goCtx.Done() == nil {
+		return clienttypes.WrapVerificationError(verify(ctx))
+	}
+
+	if err := goCtx.Err(); err != nil {
+		return sdkerrors.Wrap(types.ErrVerificationTimeout, err.Error())
+	}
+
+	gasMeter := sdk.NewInfiniteGasMeter()
+	verifyCtx, _ := ctx.CacheContext()
+	verifyCtx = verifyCtx.WithGasMeter(gasMeter)
+
+	// buffered so that the goroutine doesn't leak when the caller has returned
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() {
+			if r := recover(); r != nil {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+			done <- err
+		}()
+
+		err = clienttypes.WrapVerificationError(verify(verifyCtx))
+	}()
+
+	select {
+	case err := <-done:
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumed(), "proof verification")
+		return err
+	case <-goCtx.Done():
+		return sdkerrors.Wrap(types.ErrVerificationTimeout, goCtx.Err().Error())
+	}
+}
+
+// proofCodec returns the codec passed to the light client verification. It
+// decodes legacy proofs if they are allowed by the client parameters.
+func (k Keeper) proofCodec(ctx sdk.Context) codec.BinaryMarshaler {
+	if !k.clientKeeper.IsLegacyProofAllowed(ctx) {
+		return k.cdc
+	}
+
+	return commitmenttypes.NewLegacyProofCodec(k.cdc)
+}
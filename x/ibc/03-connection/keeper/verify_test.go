@@ -1,12 +1,20 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientkeeper "github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/keeper"
 	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
@@ -63,6 +71,118 @@ func (suite *KeeperTestSuite) TestVerifyClientState() {
 	}
 }
 
+// TestVerifyClientStateWithDeadline verifies that a client state verification
+// returns a timeout error once the deadline of the context is exceeded or the
+// context is cancelled.
+func (suite *KeeperTestSuite) TestVerifyClientStateWithDeadline() {
+	cases := []struct {
+		msg        string
+		newCtx     func() (context.Context, context.CancelFunc)
+		expPass    bool
+		expTimeout bool
+	}{
+		{"no deadline", func() (context.Context, context.CancelFunc) {
+			return context.WithCancel(context.Background())
+		}, true, false},
+		{"deadline not exceeded", func() (context.Context, context.CancelFunc) {
+			return context.WithTimeout(context.Background(), time.Minute)
+		}, true, false},
+		{"deadline exceeded", func() (context.Context, context.CancelFunc) {
+			return context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		}, false, true},
+		{"context cancelled", func() (context.Context, context.CancelFunc) {
+			goCtx, cancel := context.WithCancel(context.Background())
+			cancel()
+			return goCtx, cancel
+		}, false, true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			_, clientB, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+			counterpartyClient, clientProof := suite.chainB.QueryClientStateProof(clientB)
+			proofHeight := uint64(suite.chainB.GetContext().BlockHeight() - 1)
+			connection := suite.chainA.GetConnection(connA)
+
+			goCtx, cancel := tc.newCtx()
+			defer cancel()
+
+			err := suite.chainA.App.IBCKeeper.ConnectionKeeper.VerifyClientState(
+				suite.chainA.GetContext().WithContext(goCtx), connection,
+				proofHeight, clientProof, counterpartyClient,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+			suite.Require().Equal(tc.expTimeout, errors.Is(err, types.ErrVerificationTimeout))
+		})
+	}
+}
+
+// slowStore is a KVStore whose reads are delayed, to simulate an expensive proof
+// verification.
+type slowStore struct {
+	sdk.KVStore
+
+	delay time.Duration
+}
+
+func (s slowStore) Get(key []byte) []byte {
+	time.Sleep(s.delay)
+	return s.KVStore.Get(key)
+}
+
+// slowClientKeeper is a client keeper whose client stores are slowStores.
+type slowClientKeeper struct {
+	clientkeeper.Keeper
+
+	delay time.Duration
+}
+
+func (k slowClientKeeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	return slowStore{KVStore: k.Keeper.ClientStore(ctx, clientID), delay: k.delay}
+}
+
+// TestVerifyClientStateInterrupted verifies that a deadline interrupts a slow
+// client state verification instead of waiting for it to complete.
+func (suite *KeeperTestSuite) TestVerifyClientStateInterrupted() {
+	_, clientB, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+	counterpartyClient, clientProof := suite.chainB.QueryClientStateProof(clientB)
+	proofHeight := uint64(suite.chainB.GetContext().BlockHeight() - 1)
+	connection := suite.chainA.GetConnection(connA)
+
+	delay := 500 * time.Millisecond
+	app := suite.chainA.App
+	connectionKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(host.StoreKey), slowClientKeeper{Keeper: app.IBCKeeper.ClientKeeper, delay: delay},
+	)
+
+	// the verification completes without a deadline
+	err := connectionKeeper.VerifyClientState(
+		suite.chainA.GetContext(), connection, proofHeight, clientProof, counterpartyClient,
+	)
+	suite.Require().NoError(err)
+
+	goCtx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = connectionKeeper.VerifyClientState(
+		suite.chainA.GetContext().WithContext(goCtx), connection, proofHeight, clientProof, counterpartyClient,
+	)
+	suite.Require().True(errors.Is(err, types.ErrVerificationTimeout), err)
+	suite.Require().Less(int64(time.Since(start)), int64(delay))
+}
+
 // TestVerifyClientConsensusState verifies that the consensus state of
 // chainA stored on clientB (which is on chainB) matches the consensus
 // state for chainA at that height.
@@ -81,6 +201,13 @@ func (suite *KeeperTestSuite) TestVerifyClientConsensusState() {
 		{"verification success", func() {
 			_, _, connA, connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
 		}, true},
+		{"verification success with compressed consensus state", func() {
+			_, _, connA, connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+			suite.storeCompressedConsensusState(suite.chainB, connB.ClientID, nil)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, connA.ClientID, exported.Tendermint))
+		}, true},
 		{"client state not found", func() {
 			_, _, connA, connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
 
@@ -107,6 +234,17 @@ func (suite *KeeperTestSuite) TestVerifyClientConsensusState() {
 
 			suite.coordinator.CommitBlock(suite.chainB)
 		}, false},
+		{"verification failed with compressed consensus state", func() {
+			_, _, connA, connB = suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+			// give chainB wrong consensus state for chainA, stored compressed
+			suite.storeCompressedConsensusState(suite.chainB, connB.ClientID, func(consState *ibctmtypes.ConsensusState) {
+				consState.Timestamp = time.Now()
+			})
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, connA.ClientID, exported.Tendermint))
+		}, false},
 	}
 
 	for _, tc := range cases {
@@ -194,6 +332,181 @@ func (suite *KeeperTestSuite) TestVerifyConnectionState() {
 	}
 }
 
+// TestVerifyConnectionStateFrozenClient verifies that proofs at or above the
+// frozen height of the client are refused while lower proofs are still verified.
+func (suite *KeeperTestSuite) TestVerifyConnectionStateFrozenClient() {
+	cases := []struct {
+		msg              string
+		frozenHeightDiff int64
+		expPass          bool
+	}{
+		{"proof height below the frozen height", 1, true},
+		{"proof height at the frozen height", 0, false},
+		{"proof height above the frozen height", -1, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			clientA, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+			connection := suite.chainA.GetConnection(connA)
+			expectedConnection := suite.chainB.GetConnection(connB)
+
+			connectionKey := host.KeyConnection(connB.ID)
+			proof, proofHeight := suite.chainB.QueryProof(connectionKey)
+
+			clientState, ok := suite.chainA.GetClientState(clientA).(*ibctmtypes.ClientState)
+			suite.Require().True(ok)
+
+			clientState.FrozenHeight = clienttypes.NewHeight(0, uint64(int64(proofHeight)+tc.frozenHeightDiff))
+			suite.chainA.App.IBCKeeper.ClientKeeper.SetClientState(suite.chainA.GetContext(), clientA, clientState)
+
+			err := suite.chainA.App.IBCKeeper.ConnectionKeeper.VerifyConnectionState(
+				suite.chainA.GetContext(), connection,
+				proofHeight, proof, connB.ID, expectedConnection,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().True(errors.Is(err, clienttypes.ErrClientFrozen))
+			}
+		})
+	}
+}
+
+// TestGetVerificationHeight verifies that the returned proof height is the height
+// of the consensus state used to verify the client and connection states, falling
+// back to the nearest consensus state below the requested height.
+func (suite *KeeperTestSuite) TestGetVerificationHeight() {
+	cases := []struct {
+		msg            string
+		changeClientID bool
+		heightDiff     uint64
+		expFallback    bool
+		expPass        bool
+	}{
+		{"success", false, 0, false, true},
+		{"nearest consensus state below the height", false, 5, true, true},
+		{"client state not found", true, 0, false, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			clientA, clientB, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+			connection := suite.chainA.GetConnection(connA)
+			if tc.changeClientID {
+				connection.ClientId = ibctesting.InvalidID
+			}
+
+			counterpartyClient, clientProof := suite.chainB.QueryClientStateProof(clientB)
+			connectionProof, proofHeight := suite.chainB.QueryProof(host.KeyConnection(connB.ID))
+
+			verificationHeight, err := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetVerificationHeight(
+				suite.chainA.GetContext(), connection, proofHeight+tc.heightDiff,
+			)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			if tc.expFallback {
+				_, found := suite.chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientA, proofHeight+tc.heightDiff)
+				suite.Require().False(found)
+
+				// the latest consensus state of the client is the nearest one below the height
+				latestHeight := suite.chainA.GetClientState(clientA).GetLatestHeight()
+				suite.Require().Equal(clienttypes.NewHeight(0, latestHeight), verificationHeight)
+				return
+			}
+
+			consensusState, found := suite.chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientA, proofHeight)
+			suite.Require().True(found)
+			suite.Require().Equal(clienttypes.ConsensusStateEpochHeight(consensusState, proofHeight), verificationHeight)
+
+			// the proofs verified at the proof height are verified against that consensus state
+			err = suite.chainA.App.IBCKeeper.ConnectionKeeper.VerifyClientState(
+				suite.chainA.GetContext(), connection, verificationHeight.EpochHeight, clientProof, counterpartyClient,
+			)
+			suite.Require().NoError(err)
+
+			err = suite.chainA.App.IBCKeeper.ConnectionKeeper.VerifyConnectionState(
+				suite.chainA.GetContext(), connection, verificationHeight.EpochHeight, connectionProof, connB.ID, suite.chainB.GetConnection(connB),
+			)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestGetVerificationHeightFallback verifies that the nearest consensus state
+// below a height without a consensus state is returned, with its epoch.
+func (suite *KeeperTestSuite) TestGetVerificationHeightFallback() {
+	clientA, _, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+	connection := suite.chainA.GetConnection(connA)
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.IBCKeeper.ClientKeeper
+
+	consensusState := func(epoch, height uint64) *ibctmtypes.ConsensusState {
+		return ibctmtypes.NewConsensusState(
+			ctx.BlockTime(), commitmenttypes.NewMerkleRoot([]byte("hash")), clienttypes.NewHeight(epoch, height), nil,
+		)
+	}
+
+	// consensus states in the epoch of the client with a gap between them
+	latestHeight := suite.chainA.GetClientState(clientA).GetLatestHeight()
+	clientKeeper.SetClientConsensusState(ctx, clientA, latestHeight+10, consensusState(0, latestHeight+10))
+	clientKeeper.SetClientConsensusState(ctx, clientA, latestHeight+20, consensusState(0, latestHeight+20))
+
+	cases := []struct {
+		msg       string
+		height    uint64
+		expHeight clienttypes.Height
+		expPass   bool
+	}{
+		{"exact height", latestHeight + 10, clienttypes.NewHeight(0, latestHeight+10), true},
+		{"in the gap", latestHeight + 15, clienttypes.NewHeight(0, latestHeight+10), true},
+		{"above the highest consensus state", latestHeight + 100, clienttypes.NewHeight(0, latestHeight+20), true},
+		{"below the lowest consensus state", 1, clienttypes.Height{}, false},
+	}
+
+	for _, tc := range cases {
+		verificationHeight, err := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetVerificationHeight(ctx, connection, tc.height)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().True(errors.Is(err, clienttypes.ErrConsensusStateNotFound), tc.msg)
+		}
+		suite.Require().Equal(tc.expHeight, verificationHeight, tc.msg)
+	}
+
+	// a client of a counterparty chain in epoch 1
+	epochClientID := "epochclient"
+	epochClientState := ibctmtypes.NewClientState(
+		suite.chainB.ChainID, ibctmtypes.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift,
+		clienttypes.NewHeight(1, 50), commitmenttypes.GetSDKSpecs(),
+	)
+	clientKeeper.SetClientState(ctx, epochClientID, epochClientState)
+	clientKeeper.SetClientConsensusState(ctx, epochClientID, 40, consensusState(1, 40))
+	clientKeeper.SetClientConsensusState(ctx, epochClientID, 50, consensusState(1, 50))
+
+	connection.ClientId = epochClientID
+	verificationHeight, err := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetVerificationHeight(ctx, connection, 45)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clienttypes.NewHeight(1, 40), verificationHeight)
+}
+
 // TestVerifyChannelState verifies the channel state of the channel on
 // chainB. The channels on chainA and chainB are fully opened.
 func (suite *KeeperTestSuite) TestVerifyChannelState() {
@@ -298,6 +611,60 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 	}
 }
 
+// TestVerifyPacketCommitmentLegacyProof verifies a packet commitment proof which
+// doesn't parse as an ICS23 proof. It is only accepted if legacy proofs are
+// allowed by the client parameters and the application set a decoder.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentLegacyProof() {
+	cases := []struct {
+		msg     string
+		allowed bool
+		expPass bool
+	}{
+		{"verification success", true, true},
+		{"legacy proofs not allowed", false, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			_, clientB, _, connB, channelA, channelB := suite.coordinator.Setup(suite.chainA, suite.chainB, channeltypes.UNORDERED)
+			connection := suite.chainB.GetConnection(connB)
+
+			packet := channeltypes.NewPacket(ibctesting.TestHash, 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, 100000, 0)
+			err := suite.coordinator.SendPacket(suite.chainA, suite.chainB, packet, clientB)
+			suite.Require().NoError(err)
+
+			commitmentKey := host.KeyPacketCommitment(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			proof, proofHeight := suite.chainA.QueryProof(commitmentKey)
+
+			// the legacy format is the JSON encoding of the merkle proof
+			var merkleProof commitmenttypes.MerkleProof
+			suite.Require().NoError(suite.chainA.Codec.UnmarshalBinaryBare(proof, &merkleProof))
+			legacyProof, err := commitmenttypes.SubModuleCdc.MarshalJSON(&merkleProof)
+			suite.Require().NoError(err)
+
+			clientKeeper := suite.chainB.App.IBCKeeper.ClientKeeper
+			params := clienttypes.DefaultParams()
+			params.AllowLegacyProofs = tc.allowed
+			clientKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			err = suite.chainB.App.IBCKeeper.ConnectionKeeper.VerifyPacketCommitment(
+				suite.chainB.GetContext(), connection, proofHeight, legacyProof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), channeltypes.CommitPacket(packet),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyPacketAcknowledgement has chainA verify the acknowledgement on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
@@ -365,13 +732,16 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgementAbsence() {
 		msg            string
 		changeClientID bool
 		recvAck        bool
+		changeRoot     bool
 		heightDiff     uint64
+		expErr         error
 		expPass        bool
 	}{
-		{"verification success", false, false, 0, true},
-		{"client state not found - changed client ID", true, false, 0, false},
-		{"consensus state not found - increased proof height", false, false, 5, false},
-		{"verification failed - acknowledgement was received", false, true, 0, false},
+		{"verification success", false, false, false, 0, nil, true},
+		{"client state not found - changed client ID", true, false, false, 0, clienttypes.ErrClientNotFound, false},
+		{"consensus state not found - increased proof height", false, false, false, 5, sdkerrors.ErrInvalidHeight, false},
+		{"verification failed - acknowledgement was received", false, true, false, 0, commitmenttypes.ErrKeyPresent, false},
+		{"verification failed - proof doesn't match the consensus state root", false, false, true, 0, commitmenttypes.ErrRootMismatch, false},
 	}
 
 	for _, tc := range cases {
@@ -403,6 +773,17 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgementAbsence() {
 			packetAckKey := host.KeyPacketAcknowledgement(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 			proof, proofHeight := suite.chainB.QueryProof(packetAckKey)
 
+			if tc.changeRoot {
+				consensusState, found := suite.chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientA, proofHeight)
+				suite.Require().True(found)
+
+				tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+				suite.Require().True(ok)
+
+				tmConsensusState.Root = commitmenttypes.NewMerkleRoot([]byte("invalid root"))
+				suite.chainA.App.IBCKeeper.ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientA, proofHeight, tmConsensusState)
+			}
+
 			err = suite.chainA.App.IBCKeeper.ConnectionKeeper.VerifyPacketAcknowledgementAbsence(
 				suite.chainA.GetContext(), connection, proofHeight+tc.heightDiff, proof,
 				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
@@ -412,6 +793,111 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgementAbsence() {
 				suite.Require().NoError(err)
 			} else {
 				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expErr), err.Error())
+			}
+
+			if tc.recvAck || tc.changeRoot {
+				// the error reports the store key whose absence was verified
+				suite.Require().Contains(err.Error(), string(packetAckKey))
+			}
+		})
+	}
+}
+
+// TestVerificationErrorCategories has chainA verify a packet acknowledgement on
+// channelB and checks that each failure path of the client verification returns
+// the sentinel error of its category.
+func (suite *KeeperTestSuite) TestVerificationErrorCategories() {
+	var (
+		clientA         string
+		proof           []byte
+		proofHeight     uint64
+		acknowledgement []byte
+	)
+
+	cases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"client is frozen", func() {
+				clientState := suite.chainA.GetClientState(clientA).(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				suite.chainA.App.IBCKeeper.ClientKeeper.SetClientState(suite.chainA.GetContext(), clientA, clientState)
+			}, clienttypes.ErrClientFrozen,
+		},
+		{
+			"consensus state not found", func() {
+				store := suite.chainA.App.IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), clientA)
+				store.Delete(host.KeyConsensusState(proofHeight))
+			}, clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"proof doesn't match the consensus state root", func() {
+				consensusState, found := suite.chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientA, proofHeight)
+				suite.Require().True(found)
+
+				tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+				suite.Require().True(ok)
+
+				tmConsensusState.Root = commitmenttypes.NewMerkleRoot([]byte("invalid root"))
+				suite.chainA.App.IBCKeeper.ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), clientA, proofHeight, tmConsensusState)
+			}, commitmenttypes.ErrRootMismatch,
+		},
+		{
+			"invalid proof", func() {
+				proof = []byte("invalid proof")
+			}, clienttypes.ErrProofVerificationFailed,
+		},
+		{
+			"proof of another value", func() {
+				acknowledgement = []byte(ibctesting.InvalidID)
+			}, clienttypes.ErrProofVerificationFailed,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			var (
+				clientB            string
+				connA              *ibctesting.TestConnection
+				channelA, channelB ibctesting.TestChannel
+			)
+			clientA, clientB, connA, _, channelA, channelB = suite.coordinator.Setup(suite.chainA, suite.chainB, channeltypes.UNORDERED)
+			connection := suite.chainA.GetConnection(connA)
+
+			packet := channeltypes.NewPacket(ibctesting.TestHash, 1, channelA.PortID, channelA.ID, channelB.PortID, channelB.ID, 100000, 0)
+			err := suite.coordinator.SendPacket(suite.chainA, suite.chainB, packet, clientB)
+			suite.Require().NoError(err)
+
+			err = suite.coordinator.ReceiveExecuted(suite.chainB, suite.chainA, packet, clientA)
+			suite.Require().NoError(err)
+
+			proof, proofHeight = suite.chainB.QueryProof(host.KeyPacketAcknowledgement(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			acknowledgement = ibctesting.TestHash
+
+			tc.malleate()
+
+			err = suite.chainA.App.IBCKeeper.ConnectionKeeper.VerifyPacketAcknowledgement(
+				suite.chainA.GetContext(), connection, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), acknowledgement,
+			)
+			suite.Require().Error(err)
+			suite.Require().True(errors.Is(err, tc.expErr), err.Error())
+
+			// a failure only belongs to a single category
+			for _, category := range []error{
+				clienttypes.ErrClientFrozen, clienttypes.ErrConsensusStateNotFound,
+				commitmenttypes.ErrRootMismatch, clienttypes.ErrProofVerificationFailed,
+			} {
+				if category != tc.expErr {
+					suite.Require().False(errors.Is(err, category), err.Error())
+				}
 			}
 		})
 	}
@@ -470,3 +956,26 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 		})
 	}
 }
+
+// storeCompressedConsensusState enables consensus state compression on the chain
+// and stores the latest consensus state of the given client again, compressed,
+// after applying the optional modification.
+func (suite *KeeperTestSuite) storeCompressedConsensusState(chain *ibctesting.TestChain, clientID string, modify func(*ibctmtypes.ConsensusState)) {
+	ctx := chain.GetContext()
+	clientKeeper := chain.App.IBCKeeper.ClientKeeper
+
+	params := clienttypes.DefaultParams()
+	params.CompressConsensusStates = true
+	clientKeeper.SetParams(ctx, params)
+
+	consState, found := clientKeeper.GetLatestClientConsensusState(ctx, clientID)
+	suite.Require().True(found)
+	tmConsState := consState.(*ibctmtypes.ConsensusState)
+	if modify != nil {
+		modify(tmConsState)
+	}
+	clientKeeper.SetClientConsensusState(ctx, clientID, tmConsState.GetHeight(), tmConsState)
+
+	stored := clientKeeper.ClientStore(ctx, clientID).Get(host.KeyConsensusState(tmConsState.GetHeight()))
+	suite.Require().True(clienttypes.IsCompressedConsensusState(stored))
+}
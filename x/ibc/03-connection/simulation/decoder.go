@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
@@ -26,6 +27,9 @@ func NewDecodeStore(cdc codec.BinaryMarshaler, kvA, kvB kv.Pair) (string, bool)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &connectionB)
 		return fmt.Sprintf("ConnectionEnd A: %v\nConnectionEnd B: %v", connectionA, connectionB), true
 
+	case bytes.HasPrefix(kvA.Key, host.KeyConnectionVerifiedHeight("")):
+		return fmt.Sprintf("Verified height A: %d\nVerified height B: %d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value)), true
+
 	default:
 		return "", false
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/simulation"
 	"github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
@@ -38,6 +39,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   host.KeyConnection(connectionID),
 				Value: cdc.MustMarshalBinaryBare(&connection),
 			},
+			{
+				Key:   host.KeyConnectionVerifiedHeight(connectionID),
+				Value: sdk.Uint64ToBigEndian(10),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -50,6 +55,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"ClientPaths", fmt.Sprintf("ClientPaths A: %v\nClientPaths B: %v", paths, paths)},
 		{"ConnectionEnd", fmt.Sprintf("ConnectionEnd A: %v\nConnectionEnd B: %v", connection, connection)},
+		{"verified height", "Verified height A: 10\nVerified height B: 10"},
 		{"other", ""},
 	}
 
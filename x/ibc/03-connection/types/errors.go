@@ -15,4 +15,5 @@ var (
 	ErrInvalidConnection             = sdkerrors.Register(SubModuleName, 8, "invalid connection")
 	ErrInvalidVersion                = sdkerrors.Register(SubModuleName, 9, "invalid connection version")
 	ErrVersionNegotiationFailed      = sdkerrors.Register(SubModuleName, 10, "connection version negotiation failed")
+	ErrVerificationTimeout           = sdkerrors.Register(SubModuleName, 11, "proof verification timed out")
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
@@ -9,8 +10,12 @@ import (
 type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientConsensusState(ctx sdk.Context, clientID string, height uint64) (exported.ConsensusState, bool)
+	IterateConsensusStatesReverse(
+		ctx sdk.Context, clientID string, startHeight clienttypes.Height, cb func(height clienttypes.Height, cs exported.ConsensusState) bool,
+	)
 	GetSelfConsensusState(ctx sdk.Context, height uint64) (exported.ConsensusState, bool)
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 	IterateClients(ctx sdk.Context, cb func(string, exported.ClientState) bool)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	IsLegacyProofAllowed(ctx sdk.Context) bool
 }
@@ -14,31 +14,43 @@ func NewConnectionPaths(id string, paths []string) ConnectionPaths {
 	}
 }
 
+// NewConnectionVerifiedHeight creates a ConnectionVerifiedHeight instance.
+func NewConnectionVerifiedHeight(connectionID string, height uint64) ConnectionVerifiedHeight {
+	return ConnectionVerifiedHeight{
+		ConnectionId: connectionID,
+		Height:       height,
+	}
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
-	connections []IdentifiedConnection, connPaths []ConnectionPaths,
+	connections []IdentifiedConnection, connPaths []ConnectionPaths, verifiedHeights []ConnectionVerifiedHeight,
 ) GenesisState {
 	return GenesisState{
-		Connections:           connections,
-		ClientConnectionPaths: connPaths,
+		Connections:               connections,
+		ClientConnectionPaths:     connPaths,
+		ConnectionVerifiedHeights: verifiedHeights,
 	}
 }
 
 // DefaultGenesisState returns the ibc connection submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Connections:           []IdentifiedConnection{},
-		ClientConnectionPaths: []ConnectionPaths{},
+		Connections:               []IdentifiedConnection{},
+		ClientConnectionPaths:     []ConnectionPaths{},
+		ConnectionVerifiedHeights: []ConnectionVerifiedHeight{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	connections := make(map[string]bool, len(gs.Connections))
 	for i, conn := range gs.Connections {
 		if err := conn.ValidateBasic(); err != nil {
 			return fmt.Errorf("invalid connection %v index %d: %w", conn, i, err)
 		}
+		connections[conn.Id] = true
 	}
 
 	for i, conPaths := range gs.ClientConnectionPaths {
@@ -52,5 +64,22 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	verifiedHeights := make(map[string]bool, len(gs.ConnectionVerifiedHeights))
+	for i, verifiedHeight := range gs.ConnectionVerifiedHeights {
+		if err := host.ConnectionIdentifierValidator(verifiedHeight.ConnectionId); err != nil {
+			return fmt.Errorf("invalid connection verified height %d: %w", i, err)
+		}
+		if !connections[verifiedHeight.ConnectionId] {
+			return fmt.Errorf("invalid connection verified height %d: connection %s not found", i, verifiedHeight.ConnectionId)
+		}
+		if verifiedHeights[verifiedHeight.ConnectionId] {
+			return fmt.Errorf("invalid connection verified height %d: duplicate connection %s", i, verifiedHeight.ConnectionId)
+		}
+		if verifiedHeight.Height == 0 {
+			return fmt.Errorf("invalid connection verified height %d: height cannot be zero", i)
+		}
+		verifiedHeights[verifiedHeight.ConnectionId] = true
+	}
+
 	return nil
 }
@@ -25,8 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibc connection submodule's genesis state.
 type GenesisState struct {
-	Connections           []IdentifiedConnection `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections"`
-	ClientConnectionPaths []ConnectionPaths      `protobuf:"bytes,2,rep,name=client_connection_paths,json=clientConnectionPaths,proto3" json:"client_connection_paths" yaml:"client_connection_paths"`
+	Connections               []IdentifiedConnection     `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections"`
+	ClientConnectionPaths     []ConnectionPaths          `protobuf:"bytes,2,rep,name=client_connection_paths,json=clientConnectionPaths,proto3" json:"client_connection_paths" yaml:"client_connection_paths"`
+	ConnectionVerifiedHeights []ConnectionVerifiedHeight `protobuf:"bytes,3,rep,name=connection_verified_heights,json=connectionVerifiedHeights,proto3" json:"connection_verified_heights" yaml:"connection_verified_heights"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,31 +77,102 @@ func (m *GenesisState) GetClientConnectionPaths() []ConnectionPaths {
 	return nil
 }
 
+func (m *GenesisState) GetConnectionVerifiedHeights() []ConnectionVerifiedHeight {
+	if m != nil {
+		return m.ConnectionVerifiedHeights
+	}
+	return nil
+}
+
+// ConnectionVerifiedHeight defines the height of the client consensus state
+// against which the handshake of a connection was last verified.
+type ConnectionVerifiedHeight struct {
+	// connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// height of the client consensus state
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ConnectionVerifiedHeight) Reset()         { *m = ConnectionVerifiedHeight{} }
+func (m *ConnectionVerifiedHeight) String() string { return proto.CompactTextString(m) }
+func (*ConnectionVerifiedHeight) ProtoMessage()    {}
+func (*ConnectionVerifiedHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a83da0d52c27d9b, []int{1}
+}
+func (m *ConnectionVerifiedHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionVerifiedHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionVerifiedHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionVerifiedHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionVerifiedHeight.Merge(m, src)
+}
+func (m *ConnectionVerifiedHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionVerifiedHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionVerifiedHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionVerifiedHeight proto.InternalMessageInfo
+
+func (m *ConnectionVerifiedHeight) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *ConnectionVerifiedHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.connection.GenesisState")
+	proto.RegisterType((*ConnectionVerifiedHeight)(nil), "ibc.connection.ConnectionVerifiedHeight")
 }
 
 func init() { proto.RegisterFile("ibc/connection/genesis.proto", fileDescriptor_7a83da0d52c27d9b) }
 
 var fileDescriptor_7a83da0d52c27d9b = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x4c, 0x4a, 0xd6,
-	0x4f, 0xce, 0xcf, 0xcb, 0x4b, 0x4d, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcb, 0x4c, 0x4a, 0xd6, 0x43, 0xc8,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xa5, 0xf4, 0x41, 0x2c, 0x88, 0x2a, 0x29, 0x79, 0x34,
-	0x33, 0x10, 0x4c, 0x88, 0x02, 0xa5, 0xbb, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x83, 0x83, 0x4b, 0x12,
-	0x4b, 0x52, 0x85, 0x7c, 0xb8, 0xb8, 0x11, 0x8a, 0x8a, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d,
-	0x54, 0xf4, 0x50, 0x6d, 0xd3, 0xf3, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x4c, 0xcb, 0x4c, 0x4d, 0x71,
-	0x86, 0x0b, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x84, 0xac, 0x5d, 0xa8, 0x9e, 0x4b, 0x3c,
-	0x39, 0x27, 0x33, 0x35, 0xaf, 0x24, 0x1e, 0x21, 0x1a, 0x5f, 0x90, 0x58, 0x92, 0x51, 0x2c, 0xc1,
-	0x04, 0x36, 0x59, 0x1e, 0xdd, 0x64, 0x84, 0x79, 0x01, 0x20, 0x65, 0x4e, 0x6a, 0x20, 0x43, 0x3f,
-	0xdd, 0x93, 0x97, 0xab, 0x4c, 0xcc, 0xcd, 0xb1, 0x52, 0xc2, 0x61, 0x9a, 0x52, 0x90, 0x28, 0x44,
-	0x06, 0x5d, 0x7b, 0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
-	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa5,
-	0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0xe7, 0x17, 0xe7, 0xe6, 0x17,
-	0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x0a, 0x7d, 0x50, 0xc8, 0x19, 0x18, 0xeb, 0x22, 0x05,
-	0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xc8, 0x26, 0xf8, 0x5d, 0x9f, 0x01, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x33, 0x6d, 0x29, 0xdc, 0x69, 0xef, 0x5d, 0x84, 0x5e, 0x8d, 0x55, 0x26, 0x65, 0x10,
+	0x09, 0x42, 0x13, 0xb1, 0xe0, 0x42, 0x70, 0x13, 0x17, 0x5a, 0x70, 0x51, 0x22, 0xb8, 0x70, 0x53,
+	0x9a, 0xc9, 0x98, 0x0c, 0xb6, 0x99, 0xda, 0x19, 0xc5, 0xae, 0x7c, 0x05, 0x17, 0x3e, 0x54, 0x97,
+	0x5d, 0xba, 0x2a, 0xd2, 0xbe, 0x41, 0xf1, 0x01, 0x24, 0x7f, 0x30, 0xb1, 0x92, 0xd5, 0xcc, 0x39,
+	0xe7, 0xfb, 0x7e, 0xe7, 0x5b, 0x1c, 0xb8, 0xc7, 0x5c, 0x62, 0x11, 0x1e, 0x86, 0x94, 0x48, 0xc6,
+	0x43, 0xcb, 0xa7, 0x21, 0x15, 0x4c, 0x98, 0xe3, 0x09, 0x97, 0x5c, 0xfd, 0xc7, 0x5c, 0x62, 0x66,
+	0xd3, 0x66, 0xc3, 0xe7, 0x3e, 0x8f, 0x47, 0x56, 0xf4, 0x4b, 0x54, 0x4d, 0x7d, 0x83, 0x91, 0x7d,
+	0x13, 0x01, 0xfe, 0x2c, 0xc1, 0xfa, 0x45, 0x02, 0xbe, 0x96, 0x03, 0x49, 0xd5, 0x2b, 0x58, 0xcb,
+	0x44, 0x42, 0x03, 0xad, 0xb2, 0x51, 0x3b, 0xde, 0x37, 0x7f, 0x6e, 0x33, 0xbb, 0x1e, 0x0d, 0x25,
+	0xbb, 0x63, 0xd4, 0x3b, 0xff, 0x6e, 0xda, 0x95, 0xd9, 0x42, 0x57, 0x9c, 0xbc, 0x5d, 0x7d, 0x81,
+	0xdb, 0x64, 0xc8, 0x68, 0x28, 0xfb, 0x59, 0xb7, 0x3f, 0x1e, 0xc8, 0x40, 0x68, 0xa5, 0x98, 0xac,
+	0x6f, 0x92, 0x33, 0x5e, 0x2f, 0x92, 0xd9, 0x07, 0x11, 0x74, 0xbd, 0xd0, 0xd1, 0x74, 0x30, 0x1a,
+	0x9e, 0xe2, 0x02, 0x1a, 0x76, 0xfe, 0x27, 0x93, 0x0d, 0xbb, 0xfa, 0x06, 0xe0, 0x6e, 0x4e, 0xfc,
+	0x44, 0x27, 0x71, 0xea, 0x7e, 0x40, 0x99, 0x1f, 0x48, 0xa1, 0x95, 0xe3, 0x14, 0x46, 0x71, 0x8a,
+	0x9b, 0xd4, 0x71, 0x19, 0x1b, 0xec, 0xc3, 0x34, 0x0e, 0x4e, 0xe3, 0x14, 0xa3, 0xb1, 0xb3, 0x43,
+	0x0a, 0x28, 0x02, 0x3f, 0x40, 0xad, 0x68, 0x85, 0x7a, 0x06, 0xff, 0xe6, 0xb0, 0xcc, 0xd3, 0x40,
+	0x0b, 0x18, 0x7f, 0x6c, 0x6d, 0xbd, 0xd0, 0x1b, 0xbf, 0xb6, 0x32, 0x0f, 0x3b, 0xf5, 0xac, 0xee,
+	0x7a, 0xea, 0x16, 0xac, 0x26, 0x09, 0xb4, 0x52, 0x0b, 0x18, 0x15, 0x27, 0xad, 0xec, 0xde, 0x6c,
+	0x89, 0xc0, 0x7c, 0x89, 0xc0, 0xc7, 0x12, 0x81, 0xd7, 0x15, 0x52, 0xe6, 0x2b, 0xa4, 0xbc, 0xaf,
+	0x90, 0x72, 0x7b, 0xe2, 0x33, 0x19, 0x3c, 0xba, 0x26, 0xe1, 0x23, 0x8b, 0x70, 0x31, 0xe2, 0x22,
+	0x7d, 0xda, 0xc2, 0xbb, 0xb7, 0x9e, 0xad, 0xe8, 0x86, 0x8e, 0x3a, 0xed, 0xdc, 0x19, 0xc9, 0xe9,
+	0x98, 0x0a, 0xb7, 0x1a, 0x9f, 0x50, 0xe7, 0x6b, 0x00, 0xb0, 0xb0, 0x1c, 0x90, 0xa9, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +195,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConnectionVerifiedHeights) > 0 {
+		for iNdEx := len(m.ConnectionVerifiedHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionVerifiedHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ClientConnectionPaths) > 0 {
 		for iNdEx := len(m.ClientConnectionPaths) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +240,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionVerifiedHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionVerifiedHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionVerifiedHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -183,6 +304,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConnectionVerifiedHeights) > 0 {
+		for _, e := range m.ConnectionVerifiedHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConnectionVerifiedHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
 	return n
 }
 
@@ -289,6 +432,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionVerifiedHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionVerifiedHeights = append(m.ConnectionVerifiedHeights, ConnectionVerifiedHeight{})
+			if err := m.ConnectionVerifiedHeights[len(m.ConnectionVerifiedHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionVerifiedHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionVerifiedHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionVerifiedHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -32,6 +32,9 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.ConnectionPaths{
 					{clientID, []string{host.ConnectionPath(connectionID)}},
 				},
+				[]types.ConnectionVerifiedHeight{
+					types.NewConnectionVerifiedHeight(connectionID, 10),
+				},
 			),
 			expPass: true,
 		},
@@ -44,6 +47,9 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.ConnectionPaths{
 					{clientID, []string{host.ConnectionPath(connectionID)}},
 				},
+				[]types.ConnectionVerifiedHeight{
+					types.NewConnectionVerifiedHeight(connectionID, 10),
+				},
 			),
 			expPass: false,
 		},
@@ -56,6 +62,7 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.ConnectionPaths{
 					{"(CLIENTIDONE)", []string{host.ConnectionPath(connectionID)}},
 				},
+				nil,
 			),
 			expPass: false,
 		},
@@ -68,11 +75,47 @@ func TestValidateGenesis(t *testing.T) {
 				[]types.ConnectionPaths{
 					{clientID, []string{connectionID}},
 				},
+				nil,
 			),
 			expPass: false,
 		},
 	}
 
+	verifiedHeightCases := []struct {
+		name            string
+		verifiedHeights []types.ConnectionVerifiedHeight
+		expPass         bool
+	}{
+		{"no verified heights", nil, true},
+		{"valid verified height", []types.ConnectionVerifiedHeight{types.NewConnectionVerifiedHeight(connectionID, 10)}, true},
+		{"invalid connection id", []types.ConnectionVerifiedHeight{types.NewConnectionVerifiedHeight("(CONNECTIONID)", 10)}, false},
+		{"unknown connection", []types.ConnectionVerifiedHeight{types.NewConnectionVerifiedHeight(connectionID2, 10)}, false},
+		{"zero height", []types.ConnectionVerifiedHeight{types.NewConnectionVerifiedHeight(connectionID, 0)}, false},
+		{
+			"duplicate connection",
+			[]types.ConnectionVerifiedHeight{types.NewConnectionVerifiedHeight(connectionID, 10), types.NewConnectionVerifiedHeight(connectionID, 12)},
+			false,
+		},
+	}
+
+	for _, tc := range verifiedHeightCases {
+		testCases = append(testCases, struct {
+			name     string
+			genState types.GenesisState
+			expPass  bool
+		}{
+			name: tc.name,
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(connectionID, types.NewConnectionEnd(types.INIT, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []string{ibctesting.ConnectionVersion})),
+				},
+				nil,
+				tc.verifiedHeights,
+			),
+			expPass: tc.expPass,
+		})
+	}
+
 	for _, tc := range testCases {
 		tc := tc
 		err := tc.genState.Validate()
@@ -645,6 +645,135 @@ func (m *QueryConnectionConsensusStateResponse) GetProofHeight() uint64 {
 	return 0
 }
 
+// QueryConnectionVerifiedConsensusStateRequest is the request type for the
+// Query/ConnectionVerifiedConsensusState RPC method
+type QueryConnectionVerifiedConsensusStateRequest struct {
+	// connection identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *QueryConnectionVerifiedConsensusStateRequest) Reset() {
+	*m = QueryConnectionVerifiedConsensusStateRequest{}
+}
+func (m *QueryConnectionVerifiedConsensusStateRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConnectionVerifiedConsensusStateRequest) ProtoMessage() {}
+func (*QueryConnectionVerifiedConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ee60d8b08ce3606, []int{10}
+}
+func (m *QueryConnectionVerifiedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionVerifiedConsensusStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionVerifiedConsensusStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionVerifiedConsensusStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionVerifiedConsensusStateRequest.Merge(m, src)
+}
+func (m *QueryConnectionVerifiedConsensusStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionVerifiedConsensusStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionVerifiedConsensusStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionVerifiedConsensusStateRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionVerifiedConsensusStateRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryConnectionVerifiedConsensusStateResponse is the response type for the
+// Query/ConnectionVerifiedConsensusState RPC method
+type QueryConnectionVerifiedConsensusStateResponse struct {
+	// consensus state against which the connection was verified
+	ConsensusState *types1.Any `protobuf:"bytes,1,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// client ID associated with the consensus state
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// height of the consensus state at which the counterparty state was verified
+	VerifiedHeight uint64 `protobuf:"varint,3,opt,name=verified_height,json=verifiedHeight,proto3" json:"verified_height,omitempty"`
+	// height at which the query was performed
+	ProofHeight uint64 `protobuf:"varint,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height,omitempty"`
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) Reset() {
+	*m = QueryConnectionVerifiedConsensusStateResponse{}
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConnectionVerifiedConsensusStateResponse) ProtoMessage() {}
+func (*QueryConnectionVerifiedConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ee60d8b08ce3606, []int{11}
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionVerifiedConsensusStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionVerifiedConsensusStateResponse.Merge(m, src)
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionVerifiedConsensusStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionVerifiedConsensusStateResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) GetConsensusState() *types1.Any {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return nil
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) GetVerifiedHeight() uint64 {
+	if m != nil {
+		return m.VerifiedHeight
+	}
+	return 0
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) GetProofHeight() uint64 {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryConnectionRequest)(nil), "ibc.connection.QueryConnectionRequest")
 	proto.RegisterType((*QueryConnectionResponse)(nil), "ibc.connection.QueryConnectionResponse")
@@ -656,65 +785,72 @@ func init() {
 	proto.RegisterType((*QueryConnectionClientStateResponse)(nil), "ibc.connection.QueryConnectionClientStateResponse")
 	proto.RegisterType((*QueryConnectionConsensusStateRequest)(nil), "ibc.connection.QueryConnectionConsensusStateRequest")
 	proto.RegisterType((*QueryConnectionConsensusStateResponse)(nil), "ibc.connection.QueryConnectionConsensusStateResponse")
+	proto.RegisterType((*QueryConnectionVerifiedConsensusStateRequest)(nil), "ibc.connection.QueryConnectionVerifiedConsensusStateRequest")
+	proto.RegisterType((*QueryConnectionVerifiedConsensusStateResponse)(nil), "ibc.connection.QueryConnectionVerifiedConsensusStateResponse")
 }
 
 func init() { proto.RegisterFile("ibc/connection/query.proto", fileDescriptor_5ee60d8b08ce3606) }
 
 var fileDescriptor_5ee60d8b08ce3606 = []byte{
-	// 840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x4e, 0xd4, 0x5e,
-	0x14, 0xe6, 0xce, 0x00, 0xbf, 0xdf, 0x9c, 0x41, 0xd4, 0x9b, 0x01, 0x6a, 0x95, 0x61, 0x28, 0xff,
-	0x46, 0x0d, 0xad, 0x0c, 0x42, 0x8c, 0x8a, 0x51, 0x08, 0x08, 0x71, 0x83, 0x75, 0xa5, 0x1b, 0xd2,
-	0xce, 0x94, 0x4e, 0x23, 0xf4, 0x0e, 0xdc, 0x8e, 0x71, 0x62, 0xd8, 0xb8, 0x35, 0x26, 0x26, 0xee,
-	0xdc, 0xfa, 0x02, 0xc6, 0x57, 0x70, 0xc3, 0x92, 0x44, 0x13, 0x4d, 0x4c, 0x8c, 0x01, 0x9f, 0xc0,
-	0x27, 0x30, 0xbd, 0xf7, 0x0e, 0x6d, 0xe7, 0x8f, 0x05, 0x22, 0xab, 0x69, 0xef, 0x39, 0xa7, 0xe7,
-	0x3b, 0xdf, 0xf9, 0xfa, 0x75, 0x40, 0x76, 0xcc, 0xa2, 0x56, 0x24, 0xae, 0x6b, 0x15, 0x3d, 0x87,
-	0xb8, 0xda, 0x56, 0xd5, 0xda, 0xae, 0xa9, 0x95, 0x6d, 0xe2, 0x11, 0xdc, 0xeb, 0x98, 0x45, 0x35,
-	0x88, 0xc9, 0x19, 0x9b, 0xd8, 0x84, 0x85, 0x34, 0xff, 0x8a, 0x67, 0xc9, 0x57, 0x8a, 0x84, 0x6e,
-	0x12, 0xaa, 0x99, 0x06, 0xb5, 0x78, 0xb9, 0xf6, 0x6c, 0xca, 0xb4, 0x3c, 0x63, 0x4a, 0xab, 0x18,
-	0xb6, 0xe3, 0x1a, 0x7e, 0xad, 0xc8, 0x1d, 0x60, 0xdd, 0x36, 0x1c, 0xcb, 0xf5, 0xc4, 0x8f, 0x08,
-	0x0c, 0x35, 0xc0, 0x08, 0x2e, 0x45, 0xc2, 0x25, 0x9b, 0x10, 0x7b, 0xc3, 0xd2, 0x8c, 0x8a, 0xa3,
-	0x19, 0xae, 0x4b, 0x3c, 0xf6, 0x58, 0x2a, 0xa2, 0x17, 0x44, 0x94, 0xdd, 0x99, 0xd5, 0x75, 0xcd,
-	0x70, 0xc5, 0x10, 0xca, 0x1c, 0xf4, 0x3f, 0xf4, 0x41, 0x2d, 0x1c, 0x3e, 0x51, 0xb7, 0xb6, 0xaa,
-	0x16, 0xf5, 0xf0, 0x08, 0x9c, 0x09, 0xda, 0xac, 0x39, 0x25, 0x09, 0xe5, 0x50, 0x3e, 0xa5, 0xf7,
-	0x04, 0x87, 0x2b, 0x25, 0xe5, 0x03, 0x82, 0x81, 0xa6, 0x7a, 0x5a, 0x21, 0x2e, 0xb5, 0xf0, 0x1c,
-	0x40, 0x90, 0xcb, 0xaa, 0xd3, 0x85, 0x41, 0x35, 0x4a, 0x9a, 0x1a, 0xd4, 0x2d, 0xba, 0x25, 0x3d,
-	0x54, 0x80, 0x33, 0xd0, 0x55, 0xd9, 0x26, 0x64, 0x5d, 0x4a, 0xe4, 0x50, 0xbe, 0x47, 0xe7, 0x37,
-	0x78, 0x10, 0x80, 0x5d, 0xac, 0x55, 0x0c, 0xaf, 0x2c, 0x25, 0x19, 0xa4, 0x14, 0x3b, 0x59, 0x35,
-	0xbc, 0x32, 0x1e, 0x86, 0x1e, 0x1e, 0x2e, 0x5b, 0x8e, 0x5d, 0xf6, 0xa4, 0xce, 0x1c, 0xca, 0x77,
-	0xea, 0x69, 0x76, 0xb6, 0xcc, 0x8e, 0x14, 0xa3, 0x09, 0x31, 0xad, 0x8f, 0xbc, 0x04, 0x10, 0xec,
-	0x44, 0x20, 0x1e, 0x57, 0xf9, 0x02, 0x55, 0x7f, 0x81, 0x2a, 0xdf, 0xbf, 0x58, 0xa0, 0xba, 0x6a,
-	0xd8, 0x96, 0xa8, 0xd5, 0x43, 0x95, 0xca, 0x27, 0x04, 0x52, 0x73, 0x0f, 0x41, 0xcb, 0x12, 0xa4,
-	0x83, 0x29, 0xa9, 0x84, 0x72, 0xc9, 0x7c, 0xba, 0x30, 0xda, 0xc8, 0xcb, 0x4a, 0xc9, 0x72, 0x3d,
-	0x67, 0xdd, 0xb1, 0x4a, 0x21, 0x66, 0xc3, 0x85, 0xf8, 0x7e, 0x04, 0x6c, 0x82, 0x81, 0x9d, 0x88,
-	0x05, 0xcb, 0x41, 0x84, 0xd1, 0xe2, 0x7e, 0xe8, 0x16, 0x6c, 0xf9, 0x74, 0x26, 0x75, 0x71, 0xa7,
-	0xdc, 0x86, 0x41, 0x3e, 0x04, 0x53, 0x62, 0x0b, 0xba, 0x2e, 0x42, 0x8a, 0xab, 0x34, 0x50, 0xc7,
-	0xff, 0xfc, 0x60, 0xa5, 0xa4, 0xbc, 0x47, 0x90, 0x6d, 0x57, 0x2e, 0x98, 0xb8, 0x0c, 0xe7, 0x42,
-	0x0a, 0xf3, 0x17, 0xca, 0xe9, 0x48, 0xe9, 0x67, 0x83, 0x73, 0x7f, 0xad, 0xf4, 0xd4, 0xc4, 0x60,
-	0xc2, 0x70, 0xc3, 0xa2, 0x38, 0xdc, 0x47, 0x9e, 0xe1, 0xd5, 0x57, 0x8b, 0xe7, 0x5a, 0xbe, 0x09,
-	0xf3, 0xd2, 0xef, 0x1f, 0x43, 0x99, 0x9a, 0xb1, 0xb9, 0x71, 0x53, 0x89, 0x84, 0x95, 0x86, 0x77,
-	0xe4, 0x2b, 0x02, 0xe5, 0x6f, 0x4d, 0x04, 0x1b, 0x8f, 0x61, 0xc0, 0x39, 0x5c, 0xfa, 0x9a, 0x20,
-	0x96, 0xfa, 0x29, 0x42, 0x89, 0xc3, 0x5c, 0x23, 0xdc, 0x17, 0x42, 0xfa, 0x08, 0x3d, 0xab, 0xcf,
-	0x69, 0x75, 0x7c, 0x6a, 0xec, 0xed, 0xc0, 0x68, 0xe3, 0x60, 0xfe, 0x28, 0x2e, 0xad, 0xd2, 0x7f,
-	0x48, 0x60, 0x48, 0xa0, 0x09, 0x86, 0xa1, 0x2e, 0xd0, 0xef, 0x08, 0xc6, 0x62, 0xfa, 0x1f, 0x5a,
-	0x91, 0xaf, 0x28, 0x1e, 0x89, 0x70, 0x9a, 0x51, 0xb9, 0x35, 0xaa, 0x75, 0x6b, 0x54, 0xef, 0xb9,
-	0x35, 0xbd, 0xb7, 0x18, 0x79, 0x4c, 0x54, 0xe8, 0x89, 0xa8, 0xd0, 0x03, 0x72, 0x93, 0xed, 0xc9,
-	0xed, 0x8c, 0x23, 0xb7, 0xab, 0x89, 0xdc, 0xc2, 0xab, 0xff, 0xa0, 0x8b, 0x4d, 0x87, 0xdf, 0x21,
-	0x80, 0x60, 0x44, 0x3c, 0xde, 0xe8, 0x15, 0xad, 0x0d, 0x5c, 0x9e, 0x88, 0xcd, 0xe3, 0xec, 0x28,
-	0xb7, 0x5e, 0x7e, 0xfe, 0xf5, 0x36, 0x31, 0x83, 0xa7, 0xb5, 0x86, 0xcf, 0x4c, 0xfd, 0x43, 0x15,
-	0xb2, 0x1d, 0xed, 0x45, 0x64, 0x55, 0x3b, 0xf8, 0x35, 0x82, 0x74, 0xe8, 0xe5, 0xc6, 0x71, 0x5d,
-	0xeb, 0xee, 0x21, 0xe7, 0xe3, 0x13, 0x05, 0xbe, 0xab, 0x0c, 0xdf, 0x18, 0x1e, 0x39, 0x02, 0x3e,
-	0xfc, 0x11, 0xc1, 0xf9, 0x26, 0xcb, 0xc1, 0x93, 0xad, 0x9b, 0xb5, 0x71, 0x36, 0x59, 0x3d, 0x6a,
-	0xba, 0x40, 0x78, 0x87, 0x21, 0xbc, 0x81, 0x67, 0xdb, 0x22, 0xe4, 0xf2, 0x89, 0x12, 0x59, 0x97,
-	0xd4, 0x0e, 0xde, 0x45, 0xd0, 0xd7, 0xd2, 0x1d, 0xf0, 0x54, 0x0c, 0x4b, 0xcd, 0x76, 0x25, 0x17,
-	0x8e, 0x53, 0x22, 0x06, 0x58, 0x66, 0x03, 0xcc, 0xe3, 0xbb, 0x27, 0x90, 0x80, 0x16, 0xf6, 0x2a,
-	0xfc, 0x05, 0x81, 0xd4, 0xee, 0x7d, 0xc4, 0xd7, 0xe3, 0xa0, 0xb5, 0xb2, 0x0f, 0x79, 0xe6, 0x98,
-	0x55, 0x62, 0xa6, 0x07, 0x6c, 0xa6, 0x45, 0xbc, 0x70, 0xa2, 0x99, 0xa2, 0x76, 0x31, 0xbf, 0xba,
-	0xbb, 0x9f, 0x45, 0x7b, 0xfb, 0x59, 0xf4, 0x73, 0x3f, 0x8b, 0xde, 0x1c, 0x64, 0x3b, 0xf6, 0x0e,
-	0xb2, 0x1d, 0xdf, 0x0e, 0xb2, 0x1d, 0x4f, 0x66, 0x6d, 0xc7, 0x2b, 0x57, 0x4d, 0xb5, 0x48, 0x36,
-	0x35, 0xf1, 0x5f, 0x8f, 0xff, 0x4c, 0xd2, 0xd2, 0x53, 0xed, 0x39, 0x6b, 0x7e, 0x6d, 0x7a, 0x32,
-	0xd4, 0xdf, 0xab, 0x55, 0x2c, 0x6a, 0x76, 0x33, 0xcb, 0x99, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff,
-	0x4c, 0x81, 0x0a, 0xe4, 0x63, 0x0a, 0x00, 0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0xed, 0x24, 0xe9, 0x13, 0xb9, 0x29, 0x2d, 0x8c, 0xf2, 0x5e, 0x8d, 0xa1, 0x79, 0xa9, 0xdf,
+	0x7b, 0x6d, 0xf8, 0x88, 0x4d, 0x53, 0x5a, 0x21, 0x68, 0x10, 0xb4, 0x6a, 0x69, 0x05, 0x42, 0xc5,
+	0x20, 0x24, 0xd8, 0x44, 0xb6, 0xe3, 0x3a, 0x16, 0x8d, 0x9d, 0x66, 0x9c, 0x8a, 0x08, 0x75, 0x03,
+	0x6b, 0x24, 0x24, 0x76, 0x6c, 0xf9, 0x03, 0x88, 0xbf, 0xc0, 0xa6, 0xcb, 0x4a, 0x20, 0x81, 0x84,
+	0x84, 0x50, 0xcb, 0x2f, 0x40, 0x62, 0x8f, 0x3c, 0x33, 0x8e, 0x3f, 0x92, 0xd4, 0x69, 0xd4, 0xb2,
+	0xaa, 0x7d, 0xef, 0xdc, 0xb9, 0xe7, 0x9c, 0x7b, 0x73, 0xac, 0x82, 0x68, 0xeb, 0x86, 0x62, 0xb8,
+	0x8e, 0x63, 0x1a, 0x9e, 0xed, 0x3a, 0xca, 0x49, 0xcf, 0xec, 0xf6, 0xe5, 0x4e, 0xd7, 0xf5, 0x5c,
+	0x3c, 0x6f, 0xeb, 0x86, 0x1c, 0xe6, 0xc4, 0xa2, 0xe5, 0x5a, 0x2e, 0x4d, 0x29, 0xfe, 0x13, 0x3b,
+	0x25, 0xbe, 0x64, 0xb8, 0xa4, 0xed, 0x12, 0x45, 0xd7, 0x88, 0xc9, 0xca, 0x95, 0xd3, 0x35, 0xdd,
+	0xf4, 0xb4, 0x35, 0xa5, 0xa3, 0x59, 0xb6, 0xa3, 0xf9, 0xb5, 0xfc, 0xec, 0x22, 0xed, 0x76, 0x6c,
+	0x9b, 0x8e, 0xc7, 0xff, 0xf0, 0xc4, 0xc3, 0x04, 0x8c, 0xf0, 0x91, 0x1f, 0x78, 0xc1, 0x72, 0x5d,
+	0xeb, 0xd8, 0x54, 0xb4, 0x8e, 0xad, 0x68, 0x8e, 0xe3, 0x7a, 0xf4, 0x5a, 0xc2, 0xb3, 0xcf, 0xf1,
+	0x2c, 0x7d, 0xd3, 0x7b, 0x47, 0x8a, 0xe6, 0x70, 0x12, 0x52, 0x1d, 0x1e, 0x7c, 0xe8, 0x83, 0xda,
+	0x19, 0xdc, 0xa8, 0x9a, 0x27, 0x3d, 0x93, 0x78, 0xf8, 0x11, 0x3c, 0x1d, 0xb6, 0x69, 0xd8, 0x4d,
+	0x01, 0x95, 0x51, 0x25, 0xaf, 0xce, 0x85, 0xc1, 0x83, 0xa6, 0xf4, 0x23, 0x82, 0xc5, 0xa1, 0x7a,
+	0xd2, 0x71, 0x1d, 0x62, 0xe2, 0x3a, 0x40, 0x78, 0x96, 0x56, 0x17, 0x6a, 0x4b, 0x72, 0x5c, 0x34,
+	0x39, 0xac, 0xdb, 0x75, 0x9a, 0x6a, 0xa4, 0x00, 0x17, 0x61, 0xb6, 0xd3, 0x75, 0xdd, 0x23, 0x21,
+	0x53, 0x46, 0x95, 0x39, 0x95, 0xbd, 0xe0, 0x25, 0x00, 0xfa, 0xd0, 0xe8, 0x68, 0x5e, 0x4b, 0xc8,
+	0x52, 0x48, 0x79, 0x1a, 0x39, 0xd4, 0xbc, 0x16, 0x5e, 0x86, 0x39, 0x96, 0x6e, 0x99, 0xb6, 0xd5,
+	0xf2, 0x84, 0x5c, 0x19, 0x55, 0x72, 0x6a, 0x81, 0xc6, 0xf6, 0x69, 0x48, 0xd2, 0x86, 0x10, 0x93,
+	0x80, 0xf2, 0x1e, 0x40, 0x38, 0x13, 0x8e, 0x78, 0x45, 0x66, 0x03, 0x94, 0xfd, 0x01, 0xca, 0x6c,
+	0xfe, 0x7c, 0x80, 0xf2, 0xa1, 0x66, 0x99, 0xbc, 0x56, 0x8d, 0x54, 0x4a, 0x3f, 0x23, 0x10, 0x86,
+	0x7b, 0x70, 0x59, 0xf6, 0xa0, 0x10, 0xb2, 0x24, 0x02, 0x2a, 0x67, 0x2b, 0x85, 0xda, 0xe3, 0xa4,
+	0x2e, 0x07, 0x4d, 0xd3, 0xf1, 0xec, 0x23, 0xdb, 0x6c, 0x46, 0x94, 0x8d, 0x16, 0xe2, 0x77, 0x63,
+	0x60, 0x33, 0x14, 0xec, 0x6a, 0x2a, 0x58, 0x06, 0x22, 0x8a, 0x16, 0x3f, 0x80, 0x7b, 0x5c, 0x2d,
+	0x5f, 0xce, 0xac, 0xca, 0xdf, 0xa4, 0x2d, 0x58, 0x62, 0x24, 0xe8, 0x26, 0x8e, 0x90, 0xeb, 0x79,
+	0xc8, 0xb3, 0x2d, 0x0d, 0xb7, 0xe3, 0x29, 0x16, 0x38, 0x68, 0x4a, 0x3f, 0x20, 0x28, 0x8d, 0x2b,
+	0xe7, 0x4a, 0xbc, 0x08, 0xcf, 0x44, 0x36, 0xcc, 0x1f, 0x28, 0x93, 0x23, 0xaf, 0x2e, 0x84, 0x71,
+	0x7f, 0xac, 0xe4, 0xce, 0x96, 0x41, 0x87, 0xe5, 0xc4, 0xa0, 0x18, 0xdc, 0x8f, 0x3c, 0xcd, 0x0b,
+	0x46, 0x8b, 0xeb, 0x23, 0x7f, 0x09, 0xdb, 0xc2, 0x3f, 0x7f, 0x3e, 0x2c, 0xf6, 0xb5, 0xf6, 0xf1,
+	0x1b, 0x52, 0x2c, 0x2d, 0x25, 0x7e, 0x23, 0xbf, 0x21, 0x90, 0xae, 0x6b, 0xc2, 0xd5, 0xf8, 0x14,
+	0x16, 0xed, 0xc1, 0xd0, 0x1b, 0x5c, 0x58, 0xe2, 0x1f, 0xe1, 0x9b, 0xb8, 0xcc, 0x76, 0x84, 0x26,
+	0xa2, 0xfb, 0x11, 0xb9, 0xeb, 0xbe, 0x3d, 0x2a, 0x7c, 0x67, 0xea, 0x9d, 0xc1, 0xe3, 0x24, 0x31,
+	0x9f, 0x8a, 0x43, 0x7a, 0xe4, 0x16, 0x05, 0x8c, 0x2c, 0x68, 0x86, 0x62, 0x08, 0x16, 0xf4, 0x0f,
+	0x04, 0x4f, 0x52, 0xfa, 0x0f, 0xac, 0x68, 0xc1, 0x08, 0x32, 0x31, 0x4d, 0x8b, 0x32, 0xb3, 0x46,
+	0x39, 0xb0, 0x46, 0xf9, 0x1d, 0xa7, 0xaf, 0xce, 0x1b, 0xb1, 0x6b, 0xe2, 0x8b, 0x9e, 0x89, 0x2f,
+	0x7a, 0x28, 0x6e, 0x76, 0xbc, 0xb8, 0xb9, 0x34, 0x71, 0x67, 0x87, 0xc5, 0x6d, 0xc3, 0x2b, 0x09,
+	0x72, 0x9f, 0x98, 0xdd, 0xc0, 0x11, 0x6e, 0x5d, 0x64, 0x7f, 0x4b, 0xab, 0x13, 0xf6, 0xfb, 0x1f,
+	0x44, 0x5d, 0x85, 0x85, 0x53, 0xde, 0xbd, 0x11, 0x31, 0xa7, 0x9c, 0x3a, 0x1f, 0x84, 0x99, 0x4a,
+	0x13, 0x6c, 0x69, 0xed, 0xeb, 0x3c, 0xcc, 0x52, 0x66, 0xf8, 0x7b, 0x04, 0x10, 0xd2, 0xc3, 0x2b,
+	0x49, 0xd3, 0x1d, 0xfd, 0x25, 0x14, 0x57, 0x53, 0xcf, 0x31, 0x45, 0xa4, 0x37, 0xbf, 0xfa, 0xe5,
+	0xef, 0xef, 0x32, 0x1b, 0x78, 0x5d, 0x49, 0x7c, 0xaf, 0x83, 0x2f, 0x7e, 0x18, 0x22, 0xca, 0x97,
+	0xb1, 0x71, 0x9c, 0xe1, 0x6f, 0x10, 0x14, 0xc2, 0x3b, 0x09, 0x4e, 0xeb, 0x1a, 0xd8, 0xb0, 0x58,
+	0x49, 0x3f, 0xc8, 0xf1, 0xbd, 0x4c, 0xf1, 0x3d, 0xc1, 0x8f, 0x26, 0xc0, 0x87, 0x7f, 0x42, 0xf0,
+	0xec, 0x90, 0x77, 0xe3, 0xea, 0xe8, 0x66, 0x63, 0x3e, 0x11, 0xa2, 0x3c, 0xe9, 0x71, 0x8e, 0xf0,
+	0x2d, 0x8a, 0xf0, 0x75, 0xbc, 0x39, 0x16, 0x21, 0x5b, 0x99, 0xb8, 0x90, 0xc1, 0x1a, 0x9d, 0xe1,
+	0x73, 0x04, 0xf7, 0x47, 0xda, 0x2c, 0x5e, 0x4b, 0x51, 0x69, 0xd8, 0xf7, 0xc5, 0xda, 0x4d, 0x4a,
+	0x38, 0x81, 0x7d, 0x4a, 0x60, 0x1b, 0xbf, 0x3d, 0xc5, 0x0a, 0x28, 0x51, 0xd3, 0xc7, 0xbf, 0x22,
+	0x10, 0xc6, 0x19, 0x1b, 0x7e, 0x2d, 0x0d, 0xda, 0x28, 0x8b, 0x10, 0x37, 0x6e, 0x58, 0xc5, 0x39,
+	0xbd, 0x47, 0x39, 0xed, 0xe2, 0x9d, 0xa9, 0x38, 0xc5, 0x2d, 0x02, 0xff, 0x8b, 0xa0, 0x9c, 0x66,
+	0x31, 0x78, 0x2b, 0x05, 0xe8, 0xb5, 0x4e, 0x28, 0xd6, 0xa7, 0xac, 0xe6, 0x74, 0x3f, 0xa6, 0x74,
+	0x3f, 0xc0, 0xef, 0x4f, 0x43, 0x77, 0xe0, 0x5a, 0x09, 0xde, 0xdb, 0x87, 0xe7, 0x97, 0x25, 0x74,
+	0x71, 0x59, 0x42, 0x7f, 0x5d, 0x96, 0xd0, 0xb7, 0x57, 0xa5, 0x99, 0x8b, 0xab, 0xd2, 0xcc, 0xef,
+	0x57, 0xa5, 0x99, 0xcf, 0x36, 0x2d, 0xdb, 0x6b, 0xf5, 0x74, 0xd9, 0x70, 0xdb, 0x0a, 0xff, 0x67,
+	0x81, 0xfd, 0xa9, 0x92, 0xe6, 0xe7, 0xca, 0x17, 0x14, 0xc5, 0xab, 0xeb, 0xd5, 0x08, 0x10, 0xaf,
+	0xdf, 0x31, 0x89, 0x7e, 0x8f, 0xda, 0xeb, 0xfa, 0x7f, 0x03, 0x00, 0x18, 0xc6, 0xd3, 0x73, 0xa4,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -742,6 +878,10 @@ type QueryClient interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(ctx context.Context, in *QueryConnectionConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionVerifiedConsensusState queries the client consensus state against
+	// which the counterparty state of the connection was last verified during the
+	// connection handshake.
+	ConnectionVerifiedConsensusState(ctx context.Context, in *QueryConnectionVerifiedConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionVerifiedConsensusStateResponse, error)
 }
 
 type queryClient struct {
@@ -797,6 +937,15 @@ func (c *queryClient) ConnectionConsensusState(ctx context.Context, in *QueryCon
 	return out, nil
 }
 
+func (c *queryClient) ConnectionVerifiedConsensusState(ctx context.Context, in *QueryConnectionVerifiedConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionVerifiedConsensusStateResponse, error) {
+	out := new(QueryConnectionVerifiedConsensusStateResponse)
+	err := c.cc.Invoke(ctx, "/ibc.connection.Query/ConnectionVerifiedConsensusState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Connection queries an IBC connection end.
@@ -812,6 +961,10 @@ type QueryServer interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(context.Context, *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionVerifiedConsensusState queries the client consensus state against
+	// which the counterparty state of the connection was last verified during the
+	// connection handshake.
+	ConnectionVerifiedConsensusState(context.Context, *QueryConnectionVerifiedConsensusStateRequest) (*QueryConnectionVerifiedConsensusStateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -833,6 +986,9 @@ func (*UnimplementedQueryServer) ConnectionClientState(ctx context.Context, req
 func (*UnimplementedQueryServer) ConnectionConsensusState(ctx context.Context, req *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ConnectionVerifiedConsensusState(ctx context.Context, req *QueryConnectionVerifiedConsensusStateRequest) (*QueryConnectionVerifiedConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionVerifiedConsensusState not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -928,6 +1084,24 @@ func _Query_ConnectionConsensusState_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConnectionVerifiedConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionVerifiedConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionVerifiedConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.connection.Query/ConnectionVerifiedConsensusState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionVerifiedConsensusState(ctx, req.(*QueryConnectionVerifiedConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.connection.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -952,6 +1126,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionConsensusState",
 			Handler:    _Query_ConnectionConsensusState_Handler,
 		},
+		{
+			MethodName: "ConnectionVerifiedConsensusState",
+			Handler:    _Query_ConnectionVerifiedConsensusState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/connection/query.proto",
@@ -1391,6 +1569,88 @@ func (m *QueryConnectionConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConnectionVerifiedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionVerifiedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionVerifiedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VerifiedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifiedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1594,6 +1854,42 @@ func (m *QueryConnectionConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConnectionVerifiedConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionVerifiedConsensusStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VerifiedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.VerifiedHeight))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProofHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2914,6 +3210,250 @@ func (m *QueryConnectionConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConnectionVerifiedConsensusStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionVerifiedConsensusStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionVerifiedConsensusStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionVerifiedConsensusStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionVerifiedConsensusStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionVerifiedConsensusStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types1.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedHeight", wireType)
+			}
+			m.VerifiedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryChannel(),
 		GetCmdQueryConnectionChannels(),
 		GetCmdQueryChannelClientState(),
+		GetCmdResolveProofClient(),
+		GetCmdTraceChannelProofPath(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
 		GetCmdQueryUnrelayedPackets(),
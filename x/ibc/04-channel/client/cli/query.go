@@ -170,6 +170,74 @@ func GetCmdQueryChannelClientState() *cobra.Command {
 	return cmd
 }
 
+// GetCmdResolveProofClient defines the command to resolve the client that verifies
+// the packet proofs of a channel.
+func GetCmdResolveProofClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof-client [port-id] [channel-id]",
+		Short: "Resolve the client that verifies the packet proofs of a channel",
+		Long: `Resolve the connection of a channel, then the client of that connection, and print the
+client identifier along with its latest height: the context needed to verify a packet proof of the channel.`,
+		Example: fmt.Sprintf("%s query ibc channel proof-client [port-id] [channel-id]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proofClient, err := utils.ResolveProofClient(clientCtx, clientCtx.InterfaceRegistry, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutputLegacy(proofClient)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdTraceChannelProofPath defines the command to trace the path a proof of a
+// channel is verified through, down to the consensus state at a given height.
+func GetCmdTraceChannelProofPath() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace-proof-path [port-id] [channel-id] [height]",
+		Short: "Trace the channel, connection, client and consensus state a proof of a channel is verified through",
+		Long: `Resolve the connection of a channel, then the client of that connection, and print each hop along
+with whether the client stores a consensus state at the given proof height. This helps to find which hop
+makes a proof of the channel fail.`,
+		Example: fmt.Sprintf("%s query %s %s trace-proof-path [port-id] [channel-id] [height]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expected integer height, got: %s", args[2])
+			}
+
+			path, err := utils.TraceProofPath(clientCtx, clientCtx.InterfaceRegistry, args[0], args[1], height)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutputLegacy(path)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPacketCommitments defines the command to query all packet commitments associated with
 // a channel
 func GetCmdQueryPacketCommitments() *cobra.Command {
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"context"
+
+	grpc1 "github.com/gogo/protobuf/grpc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ProofClient is the client that verifies the packet proofs of a channel, along
+// with the connection the channel is resolved through.
+type ProofClient struct {
+	PortID       string `json:"port_id" yaml:"port_id"`
+	ChannelID    string `json:"channel_id" yaml:"channel_id"`
+	ConnectionID string `json:"connection_id" yaml:"connection_id"`
+	ClientID     string `json:"client_id" yaml:"client_id"`
+	LatestHeight uint64 `json:"latest_height" yaml:"latest_height"`
+}
+
+// ResolveProofClient resolves the connection of a channel, then the client of the
+// connection, and returns the client along with its latest height. The client
+// state returned by the gRPC query is unpacked with the given unpacker.
+func ResolveProofClient(
+	conn grpc1.ClientConn, unpacker codectypes.AnyUnpacker, portID, channelID string,
+) (ProofClient, error) {
+	channelRes, err := types.NewQueryClient(conn).Channel(context.Background(), &types.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return ProofClient{}, err
+	}
+
+	if len(channelRes.Channel.ConnectionHops) == 0 {
+		return ProofClient{}, sdkerrors.Wrapf(types.ErrInvalidChannel, "channel %s/%s has no connection hops", portID, channelID)
+	}
+	connectionID := channelRes.Channel.ConnectionHops[0]
+
+	connectionRes, err := connectiontypes.NewQueryClient(conn).Connection(context.Background(), &connectiontypes.QueryConnectionRequest{
+		ConnectionId: connectionID,
+	})
+	if err != nil {
+		return ProofClient{}, err
+	}
+	clientID := connectionRes.Connection.ClientId
+
+	clientRes, err := clienttypes.NewQueryClient(conn).ClientState(context.Background(), &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return ProofClient{}, err
+	}
+
+	var clientState exported.ClientState
+	if err := unpacker.UnpackAny(clientRes.ClientState, &clientState); err != nil {
+		return ProofClient{}, err
+	}
+
+	return ProofClient{
+		PortID:       portID,
+		ChannelID:    channelID,
+		ConnectionID: connectionID,
+		ClientID:     clientID,
+		LatestHeight: clientState.GetLatestHeight(),
+	}, nil
+}
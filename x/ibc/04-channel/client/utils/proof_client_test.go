@@ -0,0 +1,69 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func TestResolveProofClient(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	clientA, _, connA, _, channelA, _ := coordinator.Setup(chainA, chainB, types.UNORDERED)
+
+	ctx := chainA.GetContext()
+	registry := chainA.App.InterfaceRegistry()
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, registry)
+	ibctypes.RegisterQueryService(queryHelper, chainA.App.IBCKeeper)
+
+	clientState, found := chainA.App.IBCKeeper.ClientKeeper.GetClientState(ctx, clientA)
+	require.True(t, found)
+
+	proofClient, err := utils.ResolveProofClient(queryHelper, registry, channelA.PortID, channelA.ID)
+	require.NoError(t, err)
+	require.Equal(t, utils.ProofClient{
+		PortID:       channelA.PortID,
+		ChannelID:    channelA.ID,
+		ConnectionID: connA.ID,
+		ClientID:     clientA,
+		LatestHeight: clientState.GetLatestHeight(),
+	}, proofClient)
+
+	// channel doesn't exist
+	_, err = utils.ResolveProofClient(queryHelper, registry, channelA.PortID, "channelnotfound")
+	require.Error(t, err)
+
+	// connection of the channel doesn't exist
+	counterparty := types.NewCounterparty(ibctesting.MockPort, "counterpartychannel")
+	channel := types.NewChannel(types.OPEN, types.UNORDERED, counterparty, []string{"connectionnotfound"}, ibctesting.DefaultChannelVersion)
+	chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctesting.MockPort, "channelnoconnection", channel)
+	_, err = utils.ResolveProofClient(queryHelper, registry, ibctesting.MockPort, "channelnoconnection")
+	require.Error(t, err)
+
+	// channel without connection hops
+	channel = types.NewChannel(types.OPEN, types.UNORDERED, counterparty, nil, ibctesting.DefaultChannelVersion)
+	chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctesting.MockPort, "channelnohops", channel)
+	_, err = utils.ResolveProofClient(queryHelper, registry, ibctesting.MockPort, "channelnohops")
+	require.Error(t, err)
+
+	// client of the connection doesn't exist
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, "clientnotfound",
+		connectiontypes.NewCounterparty("counterpartyclient", "counterpartyconnection", chainB.GetPrefix()),
+		[]string{ibctesting.ConnectionVersion},
+	)
+	chainA.App.IBCKeeper.ConnectionKeeper.SetConnection(ctx, "connectionnoclient", connection)
+	channel = types.NewChannel(types.OPEN, types.UNORDERED, counterparty, []string{"connectionnoclient"}, ibctesting.DefaultChannelVersion)
+	chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctesting.MockPort, "channelnoclient", channel)
+	_, err = utils.ResolveProofClient(queryHelper, registry, ibctesting.MockPort, "channelnoclient")
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"context"
+
+	grpc1 "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ProofPath is the chain of objects a proof of a channel is verified through: the
+// channel, its connection, the client of the connection and the consensus state
+// of the client at the proof height.
+type ProofPath struct {
+	PortID          string `json:"port_id" yaml:"port_id"`
+	ChannelID       string `json:"channel_id" yaml:"channel_id"`
+	ChannelState    string `json:"channel_state" yaml:"channel_state"`
+	ConnectionID    string `json:"connection_id" yaml:"connection_id"`
+	ConnectionState string `json:"connection_state" yaml:"connection_state"`
+	ClientID        string `json:"client_id" yaml:"client_id"`
+	ClientType      string `json:"client_type" yaml:"client_type"`
+	LatestHeight    uint64 `json:"latest_height" yaml:"latest_height"`
+	FrozenHeight    uint64 `json:"frozen_height" yaml:"frozen_height"`
+	Height          uint64 `json:"height" yaml:"height"`
+	// ConsensusStateFound is true if the client stores a consensus state at the height
+	ConsensusStateFound     bool   `json:"consensus_state_found" yaml:"consensus_state_found"`
+	ConsensusStateTimestamp uint64 `json:"consensus_state_timestamp,omitempty" yaml:"consensus_state_timestamp,omitempty"`
+}
+
+// TraceProofPath resolves the connection of a channel, then the client of the
+// connection and finally the consensus state of the client at the given height,
+// and returns each hop of the path. A missing channel, connection or client
+// returns an error, while a missing consensus state is reported in the path, as
+// it is the usual reason for a proof of the channel to fail. The client and
+// consensus states returned by the gRPC queries are unpacked with the given
+// unpacker.
+func TraceProofPath(
+	conn grpc1.ClientConn, unpacker codectypes.AnyUnpacker, portID, channelID string, height uint64,
+) (ProofPath, error) {
+	channelRes, err := types.NewQueryClient(conn).Channel(context.Background(), &types.QueryChannelRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return ProofPath{}, err
+	}
+
+	if len(channelRes.Channel.ConnectionHops) == 0 {
+		return ProofPath{}, sdkerrors.Wrapf(types.ErrInvalidChannel, "channel %s/%s has no connection hops", portID, channelID)
+	}
+	connectionID := channelRes.Channel.ConnectionHops[0]
+
+	connectionRes, err := connectiontypes.NewQueryClient(conn).Connection(context.Background(), &connectiontypes.QueryConnectionRequest{
+		ConnectionId: connectionID,
+	})
+	if err != nil {
+		return ProofPath{}, err
+	}
+	clientID := connectionRes.Connection.ClientId
+
+	clientQueryClient := clienttypes.NewQueryClient(conn)
+
+	clientRes, err := clientQueryClient.ClientState(context.Background(), &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return ProofPath{}, err
+	}
+
+	var clientState exported.ClientState
+	if err := unpacker.UnpackAny(clientRes.ClientState, &clientState); err != nil {
+		return ProofPath{}, err
+	}
+
+	path := ProofPath{
+		PortID:          portID,
+		ChannelID:       channelID,
+		ChannelState:    channelRes.Channel.State.String(),
+		ConnectionID:    connectionID,
+		ConnectionState: connectionRes.Connection.State.String(),
+		ClientID:        clientID,
+		ClientType:      clientState.ClientType().String(),
+		LatestHeight:    clientState.GetLatestHeight(),
+		FrozenHeight:    clientState.GetFrozenHeight(),
+		Height:          height,
+	}
+
+	consensusStateRes, err := clientQueryClient.ConsensusState(context.Background(), &clienttypes.QueryConsensusStateRequest{
+		ClientId: clientID,
+		Height:   height,
+	})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return path, nil
+	case err != nil:
+		return ProofPath{}, err
+	}
+
+	var consensusState exported.ConsensusState
+	if err := unpacker.UnpackAny(consensusStateRes.ConsensusState, &consensusState); err != nil {
+		return ProofPath{}, err
+	}
+
+	path.ConsensusStateFound = true
+	path.ConsensusStateTimestamp = consensusState.GetTimestamp()
+
+	return path, nil
+}
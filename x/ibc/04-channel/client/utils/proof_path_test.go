@@ -0,0 +1,76 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+func TestTraceProofPath(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+	clientA, _, connA, _, channelA, _ := coordinator.Setup(chainA, chainB, types.UNORDERED)
+
+	ctx := chainA.GetContext()
+	registry := chainA.App.InterfaceRegistry()
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, registry)
+	ibctypes.RegisterQueryService(queryHelper, chainA.App.IBCKeeper)
+
+	clientState, found := chainA.App.IBCKeeper.ClientKeeper.GetClientState(ctx, clientA)
+	require.True(t, found)
+	height := clientState.GetLatestHeight()
+	consensusState, found := chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, clientA, height)
+	require.True(t, found)
+
+	expPath := utils.ProofPath{
+		PortID:                  channelA.PortID,
+		ChannelID:               channelA.ID,
+		ChannelState:            types.OPEN.String(),
+		ConnectionID:            connA.ID,
+		ConnectionState:         connectiontypes.OPEN.String(),
+		ClientID:                clientA,
+		ClientType:              clientState.ClientType().String(),
+		LatestHeight:            height,
+		Height:                  height,
+		ConsensusStateFound:     true,
+		ConsensusStateTimestamp: consensusState.GetTimestamp(),
+	}
+
+	path, err := utils.TraceProofPath(queryHelper, registry, channelA.PortID, channelA.ID, height)
+	require.NoError(t, err)
+	require.Equal(t, expPath, path)
+
+	// the client has no consensus state at the height: the path is still traced
+	path, err = utils.TraceProofPath(queryHelper, registry, channelA.PortID, channelA.ID, height+100)
+	require.NoError(t, err)
+	expPath.Height = height + 100
+	expPath.ConsensusStateFound = false
+	expPath.ConsensusStateTimestamp = 0
+	require.Equal(t, expPath, path)
+
+	// channel doesn't exist
+	_, err = utils.TraceProofPath(queryHelper, registry, channelA.PortID, "channelnotfound", height)
+	require.Error(t, err)
+
+	// client of the connection doesn't exist
+	counterparty := types.NewCounterparty(ibctesting.MockPort, "counterpartychannel")
+	connection := connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, "clientnotfound",
+		connectiontypes.NewCounterparty("counterpartyclient", "counterpartyconnection", chainB.GetPrefix()),
+		[]string{ibctesting.ConnectionVersion},
+	)
+	chainA.App.IBCKeeper.ConnectionKeeper.SetConnection(ctx, "connectionnoclient", connection)
+	channel := types.NewChannel(types.OPEN, types.UNORDERED, counterparty, []string{"connectionnoclient"}, ibctesting.DefaultChannelVersion)
+	chainA.App.IBCKeeper.ChannelKeeper.SetChannel(ctx, ibctesting.MockPort, "channelnoclient", channel)
+	_, err = utils.TraceProofPath(queryHelper, registry, ibctesting.MockPort, "channelnoclient", height)
+	require.Error(t, err)
+}
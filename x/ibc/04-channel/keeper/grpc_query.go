@@ -364,6 +364,25 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(req.PortId, req.ChannelId, sequence, nil, ctx.BlockHeight()), nil
 }
 
+// ConsensusStatePrunable implements the Query/ConsensusStatePrunable gRPC method
+func (q Keeper) ConsensusStatePrunable(c context.Context, req *types.QueryConsensusStatePrunableRequest) (*types.QueryConsensusStatePrunableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prunable, reason := q.CanPruneConsensusState(ctx, req.ClientId, clienttypes.NewHeight(req.EpochNumber, req.EpochHeight))
+
+	return &types.QueryConsensusStatePrunableResponse{
+		Prunable: prunable,
+		Reason:   reason,
+	}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
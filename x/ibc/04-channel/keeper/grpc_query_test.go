@@ -1109,3 +1109,82 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryConsensusStatePrunable() {
+	var (
+		req         *types.QueryConsensusStatePrunableRequest
+		expPrunable bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req = &types.QueryConsensusStatePrunableRequest{
+					ClientId:    "",
+					EpochHeight: 1,
+				}
+			},
+			false,
+		},
+		{
+			"latest consensus state",
+			func() {
+				clientA, _, _, _, _, _ := suite.coordinator.Setup(suite.chainA, suite.chainB, types.UNORDERED)
+				expPrunable = false
+
+				req = &types.QueryConsensusStatePrunableRequest{
+					ClientId:    clientA,
+					EpochHeight: suite.chainA.GetClientState(clientA).GetLatestHeight(),
+				}
+			},
+			true,
+		},
+		{
+			"prunable consensus state",
+			func() {
+				clientA, _, _, _, _, _ := suite.coordinator.Setup(suite.chainA, suite.chainB, types.UNORDERED)
+				height := suite.chainA.GetClientState(clientA).GetLatestHeight()
+				suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, clientA, exported.Tendermint))
+				expPrunable = true
+
+				req = &types.QueryConsensusStatePrunableRequest{
+					ClientId:    clientA,
+					EpochHeight: height,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ConsensusStatePrunable(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expPrunable, res.Prunable)
+				suite.Require().Equal(expPrunable, res.Reason == "")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
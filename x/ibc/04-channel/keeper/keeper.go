@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
@@ -318,6 +319,65 @@ func (k Keeper) LookupModuleByChannel(ctx sdk.Context, portID, channelID string)
 	return porttypes.GetModuleOwner(modules), cap, nil
 }
 
+// CanPruneConsensusState returns whether the consensus state of a client at the
+// given height can be pruned without breaking relaying. If it can't, the reason
+// is returned. The latest consensus state of a client is never prunable as it's
+// needed to update the client. The other consensus states aren't prunable while
+// a channel on a connection using the client has in-flight packets, as the proofs
+// of their acknowledgement or timeout may have been built at any height the client
+// tracks.
+func (k Keeper) CanPruneConsensusState(ctx sdk.Context, clientID string, height clienttypes.Height) (bool, string) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return false, fmt.Sprintf("client %s not found", clientID)
+	}
+
+	// consensus states are stored by epoch height, so the epoch of the one found
+	// must match as well
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height.EpochHeight)
+	if !found || !clienttypes.ConsensusStateEpochHeight(consensusState, height.EpochHeight).EQ(height) {
+		return false, fmt.Sprintf("client %s has no consensus state at height %s", clientID, height)
+	}
+
+	latestHeight := clienttypes.NewHeight(0, clientState.GetLatestHeight())
+	if latestConsensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight()); found {
+		latestHeight = clienttypes.ConsensusStateEpochHeight(latestConsensusState, clientState.GetLatestHeight())
+	}
+
+	if height.EQ(latestHeight) {
+		return false, fmt.Sprintf("consensus state at height %s is the latest consensus state of client %s", height, clientID)
+	}
+
+	var reason string
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found || connection.GetClientID() != clientID {
+			return false
+		}
+
+		var inFlight uint64
+		k.IteratePacketCommitmentAtChannel(ctx, channel.PortId, channel.ChannelId, func(_, _ string, _ uint64, _ []byte) bool {
+			inFlight++
+			return false
+		})
+
+		if inFlight > 0 {
+			reason = fmt.Sprintf(
+				"channel %s/%s on connection %s has %d in-flight packet(s) whose proofs may be verified against the consensus state",
+				channel.PortId, channel.ChannelId, channel.ConnectionHops[0], inFlight,
+			)
+			return true
+		}
+		return false
+	})
+
+	if reason != "" {
+		return false, reason
+	}
+
+	return true, ""
+}
+
 // common functionality for IteratePacketCommitment and IteratePacketAcknowledgement
 func (k Keeper) iterateHashes(_ sdk.Context, iterator db.Iterator, cb func(portID, channelID string, sequence uint64, hash []byte) bool) {
 	defer iterator.Close()
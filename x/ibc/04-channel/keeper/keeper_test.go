@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
@@ -302,3 +303,60 @@ func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
 	suite.True(found)
 	suite.Equal(ackHash, storedAckHash)
 }
+
+// TestCanPruneConsensusState tests that consensus states are only prunable when no
+// channel on a connection using the client has in-flight packets.
+func (suite *KeeperTestSuite) TestCanPruneConsensusState() {
+	var (
+		clientA  string
+		channelA ibctesting.TestChannel
+		height   clienttypes.Height
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPrune  bool
+		expReason string
+	}{
+		{"safe prune", func() {}, true, ""},
+		{"client not found", func() {
+			clientA = ibctesting.InvalidID
+		}, false, "not found"},
+		{"consensus state not found", func() {
+			height = clienttypes.NewHeight(0, height.EpochHeight+1000)
+		}, false, "no consensus state"},
+		{"consensus state of another epoch", func() {
+			height = clienttypes.NewHeight(height.EpochNumber+1, height.EpochHeight)
+		}, false, "no consensus state"},
+		{"latest consensus state", func() {
+			clientState := suite.chainA.GetClientState(clientA)
+			height = clienttypes.NewHeight(0, clientState.GetLatestHeight())
+		}, false, "latest consensus state"},
+		{"in-flight packet on an active channel", func() {
+			suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), channelA.PortID, channelA.ID, 1, []byte("commitment"))
+		}, false, "1 in-flight packet(s)"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			clientA, _, _, _, channelA, _ = suite.coordinator.Setup(suite.chainA, suite.chainB, types.UNORDERED)
+
+			// the consensus state at the current latest height becomes prunable
+			// once the client is updated
+			height = clienttypes.NewHeight(0, suite.chainA.GetClientState(clientA).GetLatestHeight())
+			suite.Require().NoError(suite.coordinator.UpdateClient(suite.chainA, suite.chainB, clientA, exported.Tendermint))
+
+			tc.malleate()
+
+			canPrune, reason := suite.chainA.App.IBCKeeper.ChannelKeeper.CanPruneConsensusState(suite.chainA.GetContext(), clientA, height)
+			suite.Require().Equal(tc.expPrune, canPrune)
+			if tc.expPrune {
+				suite.Require().Empty(reason)
+			} else {
+				suite.Require().Contains(reason, tc.expReason)
+			}
+		})
+	}
+}
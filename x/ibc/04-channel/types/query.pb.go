@@ -1340,6 +1340,127 @@ func (m *QueryNextSequenceReceiveResponse) GetProofHeight() uint64 {
 	return 0
 }
 
+// QueryConsensusStatePrunableRequest is the request type for the
+// Query/ConsensusStatePrunable RPC method
+type QueryConsensusStatePrunableRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// epoch number of the consensus state height
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// epoch height of the consensus state height
+	EpochHeight uint64 `protobuf:"varint,3,opt,name=epoch_height,json=epochHeight,proto3" json:"epoch_height,omitempty"`
+}
+
+func (m *QueryConsensusStatePrunableRequest) Reset()         { *m = QueryConsensusStatePrunableRequest{} }
+func (m *QueryConsensusStatePrunableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStatePrunableRequest) ProtoMessage()    {}
+func (*QueryConsensusStatePrunableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2150995751d4f15a, []int{20}
+}
+func (m *QueryConsensusStatePrunableRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStatePrunableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStatePrunableRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStatePrunableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStatePrunableRequest.Merge(m, src)
+}
+func (m *QueryConsensusStatePrunableRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStatePrunableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStatePrunableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStatePrunableRequest proto.InternalMessageInfo
+
+func (m *QueryConsensusStatePrunableRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConsensusStatePrunableRequest) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *QueryConsensusStatePrunableRequest) GetEpochHeight() uint64 {
+	if m != nil {
+		return m.EpochHeight
+	}
+	return 0
+}
+
+// QueryConsensusStatePrunableResponse is the response type for the
+// Query/ConsensusStatePrunable RPC method
+type QueryConsensusStatePrunableResponse struct {
+	// whether the consensus state can be pruned
+	Prunable bool `protobuf:"varint,1,opt,name=prunable,proto3" json:"prunable,omitempty"`
+	// reason why the consensus state can't be pruned, if any
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryConsensusStatePrunableResponse) Reset()         { *m = QueryConsensusStatePrunableResponse{} }
+func (m *QueryConsensusStatePrunableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusStatePrunableResponse) ProtoMessage()    {}
+func (*QueryConsensusStatePrunableResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2150995751d4f15a, []int{21}
+}
+func (m *QueryConsensusStatePrunableResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusStatePrunableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusStatePrunableResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusStatePrunableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusStatePrunableResponse.Merge(m, src)
+}
+func (m *QueryConsensusStatePrunableResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusStatePrunableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusStatePrunableResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsensusStatePrunableResponse proto.InternalMessageInfo
+
+func (m *QueryConsensusStatePrunableResponse) GetPrunable() bool {
+	if m != nil {
+		return m.Prunable
+	}
+	return false
+}
+
+func (m *QueryConsensusStatePrunableResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.channel.QueryChannelRequest")
 	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.channel.QueryChannelResponse")
@@ -1361,91 +1482,100 @@ func init() {
 	proto.RegisterType((*QueryUnrelayedPacketsResponse)(nil), "ibc.channel.QueryUnrelayedPacketsResponse")
 	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.channel.QueryNextSequenceReceiveRequest")
 	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.channel.QueryNextSequenceReceiveResponse")
+	proto.RegisterType((*QueryConsensusStatePrunableRequest)(nil), "ibc.channel.QueryConsensusStatePrunableRequest")
+	proto.RegisterType((*QueryConsensusStatePrunableResponse)(nil), "ibc.channel.QueryConsensusStatePrunableResponse")
 }
 
 func init() { proto.RegisterFile("ibc/channel/query.proto", fileDescriptor_2150995751d4f15a) }
 
 var fileDescriptor_2150995751d4f15a = []byte{
-	// 1250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xef, 0xd8, 0xce, 0xaf, 0x97, 0xe8, 0x9b, 0x7c, 0x27, 0x09, 0x49, 0xdd, 0xc4, 0x75, 0x56,
-	0x02, 0x42, 0x4a, 0x76, 0x69, 0x8a, 0x10, 0xaa, 0xa0, 0x52, 0x13, 0x89, 0x36, 0x48, 0xa1, 0xe9,
-	0x46, 0x91, 0x9a, 0x22, 0x61, 0xd6, 0xeb, 0x89, 0xbd, 0x4a, 0xb2, 0xbb, 0xf5, 0xae, 0xdb, 0x44,
-	0x91, 0x2f, 0x9c, 0x38, 0xa2, 0x72, 0xe0, 0x82, 0xe8, 0xad, 0x12, 0x17, 0xb8, 0x70, 0x46, 0x88,
-	0x1f, 0x12, 0x12, 0x97, 0x4a, 0x05, 0xa9, 0x47, 0x94, 0xf0, 0x87, 0xa0, 0x9d, 0x79, 0xbb, 0xde,
-	0x5d, 0xef, 0xba, 0x56, 0x1c, 0x1f, 0x38, 0x39, 0xf3, 0xe6, 0xcd, 0xbc, 0xcf, 0xe7, 0x33, 0x6f,
-	0xde, 0xbc, 0x0d, 0xcc, 0x18, 0x65, 0x5d, 0xd1, 0x6b, 0x9a, 0x69, 0xb2, 0x7d, 0xe5, 0x41, 0x83,
-	0xd5, 0x8f, 0x64, 0xbb, 0x6e, 0xb9, 0x16, 0x1d, 0x35, 0xca, 0xba, 0x8c, 0x13, 0x79, 0xe1, 0xb5,
-	0x6f, 0x30, 0xd3, 0xc5, 0x1f, 0xe1, 0x95, 0x5f, 0xd2, 0x2d, 0xe7, 0xc0, 0x72, 0x94, 0xb2, 0xe6,
-	0x30, 0xb1, 0x5c, 0x79, 0x78, 0xb5, 0xcc, 0x5c, 0xed, 0xaa, 0x62, 0x6b, 0x55, 0xc3, 0xd4, 0x5c,
-	0xc3, 0x32, 0xd1, 0xf7, 0x62, 0x38, 0x14, 0xfe, 0xe2, 0xd4, 0x5c, 0xd5, 0xb2, 0xaa, 0xfb, 0x4c,
-	0xd1, 0x6c, 0x43, 0xd1, 0x4c, 0xd3, 0x72, 0xf9, 0x3a, 0xc7, 0x5f, 0x88, 0xb3, 0x7c, 0x54, 0x6e,
-	0xec, 0x2a, 0x9a, 0x89, 0x28, 0xa5, 0x0d, 0x98, 0xbc, 0xeb, 0x45, 0x5d, 0x13, 0xdb, 0xa9, 0xec,
-	0x41, 0x83, 0x39, 0x2e, 0x9d, 0x81, 0x21, 0xdb, 0xaa, 0xbb, 0x25, 0xa3, 0x32, 0x4b, 0x8a, 0x64,
-	0x71, 0x44, 0x1d, 0xf4, 0x86, 0xeb, 0x15, 0x3a, 0x0f, 0x80, 0x91, 0xbd, 0xb9, 0x0c, 0x9f, 0x1b,
-	0x41, 0xcb, 0x7a, 0x45, 0xfa, 0x86, 0xc0, 0x54, 0x74, 0x3f, 0xc7, 0xb6, 0x4c, 0x87, 0x51, 0x19,
-	0x86, 0xd0, 0x8b, 0x6f, 0x38, 0xba, 0x32, 0x25, 0x87, 0xf4, 0x91, 0x7d, 0x77, 0xdf, 0x89, 0x4e,
-	0xc1, 0x80, 0x5d, 0xb7, 0xac, 0x5d, 0x1e, 0x62, 0x4c, 0x15, 0x03, 0x2f, 0x3a, 0xff, 0xa3, 0x64,
-	0x6b, 0x6e, 0x6d, 0x36, 0x2b, 0xa2, 0x73, 0xcb, 0xa6, 0xe6, 0xd6, 0xe8, 0x02, 0x8c, 0x89, 0xe9,
-	0x1a, 0x33, 0xaa, 0x35, 0x77, 0x36, 0x57, 0x24, 0x8b, 0x39, 0x75, 0x94, 0xdb, 0x6e, 0x73, 0x93,
-	0xf4, 0x49, 0x14, 0x9f, 0xe3, 0x13, 0xfe, 0x00, 0xa0, 0xa5, 0x37, 0x42, 0x7c, 0x4d, 0x16, 0x87,
-	0x23, 0x7b, 0x87, 0x23, 0x8b, 0xb3, 0xc5, 0xc3, 0x91, 0x37, 0xb5, 0x2a, 0xc3, 0xb5, 0x6a, 0x68,
-	0xa5, 0xf4, 0x03, 0x81, 0xe9, 0x58, 0x00, 0x54, 0xe0, 0x3a, 0x0c, 0x23, 0x39, 0x67, 0x96, 0x14,
-	0xb3, 0x8b, 0xa3, 0x2b, 0x85, 0x88, 0x04, 0xeb, 0x15, 0x66, 0xba, 0xc6, 0xae, 0xc1, 0x2a, 0xbe,
-	0x18, 0x81, 0x3f, 0xbd, 0x15, 0x41, 0x97, 0xe1, 0xe8, 0x5e, 0x7f, 0x29, 0x3a, 0x11, 0x38, 0x0c,
-	0x8f, 0xbe, 0x02, 0x83, 0xa8, 0x8d, 0x27, 0x5e, 0x56, 0xc5, 0x91, 0xf4, 0x39, 0x81, 0x82, 0x80,
-	0x6d, 0x99, 0x26, 0xd3, 0x3d, 0xdf, 0xb8, 0x42, 0x05, 0x00, 0x3d, 0x98, 0xc4, 0xac, 0x08, 0x59,
-	0x62, 0x0a, 0x66, 0xce, 0xac, 0xe0, 0x8f, 0x04, 0x2e, 0xa7, 0x42, 0xf9, 0x2f, 0x68, 0x79, 0xcf,
-	0x97, 0x52, 0x44, 0x5c, 0xe3, 0xd7, 0x7d, 0xcb, 0xd5, 0x5c, 0xd6, 0xeb, 0xed, 0xfa, 0x33, 0x90,
-	0x26, 0x61, 0x6b, 0x94, 0x66, 0x07, 0x66, 0x8c, 0x80, 0x7d, 0x49, 0xd4, 0x9a, 0x92, 0xe3, 0xb9,
-	0x60, 0x56, 0x2f, 0x08, 0xa5, 0x44, 0x11, 0x0a, 0x09, 0x15, 0xda, 0x6b, 0xda, 0x48, 0x32, 0xf7,
-	0xed, 0x4e, 0x3a, 0xb0, 0x10, 0x61, 0xe5, 0xf1, 0x30, 0x9d, 0x86, 0x73, 0x1e, 0x9a, 0xc5, 0x4e,
-	0x29, 0x17, 0x9c, 0xd2, 0x0b, 0x02, 0x52, 0xa7, 0xa8, 0x28, 0xe7, 0xfb, 0x30, 0xae, 0xfb, 0x33,
-	0x11, 0x19, 0xa7, 0x64, 0x51, 0x54, 0x65, 0xbf, 0xa8, 0xca, 0x37, 0xcd, 0x23, 0xf5, 0x7f, 0x7a,
-	0x64, 0x1b, 0x7a, 0x09, 0x46, 0xf0, 0x08, 0x02, 0x6c, 0xc3, 0xc2, 0xb0, 0x5e, 0x69, 0xe9, 0x99,
-	0x4d, 0xd7, 0x33, 0xf7, 0x32, 0x3d, 0x07, 0xda, 0xf5, 0xac, 0xc3, 0x1c, 0x67, 0xb6, 0xa9, 0xe9,
-	0x7b, 0xcc, 0x5d, 0xb3, 0x0e, 0x0e, 0x0c, 0xf7, 0x80, 0x99, 0x6e, 0xaf, 0x52, 0xe6, 0x61, 0xd8,
-	0xf1, 0xb6, 0x30, 0x75, 0x86, 0x62, 0x06, 0x63, 0xe9, 0x2b, 0x02, 0xf3, 0x29, 0x41, 0x51, 0x49,
-	0x5e, 0x3f, 0x7c, 0x2b, 0x0f, 0x3c, 0xa6, 0x86, 0x2c, 0x7d, 0xcb, 0xae, 0x27, 0x69, 0xc8, 0x9c,
-	0x5e, 0xf5, 0x88, 0x56, 0xbc, 0xec, 0x99, 0x2b, 0xde, 0x6f, 0x7e, 0xf1, 0x4d, 0x40, 0x88, 0xe2,
-	0xad, 0xc2, 0x68, 0x4b, 0x2a, 0xbf, 0xe6, 0x15, 0x23, 0x35, 0x4f, 0x2c, 0xbe, 0xa9, 0xef, 0x85,
-	0xb4, 0x0f, 0x2f, 0xea, 0x7f, 0xe1, 0x7b, 0x84, 0xf7, 0x38, 0x40, 0x62, 0x5a, 0x8f, 0xf6, 0x59,
-	0xa5, 0xca, 0xfa, 0x9d, 0x7c, 0x4f, 0xfd, 0xbb, 0x9c, 0x12, 0x19, 0x45, 0x5c, 0x84, 0x71, 0x2d,
-	0x3a, 0x85, 0x69, 0x18, 0x37, 0xf7, 0x2d, 0x17, 0x7f, 0x26, 0x78, 0x35, 0xb7, 0xcd, 0x3a, 0xdb,
-	0xd7, 0x8e, 0x58, 0x45, 0x20, 0xee, 0x39, 0x15, 0x6f, 0xc0, 0x25, 0x9b, 0xef, 0x54, 0x6a, 0x9d,
-	0x78, 0xc9, 0xd7, 0xc7, 0x99, 0xcd, 0x16, 0xb3, 0x8b, 0x39, 0xf5, 0xa2, 0x1d, 0xcb, 0xaf, 0x2d,
-	0xdf, 0x81, 0x2e, 0xc1, 0x44, 0x4c, 0x03, 0x87, 0xe3, 0x1f, 0x56, 0xdb, 0xec, 0xd2, 0x36, 0xde,
-	0xa7, 0x76, 0x0e, 0xa8, 0xf3, 0x1c, 0x8c, 0xb4, 0x42, 0x13, 0x1e, 0xba, 0x65, 0x08, 0x65, 0x4f,
-	0x26, 0x92, 0x3d, 0x3b, 0xf8, 0xb6, 0x7d, 0xc4, 0x0e, 0x03, 0x60, 0x2a, 0xd3, 0x99, 0xf1, 0xb0,
-	0xe7, 0x77, 0xf3, 0x3b, 0x02, 0xc5, 0xf4, 0xbd, 0x11, 0xf5, 0x0a, 0x4c, 0x9b, 0xec, 0xb0, 0xa5,
-	0x5a, 0xa9, 0x2e, 0x1c, 0x78, 0xa8, 0x9c, 0x3a, 0x69, 0xb6, 0xaf, 0xed, 0x57, 0x9e, 0xac, 0x7c,
-	0x3b, 0x0e, 0x03, 0x1c, 0x30, 0x7d, 0x4c, 0x60, 0x08, 0x5f, 0x28, 0x1a, 0xbd, 0xef, 0x09, 0x6d,
-	0x7b, 0x7e, 0xa1, 0x83, 0x87, 0xa0, 0x29, 0xad, 0x7e, 0xf6, 0xfc, 0x9f, 0x2f, 0x33, 0xef, 0xd1,
-	0xeb, 0x4a, 0xf8, 0x6b, 0xc2, 0xff, 0xe6, 0xf0, 0xbb, 0x24, 0xe5, 0xb8, 0xa5, 0x67, 0x53, 0xf1,
-	0x54, 0x76, 0x94, 0x63, 0xd4, 0xbe, 0x49, 0x0f, 0x61, 0xd8, 0x6f, 0xc9, 0x68, 0x7a, 0x48, 0x3f,
-	0xa9, 0xf3, 0x52, 0x27, 0x17, 0x84, 0xf5, 0x2a, 0x87, 0x75, 0x99, 0xce, 0x77, 0x84, 0x45, 0xbf,
-	0x27, 0x40, 0xdb, 0xfb, 0x42, 0x7a, 0x25, 0x21, 0x42, 0x5a, 0x23, 0x9b, 0x7f, 0xb3, 0x3b, 0x67,
-	0x04, 0x76, 0x83, 0x03, 0x7b, 0x97, 0xbe, 0x93, 0x0c, 0x2c, 0x58, 0xe8, 0x49, 0x16, 0x0c, 0x9a,
-	0x2d, 0xc4, 0x3f, 0x79, 0x88, 0xdb, 0xda, 0xb5, 0x44, 0xc4, 0x69, 0xfd, 0x62, 0x22, 0xe2, 0xd4,
-	0x0e, 0x50, 0xba, 0xc3, 0x11, 0xaf, 0xd3, 0x5b, 0x67, 0x3f, 0x61, 0x25, 0xdc, 0x37, 0xd2, 0xbf,
-	0x08, 0x4c, 0x27, 0x76, 0x49, 0x54, 0x4e, 0x07, 0x96, 0xd4, 0xc4, 0xe5, 0x95, 0xae, 0xfd, 0x91,
-	0xcb, 0xc7, 0x9c, 0xcb, 0x36, 0xdd, 0xea, 0x85, 0x4b, 0xb4, 0x7f, 0x53, 0x8e, 0xc5, 0xbd, 0x6b,
-	0xd2, 0x3f, 0x08, 0x4c, 0xc4, 0x9f, 0x5c, 0xfa, 0x46, 0x3b, 0xc4, 0x94, 0x3e, 0x2a, 0xbf, 0xd4,
-	0x8d, 0x2b, 0x12, 0xf9, 0x94, 0x13, 0xb9, 0x4f, 0xef, 0xf5, 0x40, 0xa4, 0xad, 0xc2, 0x3b, 0xca,
-	0xb1, 0x5f, 0xad, 0x9a, 0xf4, 0x17, 0x02, 0xff, 0x6f, 0x6b, 0x20, 0x68, 0x17, 0x18, 0x83, 0x8b,
-	0x71, 0xa5, 0x2b, 0x5f, 0x24, 0xb4, 0xcd, 0x09, 0xdd, 0xa1, 0x1b, 0xe7, 0x4a, 0x88, 0x3e, 0x27,
-	0x30, 0x9d, 0xf8, 0x8a, 0x27, 0xe5, 0x5a, 0xa7, 0x46, 0x23, 0x29, 0xd7, 0x3a, 0xb6, 0x07, 0xd2,
-	0x0e, 0x67, 0xb4, 0x45, 0xef, 0xf6, 0xce, 0x48, 0xd3, 0xf7, 0x22, 0x67, 0xf3, 0x38, 0x03, 0x13,
-	0xf1, 0xe7, 0x32, 0x29, 0xd3, 0x52, 0xda, 0x82, 0xa4, 0x4c, 0x4b, 0x7b, 0x7d, 0xa5, 0xa7, 0x84,
-	0xf3, 0x78, 0x42, 0xe8, 0xd7, 0xe4, 0x9c, 0x93, 0xad, 0x43, 0x8b, 0x11, 0xa3, 0x1e, 0x6f, 0x1a,
-	0x9a, 0x4a, 0xc3, 0xc7, 0x5b, 0xb2, 0x91, 0xff, 0xaf, 0x04, 0x26, 0x13, 0x1e, 0x64, 0x9a, 0x50,
-	0xed, 0xd2, 0x7b, 0x82, 0xfc, 0x72, 0x97, 0xde, 0xa8, 0xce, 0x26, 0x17, 0xe7, 0x43, 0x7a, 0xbb,
-	0x07, 0x69, 0x22, 0x6d, 0xc2, 0xea, 0xc6, 0xef, 0x27, 0x05, 0xf2, 0xec, 0xa4, 0x40, 0xfe, 0x3e,
-	0x29, 0x90, 0x2f, 0x4e, 0x0b, 0x17, 0x9e, 0x9d, 0x16, 0x2e, 0xbc, 0x38, 0x2d, 0x5c, 0xb8, 0x7f,
-	0xad, 0x6a, 0xb8, 0xb5, 0x46, 0x59, 0xd6, 0xad, 0x03, 0x05, 0xff, 0xcd, 0x27, 0x7e, 0x96, 0x9d,
-	0xca, 0x9e, 0x72, 0xc8, 0x11, 0xbc, 0xf5, 0xf6, 0xb2, 0x0f, 0xc2, 0x3d, 0xb2, 0x99, 0x53, 0x1e,
-	0xe4, 0xdf, 0x93, 0xd7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x00, 0x9a, 0x4e, 0x58, 0x14,
-	0x00, 0x00,
+	// 1372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0x67, 0x62, 0x03, 0xce, 0x73, 0xf4, 0x05, 0x86, 0x04, 0xc2, 0x42, 0x8c, 0xe3, 0xaf, 0xda,
+	0xa6, 0x01, 0x76, 0x21, 0x54, 0x55, 0x85, 0x5a, 0x24, 0x40, 0x02, 0x52, 0x29, 0x10, 0x36, 0x8a,
+	0x44, 0xa8, 0x54, 0xb3, 0x5e, 0x4f, 0xec, 0x55, 0xec, 0xdd, 0xc5, 0xb3, 0x86, 0x44, 0x91, 0x2f,
+	0xbd, 0xb4, 0xc7, 0x96, 0x1e, 0x7a, 0xa9, 0xca, 0x8d, 0x63, 0x7b, 0xe9, 0xb9, 0xaa, 0xfa, 0x43,
+	0xaa, 0xd4, 0x0b, 0x12, 0xad, 0xc4, 0xb1, 0x4a, 0xda, 0xff, 0xa3, 0xda, 0xd9, 0xb7, 0xeb, 0xdd,
+	0xf5, 0xae, 0xb1, 0xe2, 0xf8, 0xd0, 0x93, 0x33, 0x6f, 0xde, 0xcc, 0xfb, 0x7c, 0x3e, 0xf3, 0xe6,
+	0xcd, 0xdb, 0xc0, 0x49, 0xa3, 0xa2, 0x2b, 0x7a, 0x5d, 0x33, 0x4d, 0xd6, 0x50, 0x1e, 0xb5, 0x59,
+	0x6b, 0x4b, 0xb6, 0x5b, 0x96, 0x63, 0xd1, 0xbc, 0x51, 0xd1, 0x65, 0x9c, 0x90, 0x3c, 0xaf, 0x86,
+	0xc1, 0x4c, 0x07, 0x7f, 0x3c, 0x2f, 0x69, 0x5e, 0xb7, 0x78, 0xd3, 0xe2, 0x4a, 0x45, 0xe3, 0xcc,
+	0x5b, 0xae, 0x3c, 0xbe, 0x54, 0x61, 0x8e, 0x76, 0x49, 0xb1, 0xb5, 0x9a, 0x61, 0x6a, 0x8e, 0x61,
+	0x99, 0xe8, 0x7b, 0x2a, 0x1c, 0x0a, 0x7f, 0x71, 0xea, 0x4c, 0xcd, 0xb2, 0x6a, 0x0d, 0xa6, 0x68,
+	0xb6, 0xa1, 0x68, 0xa6, 0x69, 0x39, 0x62, 0x1d, 0xf7, 0x17, 0xe2, 0xac, 0x18, 0x55, 0xda, 0xeb,
+	0x8a, 0x66, 0x22, 0xca, 0xd2, 0x12, 0x1c, 0xbf, 0xe7, 0x46, 0xbd, 0xe1, 0x6d, 0xa7, 0xb2, 0x47,
+	0x6d, 0xc6, 0x1d, 0x7a, 0x12, 0x0e, 0xdb, 0x56, 0xcb, 0x29, 0x1b, 0xd5, 0x69, 0x52, 0x24, 0x73,
+	0xe3, 0xea, 0x21, 0x77, 0xb8, 0x58, 0xa5, 0x33, 0x00, 0x18, 0xd9, 0x9d, 0x1b, 0x13, 0x73, 0xe3,
+	0x68, 0x59, 0xac, 0x96, 0xbe, 0x21, 0x30, 0x19, 0xdd, 0x8f, 0xdb, 0x96, 0xc9, 0x19, 0x95, 0xe1,
+	0x30, 0x7a, 0x89, 0x0d, 0xf3, 0x0b, 0x93, 0x72, 0x48, 0x1f, 0xd9, 0x77, 0xf7, 0x9d, 0xe8, 0x24,
+	0x1c, 0xb4, 0x5b, 0x96, 0xb5, 0x2e, 0x42, 0x4c, 0xa8, 0xde, 0xc0, 0x8d, 0x2e, 0xfe, 0x28, 0xdb,
+	0x9a, 0x53, 0x9f, 0xce, 0x78, 0xd1, 0x85, 0x65, 0x59, 0x73, 0xea, 0x74, 0x16, 0x26, 0xbc, 0xe9,
+	0x3a, 0x33, 0x6a, 0x75, 0x67, 0x3a, 0x5b, 0x24, 0x73, 0x59, 0x35, 0x2f, 0x6c, 0xb7, 0x85, 0xa9,
+	0xf4, 0x71, 0x14, 0x1f, 0xf7, 0x09, 0xdf, 0x04, 0xe8, 0xea, 0x8d, 0x10, 0xdf, 0x94, 0xbd, 0xc3,
+	0x91, 0xdd, 0xc3, 0x91, 0xbd, 0xb3, 0xc5, 0xc3, 0x91, 0x97, 0xb5, 0x1a, 0xc3, 0xb5, 0x6a, 0x68,
+	0x65, 0xe9, 0x7b, 0x02, 0x53, 0xb1, 0x00, 0xa8, 0xc0, 0x15, 0xc8, 0x21, 0x39, 0x3e, 0x4d, 0x8a,
+	0x99, 0xb9, 0xfc, 0x42, 0x21, 0x22, 0xc1, 0x62, 0x95, 0x99, 0x8e, 0xb1, 0x6e, 0xb0, 0xaa, 0x2f,
+	0x46, 0xe0, 0x4f, 0x6f, 0x45, 0xd0, 0x8d, 0x09, 0x74, 0x6f, 0xbd, 0x16, 0x9d, 0x17, 0x38, 0x0c,
+	0x8f, 0x9e, 0x80, 0x43, 0xa8, 0x8d, 0x2b, 0x5e, 0x46, 0xc5, 0x51, 0xe9, 0x33, 0x02, 0x05, 0x0f,
+	0xb6, 0x65, 0x9a, 0x4c, 0x77, 0x7d, 0xe3, 0x0a, 0x15, 0x00, 0xf4, 0x60, 0x12, 0xb3, 0x22, 0x64,
+	0xa1, 0x37, 0x13, 0x30, 0xee, 0x45, 0xc1, 0x1f, 0x08, 0x9c, 0x4d, 0x85, 0xf2, 0x5f, 0xd0, 0xf2,
+	0xbe, 0x2f, 0xa5, 0x17, 0xf1, 0x86, 0xb8, 0xee, 0x2b, 0x8e, 0xe6, 0xb0, 0x61, 0x6f, 0xd7, 0x1f,
+	0x81, 0x34, 0x09, 0x5b, 0xa3, 0x34, 0x6b, 0x70, 0xd2, 0x08, 0xd8, 0x97, 0xbd, 0x5a, 0x53, 0xe6,
+	0xae, 0x0b, 0x66, 0xf5, 0xac, 0xa7, 0x94, 0x98, 0x08, 0x0b, 0x15, 0xda, 0x6b, 0xca, 0x48, 0x32,
+	0x8f, 0xec, 0x4e, 0x72, 0x98, 0x8d, 0xb0, 0x72, 0x79, 0x98, 0xbc, 0xcd, 0xf7, 0x43, 0xb3, 0xd8,
+	0x29, 0x65, 0x83, 0x53, 0x7a, 0x45, 0xa0, 0xd4, 0x2f, 0x2a, 0xca, 0xf9, 0x01, 0x1c, 0xd1, 0xfd,
+	0x99, 0x88, 0x8c, 0x93, 0xb2, 0x57, 0x54, 0x65, 0xbf, 0xa8, 0xca, 0xd7, 0xcc, 0x2d, 0xf5, 0x7f,
+	0x7a, 0x64, 0x1b, 0x7a, 0x1a, 0xc6, 0xf1, 0x08, 0x02, 0x6c, 0x39, 0xcf, 0xb0, 0x58, 0xed, 0xea,
+	0x99, 0x49, 0xd7, 0x33, 0xfb, 0x3a, 0x3d, 0x0f, 0xf6, 0xea, 0xd9, 0x82, 0x33, 0x82, 0xd9, 0xb2,
+	0xa6, 0x6f, 0x30, 0xe7, 0x86, 0xd5, 0x6c, 0x1a, 0x4e, 0x93, 0x99, 0xce, 0xb0, 0x52, 0x4a, 0x90,
+	0xe3, 0xee, 0x16, 0xa6, 0xce, 0x50, 0xcc, 0x60, 0x5c, 0xfa, 0x8a, 0xc0, 0x4c, 0x4a, 0x50, 0x54,
+	0x52, 0xd4, 0x0f, 0xdf, 0x2a, 0x02, 0x4f, 0xa8, 0x21, 0xcb, 0xc8, 0xb2, 0xeb, 0x59, 0x1a, 0x32,
+	0x3e, 0xac, 0x1e, 0xd1, 0x8a, 0x97, 0xd9, 0x73, 0xc5, 0xfb, 0xd5, 0x2f, 0xbe, 0x09, 0x08, 0x51,
+	0xbc, 0xeb, 0x90, 0xef, 0x4a, 0xe5, 0xd7, 0xbc, 0x62, 0xa4, 0xe6, 0x79, 0x8b, 0xaf, 0xe9, 0x1b,
+	0x21, 0xed, 0xc3, 0x8b, 0x46, 0x5f, 0xf8, 0x9e, 0xe0, 0x3d, 0x0e, 0x90, 0x98, 0xd6, 0x93, 0x06,
+	0xab, 0xd6, 0xd8, 0xa8, 0x93, 0xef, 0xb9, 0x7f, 0x97, 0x53, 0x22, 0xa3, 0x88, 0x73, 0x70, 0x44,
+	0x8b, 0x4e, 0x61, 0x1a, 0xc6, 0xcd, 0x23, 0xcb, 0xc5, 0x9f, 0x08, 0x5e, 0xcd, 0x55, 0xb3, 0xc5,
+	0x1a, 0xda, 0x16, 0xab, 0x7a, 0x88, 0x87, 0x4e, 0xc5, 0xab, 0x70, 0xda, 0x16, 0x3b, 0x95, 0xbb,
+	0x27, 0x5e, 0xf6, 0xf5, 0xe1, 0xd3, 0x99, 0x62, 0x66, 0x2e, 0xab, 0x9e, 0xb2, 0x63, 0xf9, 0xb5,
+	0xe2, 0x3b, 0xd0, 0x79, 0x38, 0x1a, 0xd3, 0x80, 0x0b, 0xfc, 0x39, 0xb5, 0xc7, 0x5e, 0x5a, 0x85,
+	0x99, 0x14, 0x0e, 0xa8, 0xf3, 0x19, 0x18, 0xef, 0x86, 0x26, 0x22, 0x74, 0xd7, 0x10, 0xca, 0x9e,
+	0xb1, 0x48, 0xf6, 0xac, 0xe1, 0xdb, 0x76, 0x87, 0x6d, 0x06, 0xc0, 0x54, 0xa6, 0x33, 0xe3, 0xf1,
+	0xd0, 0xef, 0xe6, 0xb7, 0x04, 0x8a, 0xe9, 0x7b, 0x23, 0xea, 0x05, 0x98, 0x32, 0xd9, 0x66, 0x57,
+	0xb5, 0x72, 0xcb, 0x73, 0x10, 0xa1, 0xb2, 0xea, 0x71, 0xb3, 0x77, 0xed, 0xc8, 0xf2, 0xe4, 0xd3,
+	0xe0, 0x71, 0x8a, 0x3c, 0x27, 0xcb, 0xad, 0xb6, 0xa9, 0x55, 0x1a, 0x81, 0x1e, 0x91, 0xd7, 0x85,
+	0xc4, 0x5e, 0x97, 0x59, 0x98, 0x60, 0xb6, 0xa5, 0xd7, 0xcb, 0x66, 0xbb, 0x59, 0x61, 0x2d, 0x01,
+	0x31, 0xab, 0xe6, 0x85, 0xed, 0x8e, 0x30, 0x75, 0x5d, 0x22, 0x2f, 0xa4, 0xe7, 0x72, 0xdb, 0x3f,
+	0x95, 0xff, 0xf7, 0x05, 0x82, 0xe2, 0x49, 0x90, 0xb3, 0xd1, 0x26, 0x80, 0xe4, 0xd4, 0x60, 0xec,
+	0x1e, 0x78, 0x8b, 0x69, 0x1c, 0x6b, 0xce, 0xb8, 0x8a, 0xa3, 0x85, 0x2f, 0x8e, 0xc1, 0x41, 0xb1,
+	0x37, 0x7d, 0x4a, 0xe0, 0x30, 0x3e, 0xc3, 0x34, 0x5a, 0xd4, 0x12, 0xbe, 0x4d, 0xa4, 0xd9, 0x3e,
+	0x1e, 0x1e, 0x9c, 0xd2, 0xf5, 0x4f, 0x5e, 0xfe, 0xfd, 0xe5, 0xd8, 0xfb, 0xf4, 0x8a, 0x12, 0xfe,
+	0x64, 0xf2, 0x3f, 0xac, 0x70, 0xcc, 0x95, 0xed, 0x6e, 0xd2, 0x74, 0x14, 0x37, 0x95, 0xb8, 0xb2,
+	0x8d, 0x09, 0xd6, 0xa1, 0x9b, 0x90, 0xc3, 0x6d, 0x39, 0x4d, 0x0f, 0xe9, 0xdf, 0x5c, 0xa9, 0xd4,
+	0xcf, 0x05, 0x61, 0xbd, 0x21, 0x60, 0x9d, 0xa5, 0x33, 0x7d, 0x61, 0xd1, 0xef, 0x08, 0xd0, 0xde,
+	0xe6, 0x97, 0x9e, 0x4b, 0x88, 0x90, 0xd6, 0xad, 0x4b, 0xe7, 0x07, 0x73, 0x46, 0x60, 0x57, 0x05,
+	0xb0, 0xf7, 0xe8, 0xbb, 0xc9, 0xc0, 0x82, 0x85, 0xae, 0x64, 0xc1, 0xa0, 0xd3, 0x45, 0xfc, 0xa3,
+	0x8b, 0xb8, 0xa7, 0x27, 0x4d, 0x44, 0x9c, 0xd6, 0x14, 0x4b, 0xe7, 0x07, 0x73, 0x46, 0xc4, 0x77,
+	0x05, 0xe2, 0x45, 0x7a, 0x6b, 0xef, 0x27, 0xac, 0x84, 0x9b, 0x63, 0xfa, 0x27, 0x81, 0xa9, 0xc4,
+	0x56, 0x90, 0xca, 0xe9, 0xc0, 0x92, 0x3a, 0x55, 0x49, 0x19, 0xd8, 0x1f, 0xb9, 0x7c, 0x24, 0xb8,
+	0xac, 0xd2, 0x95, 0x61, 0xb8, 0x44, 0x9b, 0x54, 0x65, 0xdb, 0xbb, 0xd2, 0x1d, 0xfa, 0x3b, 0x81,
+	0xa3, 0xf1, 0xbe, 0x82, 0xbe, 0xdd, 0x0b, 0x31, 0xa5, 0x59, 0x94, 0xe6, 0x07, 0x71, 0x45, 0x22,
+	0x0f, 0x05, 0x91, 0x07, 0xf4, 0xfe, 0x10, 0x44, 0x7a, 0x9e, 0x31, 0xae, 0x6c, 0xfb, 0x25, 0xb9,
+	0x43, 0x7f, 0x26, 0x70, 0x2c, 0x1e, 0x9e, 0xd3, 0x01, 0x30, 0x06, 0x17, 0xe3, 0xdc, 0x40, 0xbe,
+	0x48, 0x68, 0x55, 0x10, 0xba, 0x4b, 0x97, 0xf6, 0x95, 0x10, 0x7d, 0x49, 0x60, 0x2a, 0xb1, 0x55,
+	0x49, 0xca, 0xb5, 0x7e, 0xdd, 0x94, 0xa4, 0x0c, 0xec, 0x8f, 0x8c, 0xd6, 0x04, 0xa3, 0x15, 0x7a,
+	0x6f, 0x78, 0x46, 0x9a, 0xbe, 0x11, 0x39, 0x9b, 0xa7, 0x63, 0x70, 0x34, 0xde, 0x13, 0x24, 0x65,
+	0x5a, 0x4a, 0xef, 0x23, 0xcd, 0x0f, 0xe2, 0x8a, 0x34, 0x9e, 0x13, 0xc1, 0xe3, 0x19, 0xa1, 0x5f,
+	0x93, 0x7d, 0x4e, 0xb6, 0x3e, 0x7d, 0x54, 0x8c, 0x7a, 0xbc, 0x33, 0xea, 0x28, 0x6d, 0x1f, 0x6f,
+	0xd9, 0x46, 0xfe, 0xbf, 0x10, 0x38, 0x9e, 0xd0, 0x75, 0xd0, 0x84, 0x6a, 0x97, 0xde, 0xf8, 0x48,
+	0x17, 0x06, 0xf4, 0x46, 0x75, 0x96, 0x85, 0x38, 0x1f, 0xd2, 0xdb, 0x43, 0x48, 0x13, 0xe9, 0x85,
+	0xe8, 0x3f, 0x04, 0x4e, 0x24, 0xb7, 0x00, 0x54, 0x49, 0x7c, 0x69, 0xd2, 0xbb, 0x16, 0xe9, 0xe2,
+	0xe0, 0x0b, 0x90, 0x4f, 0x5d, 0xf0, 0xa9, 0xd0, 0x87, 0xc9, 0x7c, 0x44, 0x19, 0x77, 0xe9, 0xf8,
+	0xbd, 0x50, 0x4f, 0x39, 0xe4, 0xca, 0x76, 0xb8, 0x17, 0xea, 0xf8, 0x43, 0x2c, 0x92, 0x8a, 0xdf,
+	0xab, 0x5c, 0x5f, 0xfa, 0x6d, 0xa7, 0x40, 0x5e, 0xec, 0x14, 0xc8, 0x5f, 0x3b, 0x05, 0xf2, 0xf9,
+	0x6e, 0xe1, 0xc0, 0x8b, 0xdd, 0xc2, 0x81, 0x57, 0xbb, 0x85, 0x03, 0x0f, 0x2e, 0xd7, 0x0c, 0xa7,
+	0xde, 0xae, 0xc8, 0xba, 0xd5, 0x54, 0xf0, 0x7f, 0xb6, 0xde, 0xcf, 0x05, 0x5e, 0xdd, 0x50, 0x36,
+	0x05, 0xb2, 0x8b, 0xef, 0x5c, 0xf0, 0xc1, 0x39, 0x5b, 0x36, 0xe3, 0x95, 0x43, 0xe2, 0x9f, 0x03,
+	0x97, 0xff, 0x1d, 0x00, 0xa0, 0x9f, 0x37, 0xf1, 0x25, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1485,6 +1615,9 @@ type QueryClient interface {
 	UnrelayedPackets(ctx context.Context, in *QueryUnrelayedPacketsRequest, opts ...grpc.CallOption) (*QueryUnrelayedPacketsResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error)
+	// ConsensusStatePrunable queries whether the consensus state of a client at a
+	// height can be pruned without breaking the relaying of in-flight packets.
+	ConsensusStatePrunable(ctx context.Context, in *QueryConsensusStatePrunableRequest, opts ...grpc.CallOption) (*QueryConsensusStatePrunableResponse, error)
 }
 
 type queryClient struct {
@@ -1585,6 +1718,15 @@ func (c *queryClient) NextSequenceReceive(ctx context.Context, in *QueryNextSequ
 	return out, nil
 }
 
+func (c *queryClient) ConsensusStatePrunable(ctx context.Context, in *QueryConsensusStatePrunableRequest, opts ...grpc.CallOption) (*QueryConsensusStatePrunableResponse, error) {
+	out := new(QueryConsensusStatePrunableResponse)
+	err := c.cc.Invoke(ctx, "/ibc.channel.Query/ConsensusStatePrunable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Channel queries an IBC Channel.
@@ -1612,6 +1754,9 @@ type QueryServer interface {
 	UnrelayedPackets(context.Context, *QueryUnrelayedPacketsRequest) (*QueryUnrelayedPacketsResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(context.Context, *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error)
+	// ConsensusStatePrunable queries whether the consensus state of a client at a
+	// height can be pruned without breaking the relaying of in-flight packets.
+	ConsensusStatePrunable(context.Context, *QueryConsensusStatePrunableRequest) (*QueryConsensusStatePrunableResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1648,6 +1793,9 @@ func (*UnimplementedQueryServer) UnrelayedPackets(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) NextSequenceReceive(ctx context.Context, req *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceReceive not implemented")
 }
+func (*UnimplementedQueryServer) ConsensusStatePrunable(ctx context.Context, req *QueryConsensusStatePrunableRequest) (*QueryConsensusStatePrunableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsensusStatePrunable not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1833,6 +1981,24 @@ func _Query_NextSequenceReceive_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsensusStatePrunable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusStatePrunableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsensusStatePrunable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.channel.Query/ConsensusStatePrunable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsensusStatePrunable(ctx, req.(*QueryConsensusStatePrunableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.channel.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1877,6 +2043,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSequenceReceive",
 			Handler:    _Query_NextSequenceReceive_Handler,
 		},
+		{
+			MethodName: "ConsensusStatePrunable",
+			Handler:    _Query_ConsensusStatePrunable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/channel/query.proto",
@@ -2832,6 +3002,86 @@ func (m *QueryNextSequenceReceiveResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConsensusStatePrunableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatePrunableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatePrunableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusStatePrunableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusStatePrunableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusStatePrunableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Prunable {
+		i--
+		if m.Prunable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3264,6 +3514,41 @@ func (m *QueryNextSequenceReceiveResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConsensusStatePrunableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.EpochHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EpochHeight))
+	}
+	return n
+}
+
+func (m *QueryConsensusStatePrunableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prunable {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6234,6 +6519,234 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConsensusStatePrunableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStatePrunableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStatePrunableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochHeight", wireType)
+			}
+			m.EpochHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusStatePrunableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusStatePrunableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusStatePrunableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prunable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prunable = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
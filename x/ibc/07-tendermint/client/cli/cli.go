@@ -18,6 +18,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewCreateClientCmd(),
 		NewUpdateClientCmd(),
+		GetCmdUpdateClientRange(),
+		GetCmdUpdateClientIfStale(),
 		NewSubmitMisbehaviourCmd(),
 	)
 
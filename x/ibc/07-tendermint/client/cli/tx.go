@@ -16,15 +16,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	clientutils "github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/utils"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 )
 
 const (
-	flagTrustLevel = "trust-level"
-	flagProofSpecs = "proof-specs"
+	flagTrustLevel     = "trust-level"
+	flagProofSpecs     = "proof-specs"
+	flagSourceNode     = "source-node"
+	flagFromEpoch      = "from-epoch"
+	flagToEpoch        = "to-epoch"
+	flagEpochEndHeight = "epoch-end-height"
+	flagMaxAge         = "max-age"
 )
 
 // NewCreateClientCmd defines the command to create a new IBC Client as defined
@@ -197,6 +204,195 @@ func NewUpdateClientCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCmdUpdateClientRange defines the command to update an existing client with
+// all the headers of the counterparty chain within a range of heights.
+func GetCmdUpdateClientRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-range [client-id] [from-height] [to-height]",
+		Short: "update existing client with a range of headers",
+		Long: `Update an existing tendermint client from its current height (from-height) to the target
+height (to-height) with the headers queried from the counterparty chain node set with the '--source-node' flag.
+
+All the update messages of an epoch are submitted in a single transaction. If the range crosses into the next
+epoch, the '--epoch-end-height' flag must be set to the last height of the current epoch and a second transaction
+is submitted for the headers of the next epoch. Use '--broadcast-mode block' so that the second transaction uses
+the updated account sequence.`,
+		Example: fmt.Sprintf(
+			"$ %s tx ibc %s update-range [client-id] [from-height] [to-height] --source-node tcp://localhost:26657 --from node0 --chain-id $CID",
+			version.AppName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+
+			fromHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from height: %w", err)
+			}
+
+			toHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid to height: %w", err)
+			}
+
+			fromEpoch, _ := cmd.Flags().GetUint64(flagFromEpoch)
+			toEpoch, _ := cmd.Flags().GetUint64(flagToEpoch)
+			epochEndHeight, _ := cmd.Flags().GetUint64(flagEpochEndHeight)
+
+			sourceNode, _ := cmd.Flags().GetString(flagSourceNode)
+			if strings.TrimSpace(sourceNode) == "" {
+				return errors.New("the counterparty chain node must be set with the --source-node flag")
+			}
+			sourceCtx := clientCtx.WithNodeURI(sourceNode)
+
+			from := clienttypes.NewHeight(fromEpoch, fromHeight)
+			batches, err := clientutils.SplitUpdateRange(from, clienttypes.NewHeight(toEpoch, toHeight), epochEndHeight)
+			if err != nil {
+				return err
+			}
+
+			trustedHeight := from
+			for _, batch := range batches {
+				msgs := make([]sdk.Msg, len(batch))
+				for i, height := range batch {
+					header, err := clientutils.QueryTendermintHeaderAtHeight(sourceCtx, int64(height.EpochHeight))
+					if err != nil {
+						return errors.Wrapf(err, "failed to query header at height %s", height)
+					}
+
+					// headers are consecutive, so the validators trusted at the previous height
+					// are the validators of the header itself
+					header.TrustedHeight = trustedHeight
+					header.TrustedValidators = header.ValidatorSet
+
+					msg, err := clienttypes.NewMsgUpdateClient(clientID, &header, clientCtx.GetFromAddress())
+					if err != nil {
+						return err
+					}
+
+					if err := msg.ValidateBasic(); err != nil {
+						return err
+					}
+
+					msgs[i] = msg
+					trustedHeight = height
+				}
+
+				if err := tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSourceNode, "", "<host>:<port> to the Tendermint RPC interface of the counterparty chain")
+	cmd.Flags().Uint64(flagFromEpoch, 0, "epoch number of the from height")
+	cmd.Flags().Uint64(flagToEpoch, 0, "epoch number of the to height")
+	cmd.Flags().Uint64(flagEpochEndHeight, 0, "last height of the from epoch, required when the range crosses into the next epoch")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdUpdateClientIfStale defines the command to update an existing client with
+// the latest header of the counterparty chain, only if the client is stale.
+func GetCmdUpdateClientIfStale() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-if-stale [client-id]",
+		Short: "update existing client with the latest header if it is stale",
+		Long: `Update an existing tendermint client with the latest header queried from the counterparty chain node
+set with the '--source-node' flag, only if the timestamp of the latest consensus state of the client is older
+than the '--max-age' flag. Otherwise no transaction is submitted and "fresh." is printed.`,
+		Example: fmt.Sprintf(
+			"$ %s tx ibc %s update-if-stale [client-id] --max-age 10m --source-node tcp://localhost:26657 --from node0 --chain-id $CID",
+			version.AppName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			clientID := args[0]
+			maxAge, _ := cmd.Flags().GetDuration(flagMaxAge)
+
+			sourceNode, _ := cmd.Flags().GetString(flagSourceNode)
+			if strings.TrimSpace(sourceNode) == "" {
+				return errors.New("the counterparty chain node must be set with the --source-node flag")
+			}
+			sourceCtx := clientCtx.WithNodeURI(sourceNode)
+
+			stale, err := clientutils.QueryIsClientStale(clientCtx, clientID, maxAge, time.Now())
+			if err != nil {
+				return err
+			}
+
+			if !stale {
+				_, err := fmt.Fprintln(cmd.OutOrStdout(), "fresh.")
+				return err
+			}
+
+			res, err := clientutils.QueryClientState(clientCtx, clientID, false)
+			if err != nil {
+				return err
+			}
+
+			clientState, err := clienttypes.UnpackClientState(res.ClientState)
+			if err != nil {
+				return err
+			}
+
+			tmClientState, ok := clientState.(*types.ClientState)
+			if !ok {
+				return fmt.Errorf("client %s is not a tendermint client, got client type: %s", clientID, clientState.ClientType())
+			}
+
+			header, _, err := clientutils.QueryTendermintHeader(sourceCtx)
+			if err != nil {
+				return errors.Wrap(err, "failed to query the latest header")
+			}
+
+			// the validators trusted at the latest height of the client are the next
+			// validators committed to by the header at that height
+			trustedHeight := tmClientState.LatestHeight
+			trustedHeader, err := clientutils.QueryTendermintHeaderAtHeight(sourceCtx, int64(trustedHeight.EpochHeight+1))
+			if err != nil {
+				return errors.Wrapf(err, "failed to query the validators trusted at height %s", trustedHeight)
+			}
+
+			header.TrustedHeight = trustedHeight
+			header.TrustedValidators = trustedHeader.ValidatorSet
+
+			msg, err := clienttypes.NewMsgUpdateClient(clientID, &header, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(flagMaxAge, 10*time.Minute, "maximum age of the latest consensus state of the client before it is updated")
+	cmd.Flags().String(flagSourceNode, "", "<host>:<port> to the Tendermint RPC interface of the counterparty chain")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewSubmitMisbehaviourCmd defines the command to submit a misbehaviour to invalidate
 // previous state roots and prevent future updates as defined in
 // https://github.com/cosmos/ics/tree/master/spec/ics-002-client-semantics#misbehaviour
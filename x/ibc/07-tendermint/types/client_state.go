@@ -1,10 +1,12 @@
 package types
 
 import (
+	"bytes"
 	"strings"
 	"time"
 
 	ics23 "github.com/confio/ics23/go"
+	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/light"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,7 +20,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState                  = (*ClientState)(nil)
+	_ clienttypes.TrustingPeriodClientState = (*ClientState)(nil)
+	_ clienttypes.ChainIDClientState        = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -54,6 +60,11 @@ func (cs ClientState) GetLatestHeight() uint64 {
 	return cs.LatestHeight.EpochHeight
 }
 
+// GetEpochNumber returns the epoch number of the latest height of the client
+func (cs ClientState) GetEpochNumber() uint64 {
+	return cs.LatestHeight.EpochNumber
+}
+
 // IsFrozen returns true if the frozen height has been set.
 func (cs ClientState) IsFrozen() bool {
 	return !cs.FrozenHeight.IsZero()
@@ -66,6 +77,18 @@ func (cs ClientState) GetFrozenHeight() uint64 {
 	return cs.FrozenHeight.EpochHeight
 }
 
+// Clone returns a deep copy of the client state.
+func (cs ClientState) Clone() exported.ClientState {
+	return proto.Clone(&cs).(*ClientState)
+}
+
+// Freeze returns a copy of the client state frozen at the given height.
+func (cs ClientState) Freeze(frozenHeight clienttypes.Height) exported.ClientState {
+	frozen := cs.Clone().(*ClientState)
+	frozen.FrozenHeight = frozenHeight
+	return frozen
+}
+
 // Validate performs a basic validation of the client state fields.
 func (cs ClientState) Validate() error {
 	if strings.TrimSpace(cs.ChainId) == "" {
@@ -111,6 +134,24 @@ func (cs ClientState) GetProofSpecs() []*ics23.ProofSpec {
 	return cs.ProofSpecs
 }
 
+// GetTrustingPeriod returns the duration of the period since the latest
+// timestamp during which the submitted headers are valid for upgrade.
+func (cs ClientState) GetTrustingPeriod() time.Duration {
+	return cs.TrustingPeriod
+}
+
+// GetDelayTimePeriod returns the time delay, in nanoseconds, that must elapse
+// after a consensus state is stored before it can be used for verification.
+func (cs ClientState) GetDelayTimePeriod() uint64 {
+	return cs.DelayTimePeriod
+}
+
+// GetDelayBlockPeriod returns the number of blocks that must be produced after a
+// consensus state is stored before it can be used for verification.
+func (cs ClientState) GetDelayBlockPeriod() uint64 {
+	return cs.DelayBlockPeriod
+}
+
 // VerifyClientState verifies a proof of the client state of the running chain
 // stored on the target machine
 func (cs ClientState) VerifyClientState(
@@ -190,9 +231,45 @@ func (cs ClientState) VerifyClientConsensusState(
 	}
 
 	if err := merkleProof.VerifyMembership(cs.ProofSpecs, provingRoot, path, bz); err != nil {
+		// the counterparty may store the consensus state compressed, in which case
+		// the stored bytes are proven and then compared in their canonical form
+		return verifyCompressedConsensusState(cs, merkleProof, provingRoot, path, bz, err)
+	}
+
+	return nil
+}
+
+// verifyCompressedConsensusState verifies the membership of the compressed
+// consensus state committed to by the proof and checks that its canonical form
+// matches the expected encoded consensus state. The given verification error of
+// the canonical form is returned if the proof doesn't commit to a compressed
+// consensus state.
+func verifyCompressedConsensusState(
+	cs ClientState, proof exported.Proof, provingRoot exported.Root, path exported.Path, expected []byte, verifyErr error,
+) error {
+	merkleProof, ok := proof.(commitmenttypes.MerkleProof)
+	if !ok {
+		return verifyErr
+	}
+
+	stored, err := merkleProof.StoredValue()
+	if err != nil || !clienttypes.IsCompressedConsensusState(stored) {
+		return verifyErr
+	}
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, provingRoot, path, stored); err != nil {
 		return err
 	}
 
+	canonical, err := clienttypes.DecompressConsensusState(stored)
+	if err != nil {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedClientConsensusStateVerification, "cannot decompress stored consensus state: %v", err)
+	}
+
+	if !bytes.Equal(canonical, expected) {
+		return sdkerrors.Wrap(clienttypes.ErrFailedClientConsensusStateVerification, "stored consensus state does not match the expected consensus state")
+	}
+
 	return nil
 }
 
@@ -395,8 +472,10 @@ func (cs ClientState) VerifyNextSequenceRecv(
 }
 
 // produceVerificationArgs perfoms the basic checks on the arguments that are
-// shared between the verification functions and returns the unmarshalled
-// merkle proof, the consensus state and an error if one occurred.
+// shared between the verification functions and returns the decoded proof, the
+// consensus state and an error if one occurred. The proof is decoded as an ICS23
+// merkle proof, falling back to the legacy format if the codec accepts it and the
+// proof doesn't parse as one.
 func produceVerificationArgs(
 	store sdk.KVStore,
 	cdc codec.BinaryMarshaler,
@@ -404,38 +483,39 @@ func produceVerificationArgs(
 	height uint64,
 	prefix exported.Prefix,
 	proof []byte,
-) (merkleProof commitmenttypes.MerkleProof, consensusState *ConsensusState, err error) {
+) (merkleProof exported.Proof, consensusState *ConsensusState, err error) {
 	if cs.GetLatestHeight() < height {
-		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrapf(
+		return nil, nil, sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidHeight,
 			"client state height < proof height (%d < %d)", cs.GetLatestHeight(), height,
 		)
 	}
 
-	if cs.IsFrozen() && !cs.FrozenHeight.GT(clienttypes.NewHeight(0, height)) {
-		return commitmenttypes.MerkleProof{}, nil, clienttypes.ErrClientFrozen
+	if err := clienttypes.ValidateClientNotFrozen(cs, height); err != nil {
+		return nil, nil, err
 	}
 
 	if prefix == nil {
-		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
+		return nil, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
 	}
 
 	_, ok := prefix.(*commitmenttypes.MerklePrefix)
 	if !ok {
-		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected *MerklePrefix", prefix)
+		return nil, nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected *MerklePrefix", prefix)
 	}
 
 	if proof == nil {
-		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
+		return nil, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
 	}
 
-	if err = cdc.UnmarshalBinaryBare(proof, &merkleProof); err != nil {
-		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	merkleProof, _, err = commitmenttypes.DecodeProof(cdc, proof)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	consensusState, err = GetConsensusState(store, cdc, height)
 	if err != nil {
-		return commitmenttypes.MerkleProof{}, nil, err
+		return nil, nil, err
 	}
 
 	return merkleProof, consensusState, nil
@@ -1,8 +1,11 @@
 package types_test
 
 import (
+	"time"
+
 	ics23 "github.com/confio/ics23/go"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
@@ -92,6 +95,58 @@ func (suite *TendermintTestSuite) TestValidate() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestGetDelayPeriods() {
+	delayedClientState := types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs())
+	delayedClientState.DelayTimePeriod = uint64(time.Minute)
+	delayedClientState.DelayBlockPeriod = 10
+
+	testCases := []struct {
+		name           string
+		clientState    exported.ClientState
+		expTimePeriod  uint64
+		expBlockPeriod uint64
+	}{
+		{"no delay configured", types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs()), 0, 0},
+		{"delay configured", delayedClientState, uint64(time.Minute), 10},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expTimePeriod, tc.clientState.GetDelayTimePeriod(), tc.name)
+		suite.Require().Equal(tc.expBlockPeriod, tc.clientState.GetDelayBlockPeriod(), tc.name)
+	}
+}
+
+func (suite *TendermintTestSuite) TestClone() {
+	clientState := types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs())
+
+	clone, ok := clientState.Clone().(*types.ClientState)
+	suite.Require().True(ok)
+	suite.Require().Equal(clientState, clone)
+
+	// mutating the clone, including through its pointers, doesn't affect the original
+	clone.LatestHeight = height.Increment()
+	clone.TrustLevel.Numerator = 2
+	clone.ProofSpecs[0].MaxDepth = 42
+	clone.ProofSpecs = append(clone.ProofSpecs, commitmenttypes.GetSDKSpecs()[0])
+
+	suite.Require().Equal(height, clientState.LatestHeight)
+	suite.Require().Equal(types.DefaultTrustLevel, clientState.TrustLevel)
+	suite.Require().Equal(commitmenttypes.GetSDKSpecs(), clientState.ProofSpecs)
+}
+
+func (suite *TendermintTestSuite) TestFreeze() {
+	clientState := types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs())
+	frozenHeight := clienttypes.NewHeight(0, 5)
+
+	frozen, ok := clientState.Freeze(frozenHeight).(*types.ClientState)
+	suite.Require().True(ok)
+	suite.Require().True(frozen.IsFrozen())
+	suite.Require().Equal(frozenHeight, frozen.FrozenHeight)
+
+	// the original client state is left unchanged
+	suite.Require().False(clientState.IsFrozen())
+}
+
 func (suite *TendermintTestSuite) TestVerifyClientConsensusState() {
 	testCases := []struct {
 		name           string
@@ -328,6 +383,7 @@ func (suite *TendermintTestSuite) TestVerifyChannelState() {
 func (suite *TendermintTestSuite) TestVerifyPacketCommitment() {
 	var (
 		clientState *types.ClientState
+		cdc         codec.BinaryMarshaler
 		proof       []byte
 		proofHeight uint64
 		prefix      commitmenttypes.MerklePrefix
@@ -361,6 +417,28 @@ func (suite *TendermintTestSuite) TestVerifyPacketCommitment() {
 				proof = invalidProof
 			}, false,
 		},
+		{
+			"successful verification with legacy proof", func() {
+				proof = suite.legacyProof(proof)
+				cdc = commitmenttypes.NewLegacyProofCodec(cdc)
+			}, true,
+		},
+		{
+			"successful verification with ICS23 proof and legacy proofs allowed", func() {
+				cdc = commitmenttypes.NewLegacyProofCodec(cdc)
+			}, true,
+		},
+		{
+			"legacy proofs not allowed", func() {
+				proof = suite.legacyProof(proof)
+			}, false,
+		},
+		{
+			"invalid legacy proof", func() {
+				proof = []byte("{}")
+				cdc = commitmenttypes.NewLegacyProofCodec(cdc)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -381,6 +459,7 @@ func (suite *TendermintTestSuite) TestVerifyPacketCommitment() {
 			suite.Require().True(ok)
 
 			prefix = suite.chainB.GetPrefix()
+			cdc = suite.chainA.Codec
 
 			// make packet commitment proof
 			packetKey := host.KeyPacketCommitment(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
@@ -391,7 +470,7 @@ func (suite *TendermintTestSuite) TestVerifyPacketCommitment() {
 			store := suite.chainA.App.IBCKeeper.ClientKeeper.ClientStore(suite.chainA.GetContext(), clientA)
 
 			err = clientState.VerifyPacketCommitment(
-				store, suite.chainA.Codec, proofHeight, &prefix, proof,
+				store, cdc, proofHeight, &prefix, proof,
 				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), channeltypes.CommitPacket(packet),
 			)
 
@@ -669,3 +748,14 @@ func (suite *TendermintTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+// legacyProof re-encodes an ICS23 merkle proof into the legacy JSON format,
+// which doesn't parse as an ICS23 proof.
+func (suite *TendermintTestSuite) legacyProof(proof []byte) []byte {
+	var merkleProof commitmenttypes.MerkleProof
+	suite.Require().NoError(suite.chainA.Codec.UnmarshalBinaryBare(proof, &merkleProof))
+
+	bz, err := commitmenttypes.SubModuleCdc.MarshalJSON(&merkleProof)
+	suite.Require().NoError(err)
+	return bz
+}
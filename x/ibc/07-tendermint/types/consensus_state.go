@@ -40,11 +40,21 @@ func (cs ConsensusState) GetHeight() uint64 {
 	return cs.Height.EpochHeight
 }
 
+// GetEpochNumber returns the epoch number of the height of the consensus state
+func (cs ConsensusState) GetEpochNumber() uint64 {
+	return cs.Height.EpochNumber
+}
+
 // GetTimestamp returns block time in nanoseconds at which the consensus state was stored
 func (cs ConsensusState) GetTimestamp() uint64 {
 	return uint64(cs.Timestamp.UnixNano())
 }
 
+// GetNextValidatorsHash returns the hash of the validator set of the next block
+func (cs ConsensusState) GetNextValidatorsHash() []byte {
+	return cs.NextValidatorsHash
+}
+
 // ValidateBasic defines a basic validation for the tendermint consensus state.
 func (cs ConsensusState) ValidateBasic() error {
 	if cs.Root.Empty() {
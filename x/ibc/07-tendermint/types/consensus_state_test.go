@@ -79,3 +79,13 @@ func (suite *TendermintTestSuite) TestConsensusStateValidateBasic() {
 		}
 	}
 }
+
+func (suite *TendermintTestSuite) TestNextValidatorsHash() {
+	consensusState := types.NewConsensusState(
+		suite.now, commitmenttypes.NewMerkleRoot([]byte("app_hash")), height, suite.valsHash,
+	)
+
+	nextValsHash, ok := exported.NextValidatorsHash(consensusState)
+	suite.Require().True(ok)
+	suite.Require().Equal([]byte(suite.valsHash), nextValsHash)
+}
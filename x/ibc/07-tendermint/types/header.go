@@ -42,6 +42,16 @@ func (h Header) GetHeight() uint64 {
 	return uint64(h.Header.Height)
 }
 
+// GetChainID returns the chain-id of the tendermint header. It returns an empty
+// string if the tendermint header is nil.
+func (h Header) GetChainID() string {
+	if h.Header == nil {
+		return ""
+	}
+
+	return h.Header.GetChainID()
+}
+
 // GetTime returns the current block timestamp. It returns a zero time if
 // the tendermint header is nil.
 func (h Header) GetTime() time.Time {
@@ -51,6 +61,15 @@ func (h Header) GetTime() time.Time {
 	return h.Header.Time
 }
 
+// GetTimestamp returns the current block timestamp in nanoseconds. It returns 0
+// if the tendermint header is nil.
+func (h Header) GetTimestamp() uint64 {
+	if h.Header == nil {
+		return 0
+	}
+	return uint64(h.Header.Time.UnixNano())
+}
+
 // ValidateBasic calls the SignedHeader ValidateBasic function and checks
 // that validatorsets are not nil.
 // NOTE: TrustedHeight and TrustedValidators may be empty when creating client
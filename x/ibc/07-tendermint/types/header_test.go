@@ -26,6 +26,17 @@ func (suite *TendermintTestSuite) TestGetTime() {
 	suite.Require().Equal(time.Time{}, header.GetTime())
 }
 
+func (suite *TendermintTestSuite) TestGetTimestamp() {
+	header := suite.chainA.LastHeader
+
+	var exportedHeader exported.Header = header
+	suite.Require().Equal(uint64(header.GetTime().UnixNano()), exportedHeader.GetTimestamp())
+
+	header.Header = nil
+	exportedHeader = header
+	suite.Require().Equal(uint64(0), exportedHeader.GetTimestamp())
+}
+
 func (suite *TendermintTestSuite) TestHeaderValidateBasic() {
 	var (
 		header *types.Header
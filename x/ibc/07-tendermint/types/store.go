@@ -6,7 +6,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
-	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
 // GetConsensusState retrieves the consensus state from the client prefixed
@@ -20,8 +19,8 @@ func GetConsensusState(store sdk.KVStore, cdc codec.BinaryMarshaler, height uint
 		)
 	}
 
-	var consensusStateI exported.ConsensusState
-	if err := codec.UnmarshalAny(cdc, &consensusStateI, bz); err != nil {
+	consensusStateI, err := clienttypes.UnmarshalConsensusState(cdc, bz)
+	if err != nil {
 		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "unmarshal error: %v", err)
 	}
 
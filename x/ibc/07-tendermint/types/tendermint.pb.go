@@ -51,6 +51,12 @@ type ClientState struct {
 	LatestHeight types.Height `protobuf:"bytes,7,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height" yaml:"latest_height"`
 	// Proof specifications used in verifying counterparty state
 	ProofSpecs []*_go.ProofSpec `protobuf:"bytes,8,rep,name=proof_specs,json=proofSpecs,proto3" json:"proof_specs,omitempty" yaml:"proof_specs"`
+	// time delay, in nanoseconds, that must elapse after a consensus state is
+	// stored before it can be used for packet verification
+	DelayTimePeriod uint64 `protobuf:"varint,9,opt,name=delay_time_period,json=delayTimePeriod,proto3" json:"delay_time_period,omitempty" yaml:"delay_time_period"`
+	// number of blocks that must be produced after a consensus state is stored
+	// before it can be used for packet verification
+	DelayBlockPeriod uint64 `protobuf:"varint,10,opt,name=delay_block_period,json=delayBlockPeriod,proto3" json:"delay_block_period,omitempty" yaml:"delay_block_period"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -309,68 +315,71 @@ func init() {
 func init() { proto.RegisterFile("ibc/tendermint/tendermint.proto", fileDescriptor_76a953d5a747dd66) }
 
 var fileDescriptor_76a953d5a747dd66 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x4e, 0x23, 0x47,
-	0x10, 0xf6, 0x0f, 0x0b, 0xa6, 0x6d, 0x03, 0xe9, 0x25, 0xac, 0x71, 0x58, 0x8f, 0xd5, 0xb9, 0x70,
-	0xd9, 0x99, 0xc5, 0xbb, 0x4a, 0x24, 0x8e, 0xc3, 0x2a, 0x82, 0x28, 0x2b, 0x91, 0x21, 0x9b, 0x44,
-	0x91, 0xa2, 0xd1, 0x78, 0xa6, 0x6d, 0xb7, 0x98, 0x99, 0xb6, 0xa6, 0xdb, 0xc8, 0xe4, 0x09, 0x92,
-	0xdb, 0x2a, 0xa7, 0x3d, 0x26, 0x6f, 0x91, 0x47, 0xd8, 0x53, 0xc4, 0x31, 0x27, 0x27, 0x82, 0x37,
-	0xf0, 0x31, 0xa7, 0xa8, 0x7f, 0xe6, 0xc7, 0x86, 0x08, 0xf6, 0x82, 0xbb, 0xab, 0xbe, 0xef, 0xab,
-	0xee, 0xea, 0xaa, 0x1a, 0x80, 0x41, 0xfa, 0xbe, 0xc5, 0x71, 0x1c, 0xe0, 0x24, 0x22, 0x31, 0x2f,
-	0x2c, 0xcd, 0x71, 0x42, 0x39, 0x85, 0x1b, 0xa4, 0xef, 0x9b, 0xb9, 0xb5, 0xdd, 0x2d, 0x82, 0x2f,
-	0xc7, 0x98, 0x59, 0x17, 0x5e, 0x48, 0x02, 0x8f, 0xd3, 0x44, 0x31, 0xda, 0x7b, 0xb7, 0x10, 0xf2,
-	0xaf, 0xf6, 0x3e, 0xf6, 0x69, 0x3c, 0x20, 0xd4, 0x1a, 0x27, 0x94, 0x0e, 0x52, 0x63, 0x67, 0x48,
-	0xe9, 0x30, 0xc4, 0x96, 0xdc, 0xf5, 0x27, 0x03, 0x2b, 0x98, 0x24, 0x1e, 0x27, 0x34, 0xd6, 0x7e,
-	0x63, 0xd9, 0xcf, 0x49, 0x84, 0x19, 0xf7, 0xa2, 0xb1, 0x06, 0x3c, 0x11, 0xd7, 0xf0, 0x43, 0x82,
-	0x63, 0xae, 0x7f, 0x52, 0xa6, 0x74, 0xd0, 0x28, 0x22, 0x3c, 0x92, 0xce, 0x6c, 0xa9, 0x01, 0xdb,
-	0x43, 0x3a, 0xa4, 0x72, 0x69, 0x89, 0x95, 0xb2, 0xa2, 0x3f, 0x1e, 0x81, 0xfa, 0x91, 0xd4, 0x39,
-	0xe3, 0x1e, 0xc7, 0x70, 0x17, 0xd4, 0xfc, 0x91, 0x47, 0x62, 0x97, 0x04, 0xad, 0x72, 0xb7, 0xbc,
-	0xbf, 0xee, 0xac, 0xc9, 0xfd, 0x49, 0x00, 0xdf, 0x80, 0x3a, 0x4f, 0x26, 0x8c, 0xbb, 0x21, 0xbe,
-	0xc0, 0x61, 0xab, 0xd2, 0x2d, 0xef, 0xd7, 0x7b, 0x2d, 0x73, 0x31, 0x6d, 0xe6, 0x17, 0x89, 0xe7,
-	0x8b, 0x0b, 0xd9, 0xed, 0xf7, 0x33, 0xa3, 0x34, 0x9f, 0x19, 0xf0, 0xd2, 0x8b, 0xc2, 0x43, 0x54,
-	0xa0, 0x22, 0x07, 0xc8, 0xdd, 0x57, 0x62, 0x03, 0x07, 0x60, 0x53, 0xee, 0x48, 0x3c, 0x74, 0xc7,
-	0x38, 0x21, 0x34, 0x68, 0x55, 0xa5, 0xf4, 0xae, 0xa9, 0x92, 0x61, 0xa6, 0xc9, 0x30, 0x5f, 0xe9,
-	0x64, 0xd9, 0x48, 0x6b, 0xef, 0x14, 0xb4, 0x73, 0x3e, 0x7a, 0xf7, 0xb7, 0x51, 0x76, 0x36, 0x52,
-	0xeb, 0xa9, 0x34, 0x42, 0x02, 0xb6, 0x26, 0x71, 0x9f, 0xc6, 0x41, 0x21, 0xd0, 0xca, 0x7d, 0x81,
-	0x3e, 0xd5, 0x81, 0x9e, 0xa8, 0x40, 0xcb, 0x02, 0x2a, 0xd2, 0x66, 0x66, 0xd6, 0xa1, 0x30, 0xd8,
-	0x8c, 0xbc, 0xa9, 0xeb, 0x87, 0xd4, 0x3f, 0x77, 0x83, 0x84, 0x0c, 0x78, 0xeb, 0xd1, 0x07, 0x5e,
-	0x69, 0x89, 0xaf, 0x02, 0x35, 0x23, 0x6f, 0x7a, 0x24, 0x8c, 0xaf, 0x84, 0x0d, 0xbe, 0x01, 0xcd,
-	0x41, 0x42, 0x7f, 0xc2, 0xb1, 0x3b, 0xc2, 0x64, 0x38, 0xe2, 0xad, 0x55, 0x19, 0x04, 0xca, 0x27,
-	0xd1, 0xc5, 0x71, 0x2c, 0x3d, 0xf6, 0x9e, 0x56, 0xdf, 0x56, 0xea, 0x0b, 0x34, 0xe4, 0x34, 0xd4,
-	0x5e, 0x61, 0x85, 0x6c, 0xe8, 0x71, 0xcc, 0x78, 0x2a, 0xbb, 0xf6, 0x50, 0xd9, 0x05, 0x1a, 0x72,
-	0x1a, 0x6a, 0xaf, 0x65, 0x4f, 0x40, 0x5d, 0xb6, 0x82, 0xcb, 0xc6, 0xd8, 0x67, 0xad, 0x5a, 0xb7,
-	0xba, 0x5f, 0xef, 0x6d, 0x99, 0xc4, 0x67, 0xbd, 0x17, 0xe6, 0xa9, 0xf0, 0x9c, 0x8d, 0xb1, 0x6f,
-	0xef, 0xe4, 0x25, 0x53, 0x80, 0x23, 0x07, 0x8c, 0x53, 0x08, 0x3b, 0x5c, 0xf9, 0xf9, 0x37, 0xa3,
-	0x84, 0xfe, 0xac, 0x80, 0x8d, 0x23, 0x1a, 0x33, 0x1c, 0xb3, 0x09, 0x53, 0xd5, 0x6b, 0x83, 0xf5,
-	0xac, 0x61, 0x64, 0xf9, 0xd6, 0x7b, 0xed, 0x5b, 0x29, 0xff, 0x26, 0x45, 0xd8, 0x35, 0x71, 0xfc,
-	0xb7, 0x22, 0xb3, 0x39, 0x0d, 0xbe, 0x04, 0x2b, 0x09, 0xa5, 0x5c, 0xd7, 0x77, 0x5b, 0xdd, 0x3a,
-	0x6f, 0xa6, 0xd7, 0x38, 0x39, 0x0f, 0xb1, 0x43, 0x29, 0xb7, 0x57, 0x04, 0xdd, 0x91, 0x68, 0xf8,
-	0x1c, 0xac, 0xea, 0x6c, 0x55, 0xff, 0x37, 0x5b, 0x0a, 0xaf, 0x71, 0xf0, 0x97, 0x32, 0xd8, 0x8e,
-	0xf1, 0x94, 0xbb, 0xd9, 0x58, 0x61, 0xee, 0xc8, 0x63, 0x23, 0x59, 0x94, 0x0d, 0xfb, 0xbb, 0xf9,
-	0xcc, 0xf8, 0x44, 0xe5, 0xe1, 0x2e, 0x14, 0xfa, 0x77, 0x66, 0xbc, 0x1c, 0x12, 0x3e, 0x9a, 0xf4,
-	0xc5, 0xe9, 0xee, 0x9e, 0x6c, 0x56, 0x48, 0xfa, 0xcc, 0xea, 0x5f, 0x72, 0xcc, 0xcc, 0x63, 0x3c,
-	0xb5, 0xc5, 0xc2, 0x81, 0x42, 0xee, 0xdb, 0x4c, 0xed, 0xd8, 0x63, 0x23, 0x9d, 0xd0, 0xdf, 0x2b,
-	0xa0, 0xf1, 0x9a, 0xb0, 0x3e, 0x1e, 0x79, 0x17, 0x84, 0x4e, 0x12, 0x78, 0x00, 0xd6, 0xd5, 0x0d,
-	0xb2, 0x69, 0x60, 0x6f, 0xcf, 0x67, 0xc6, 0x96, 0x3a, 0x56, 0xe6, 0x42, 0x4e, 0x4d, 0xad, 0x4f,
-	0x02, 0x68, 0x16, 0xe6, 0x47, 0x45, 0x32, 0x1e, 0xcf, 0x67, 0xc6, 0xa6, 0x66, 0x68, 0x0f, 0xca,
-	0x87, 0xca, 0xd7, 0xa0, 0x36, 0xc2, 0x5e, 0x80, 0x13, 0xf7, 0x40, 0x67, 0x6e, 0x67, 0x79, 0xa2,
-	0x1c, 0x4b, 0xbf, 0xdd, 0xb9, 0x9e, 0x19, 0x6b, 0x6a, 0x7d, 0x90, 0x4b, 0xa6, 0x64, 0xe4, 0xac,
-	0xa9, 0xe5, 0x41, 0x41, 0xb2, 0xa7, 0x1b, 0xfc, 0x01, 0x92, 0xbd, 0x5b, 0x92, 0xbd, 0x4c, 0xb2,
-	0x77, 0x58, 0x13, 0xf9, 0x79, 0x27, 0x72, 0xf4, 0x6b, 0x15, 0xac, 0x2a, 0x06, 0xf4, 0x40, 0x93,
-	0x91, 0x61, 0x8c, 0x03, 0x57, 0xc1, 0x74, 0xc1, 0x75, 0x8a, 0x81, 0xd4, 0x07, 0xe1, 0x4c, 0xc2,
-	0x74, 0xd0, 0xbd, 0xab, 0x99, 0x51, 0xce, 0x7b, 0x66, 0x41, 0x02, 0x39, 0x0d, 0x56, 0xc0, 0xc2,
-	0x1f, 0x41, 0x33, 0x7b, 0x77, 0x97, 0xe1, 0xb4, 0x28, 0xef, 0x08, 0x91, 0x3d, 0xe8, 0x19, 0xe6,
-	0x76, 0x2b, 0x97, 0x5f, 0xa0, 0x23, 0xa7, 0x71, 0x51, 0xc0, 0xc1, 0xef, 0x81, 0x1a, 0x92, 0x32,
-	0xfe, 0x3d, 0xc5, 0xfb, 0x54, 0xb7, 0xfa, 0xc7, 0x85, 0x91, 0x9b, 0xf1, 0x90, 0xd3, 0xd4, 0x06,
-	0xdd, 0xec, 0x21, 0x80, 0x29, 0x22, 0x2f, 0x5c, 0xfd, 0x1a, 0xf7, 0x9d, 0xfe, 0xe9, 0x7c, 0x66,
-	0xec, 0x2e, 0x46, 0xc9, 0x35, 0x90, 0xf3, 0x91, 0x36, 0xe6, 0x25, 0x8c, 0xbe, 0x04, 0xb5, 0xf4,
-	0xb3, 0x03, 0xf7, 0xc0, 0x7a, 0x3c, 0x89, 0x70, 0x22, 0x3c, 0xf2, 0x45, 0xaa, 0x4e, 0x6e, 0x80,
-	0x5d, 0x50, 0x0f, 0x70, 0x4c, 0x23, 0x12, 0x4b, 0x7f, 0x45, 0xfa, 0x8b, 0x26, 0xfb, 0xf4, 0xfd,
-	0x75, 0xa7, 0x7c, 0x75, 0xdd, 0x29, 0xff, 0x73, 0xdd, 0x29, 0xbf, 0xbd, 0xe9, 0x94, 0xae, 0x6e,
-	0x3a, 0xa5, 0xbf, 0x6e, 0x3a, 0xa5, 0x1f, 0x3e, 0x2b, 0xb4, 0x9b, 0x4f, 0x59, 0x44, 0x99, 0xfe,
-	0x79, 0xc6, 0x82, 0x73, 0x6b, 0x6a, 0x89, 0x0f, 0xf0, 0xf3, 0xcf, 0x9f, 0x2d, 0xff, 0x53, 0xd0,
-	0x5f, 0x95, 0x93, 0xe7, 0xc5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x4d, 0xba, 0x76, 0x82,
-	0x08, 0x00, 0x00,
+	// 1016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0xb6, 0x6c, 0xd5, 0x96, 0x57, 0xf2, 0x23, 0x1b, 0xd7, 0xa1, 0x5d, 0x47, 0x14, 0xb6, 0x17,
+	0x5f, 0x42, 0xc6, 0x4a, 0xd0, 0x02, 0x3e, 0xd2, 0x41, 0x61, 0xb7, 0x0d, 0xe0, 0xd2, 0x49, 0x5b,
+	0x14, 0x28, 0x08, 0x3e, 0x56, 0xd2, 0xc2, 0x24, 0x57, 0xe0, 0xae, 0x0c, 0xbb, 0xbf, 0xa0, 0xbd,
+	0x05, 0x3d, 0xe5, 0xd8, 0xfe, 0x9b, 0x9c, 0x5a, 0x1f, 0x7b, 0x62, 0x0b, 0xfb, 0x1f, 0xe8, 0xd8,
+	0x53, 0xb1, 0x0f, 0x3e, 0x6c, 0xb9, 0x88, 0x73, 0x91, 0x76, 0x67, 0xbe, 0xef, 0x1b, 0xee, 0xcc,
+	0xce, 0x90, 0xc0, 0x24, 0x41, 0x68, 0x73, 0x9c, 0x46, 0x38, 0x4b, 0x48, 0xca, 0x6b, 0x4b, 0x6b,
+	0x9c, 0x51, 0x4e, 0xe1, 0x2a, 0x09, 0x42, 0xab, 0xb2, 0x6e, 0xf7, 0xea, 0xe0, 0x8b, 0x31, 0x66,
+	0xf6, 0x99, 0x1f, 0x93, 0xc8, 0xe7, 0x34, 0x53, 0x8c, 0xed, 0x9d, 0x19, 0x84, 0xfc, 0xd5, 0xde,
+	0x87, 0x21, 0x4d, 0x07, 0x84, 0xda, 0xe3, 0x8c, 0xd2, 0x41, 0x61, 0xec, 0x0e, 0x29, 0x1d, 0xc6,
+	0xd8, 0x96, 0xbb, 0x60, 0x32, 0xb0, 0xa3, 0x49, 0xe6, 0x73, 0x42, 0x53, 0xed, 0x37, 0x6f, 0xfb,
+	0x39, 0x49, 0x30, 0xe3, 0x7e, 0x32, 0xd6, 0x80, 0x47, 0xe2, 0x18, 0x61, 0x4c, 0x70, 0xca, 0xf5,
+	0x5f, 0xc1, 0x94, 0x0e, 0x9a, 0x24, 0x84, 0x27, 0xd2, 0x59, 0x2e, 0x35, 0x60, 0x63, 0x48, 0x87,
+	0x54, 0x2e, 0x6d, 0xb1, 0x52, 0x56, 0xf4, 0xe7, 0x22, 0x68, 0x1f, 0x48, 0x9d, 0x13, 0xee, 0x73,
+	0x0c, 0xb7, 0x40, 0x2b, 0x1c, 0xf9, 0x24, 0xf5, 0x48, 0x64, 0x34, 0x7a, 0x8d, 0xdd, 0x65, 0x77,
+	0x49, 0xee, 0x8f, 0x22, 0xf8, 0x1a, 0xb4, 0x79, 0x36, 0x61, 0xdc, 0x8b, 0xf1, 0x19, 0x8e, 0x8d,
+	0xf9, 0x5e, 0x63, 0xb7, 0xdd, 0x37, 0xac, 0x9b, 0x69, 0xb3, 0xbe, 0xc8, 0xfc, 0x50, 0x1c, 0xc8,
+	0xd9, 0x7e, 0x97, 0x9b, 0x73, 0xd3, 0xdc, 0x84, 0x17, 0x7e, 0x12, 0xef, 0xa3, 0x1a, 0x15, 0xb9,
+	0x40, 0xee, 0xbe, 0x16, 0x1b, 0x38, 0x00, 0x6b, 0x72, 0x47, 0xd2, 0xa1, 0x37, 0xc6, 0x19, 0xa1,
+	0x91, 0xb1, 0x20, 0xa5, 0xb7, 0x2c, 0x95, 0x0c, 0xab, 0x48, 0x86, 0xf5, 0x42, 0x27, 0xcb, 0x41,
+	0x5a, 0x7b, 0xb3, 0xa6, 0x5d, 0xf1, 0xd1, 0xdb, 0xbf, 0xcd, 0x86, 0xbb, 0x5a, 0x58, 0x8f, 0xa5,
+	0x11, 0x12, 0xb0, 0x3e, 0x49, 0x03, 0x9a, 0x46, 0xb5, 0x40, 0xcd, 0xf7, 0x05, 0xfa, 0x54, 0x07,
+	0x7a, 0xa4, 0x02, 0xdd, 0x16, 0x50, 0x91, 0xd6, 0x4a, 0xb3, 0x0e, 0x85, 0xc1, 0x5a, 0xe2, 0x9f,
+	0x7b, 0x61, 0x4c, 0xc3, 0x53, 0x2f, 0xca, 0xc8, 0x80, 0x1b, 0x1f, 0x7d, 0xe0, 0x91, 0x6e, 0xf1,
+	0x55, 0xa0, 0x95, 0xc4, 0x3f, 0x3f, 0x10, 0xc6, 0x17, 0xc2, 0x06, 0x5f, 0x83, 0x95, 0x41, 0x46,
+	0x7f, 0xc2, 0xa9, 0x37, 0xc2, 0x64, 0x38, 0xe2, 0xc6, 0xa2, 0x0c, 0x02, 0x65, 0x49, 0xf4, 0xe5,
+	0x38, 0x94, 0x1e, 0x67, 0x47, 0xab, 0x6f, 0x28, 0xf5, 0x1b, 0x34, 0xe4, 0x76, 0xd4, 0x5e, 0x61,
+	0x85, 0x6c, 0xec, 0x73, 0xcc, 0x78, 0x21, 0xbb, 0x74, 0x5f, 0xd9, 0x1b, 0x34, 0xe4, 0x76, 0xd4,
+	0x5e, 0xcb, 0x1e, 0x81, 0xb6, 0x6c, 0x05, 0x8f, 0x8d, 0x71, 0xc8, 0x8c, 0x56, 0x6f, 0x61, 0xb7,
+	0xdd, 0x5f, 0xb7, 0x48, 0xc8, 0xfa, 0xcf, 0xac, 0x63, 0xe1, 0x39, 0x19, 0xe3, 0xd0, 0xd9, 0xac,
+	0xae, 0x4c, 0x0d, 0x8e, 0x5c, 0x30, 0x2e, 0x20, 0x0c, 0x1e, 0x82, 0x07, 0x11, 0x8e, 0xfd, 0x0b,
+	0x4f, 0x74, 0x47, 0x51, 0xcb, 0xe5, 0x5e, 0x63, 0xb7, 0xe9, 0xec, 0x4c, 0x73, 0xd3, 0x50, 0xf4,
+	0x19, 0x08, 0x72, 0xd7, 0xa4, 0xed, 0x15, 0x49, 0xb0, 0xae, 0xd4, 0x57, 0x00, 0x2a, 0x58, 0x20,
+	0x73, 0xad, 0xa5, 0x80, 0x94, 0x7a, 0x3c, 0xcd, 0xcd, 0xad, 0xba, 0x54, 0x1d, 0x83, 0xdc, 0x75,
+	0x69, 0x74, 0x84, 0x4d, 0x89, 0xed, 0x37, 0x7f, 0xfe, 0xcd, 0x9c, 0x43, 0x7f, 0xcc, 0x83, 0xd5,
+	0x03, 0x9a, 0x32, 0x9c, 0xb2, 0x09, 0x53, 0x4d, 0xe5, 0x80, 0xe5, 0xb2, 0x8f, 0x65, 0x57, 0xb5,
+	0xfb, 0xdb, 0x33, 0x37, 0xe1, 0x55, 0x81, 0x70, 0x5a, 0x22, 0xab, 0x6f, 0x44, 0xc1, 0x2b, 0x1a,
+	0x7c, 0x0e, 0x9a, 0x19, 0xa5, 0x5c, 0xb7, 0xdd, 0xb6, 0x2a, 0x46, 0xd5, 0xe3, 0x2f, 0x71, 0x76,
+	0x1a, 0x63, 0x97, 0x52, 0xee, 0x34, 0x05, 0xdd, 0x95, 0x68, 0xf8, 0x14, 0x2c, 0xea, 0x22, 0x2e,
+	0xfc, 0x6f, 0x11, 0x15, 0x5e, 0xe3, 0xe0, 0x2f, 0x0d, 0xb0, 0x91, 0xe2, 0x73, 0xee, 0x95, 0xd3,
+	0x8e, 0x79, 0x23, 0x9f, 0x8d, 0x64, 0xaf, 0x74, 0x9c, 0xef, 0xa6, 0xb9, 0xf9, 0x89, 0x4a, 0xca,
+	0x5d, 0x28, 0xf4, 0x6f, 0x6e, 0x3e, 0x1f, 0x12, 0x3e, 0x9a, 0x04, 0xe2, 0xe9, 0xee, 0x1e, 0xb8,
+	0x76, 0x4c, 0x02, 0x66, 0x07, 0x17, 0x1c, 0x33, 0xeb, 0x10, 0x9f, 0x3b, 0x62, 0xe1, 0x42, 0x21,
+	0xf7, 0x6d, 0xa9, 0x76, 0xe8, 0xb3, 0x91, 0x4e, 0xe8, 0xef, 0xf3, 0xa0, 0xf3, 0x92, 0xb0, 0x00,
+	0x8f, 0xfc, 0x33, 0x42, 0x27, 0x19, 0xdc, 0x03, 0xcb, 0xea, 0x04, 0xe5, 0x90, 0x72, 0x36, 0xa6,
+	0xb9, 0xb9, 0xae, 0x1e, 0xab, 0x74, 0x21, 0xb7, 0xa5, 0xd6, 0x47, 0x11, 0xb4, 0x6a, 0x63, 0x6d,
+	0x5e, 0x32, 0x1e, 0x4e, 0x73, 0x73, 0x4d, 0x33, 0xb4, 0x07, 0x55, 0xb3, 0xee, 0x1b, 0xd0, 0x1a,
+	0x61, 0x3f, 0xc2, 0x99, 0xb7, 0xa7, 0x33, 0xb7, 0x79, 0x7b, 0xd0, 0x1d, 0x4a, 0xbf, 0xd3, 0xbd,
+	0xca, 0xcd, 0x25, 0xb5, 0xde, 0xab, 0x24, 0x0b, 0x32, 0x72, 0x97, 0xd4, 0x72, 0xaf, 0x26, 0xd9,
+	0x37, 0x9a, 0xf7, 0x95, 0xec, 0xcf, 0x48, 0xf6, 0x4b, 0xc9, 0xfe, 0x7e, 0x4b, 0xe4, 0xe7, 0xad,
+	0xc8, 0xd1, 0xaf, 0x0b, 0x60, 0x51, 0x31, 0xa0, 0x0f, 0x56, 0x18, 0x19, 0xa6, 0x38, 0xf2, 0x14,
+	0x4c, 0x5f, 0xb8, 0x6e, 0x3d, 0x90, 0x7a, 0x4f, 0x9d, 0x48, 0x98, 0x0e, 0xba, 0x73, 0x99, 0x9b,
+	0x8d, 0xaa, 0x95, 0x6f, 0x48, 0x20, 0xb7, 0xc3, 0x6a, 0x58, 0xf8, 0x23, 0x58, 0x29, 0xeb, 0xee,
+	0x31, 0x5c, 0x5c, 0xca, 0x3b, 0x42, 0x94, 0x05, 0x3d, 0xc1, 0xdc, 0x31, 0x2a, 0xf9, 0x1b, 0x74,
+	0xe4, 0x76, 0xce, 0x6a, 0x38, 0xf8, 0x3d, 0x50, 0xb3, 0x5b, 0xc6, 0x7f, 0xcf, 0xe5, 0x7d, 0xac,
+	0x27, 0xd0, 0xc7, 0xb5, 0x37, 0x41, 0xc9, 0x43, 0xee, 0x8a, 0x36, 0xe8, 0x19, 0x14, 0x03, 0x58,
+	0x20, 0xaa, 0x8b, 0x6b, 0x34, 0xef, 0xf5, 0xf4, 0xb5, 0x71, 0x30, 0xab, 0x81, 0xdc, 0x07, 0xda,
+	0x58, 0x5d, 0x61, 0xf4, 0x25, 0x68, 0x15, 0x6f, 0x43, 0xb8, 0x03, 0x96, 0xd3, 0x49, 0x82, 0x33,
+	0xe1, 0x91, 0x15, 0x59, 0x70, 0x2b, 0x03, 0xec, 0x81, 0x76, 0x84, 0x53, 0x9a, 0x90, 0x54, 0xfa,
+	0xe7, 0xa5, 0xbf, 0x6e, 0x72, 0x8e, 0xdf, 0x5d, 0x75, 0x1b, 0x97, 0x57, 0xdd, 0xc6, 0x3f, 0x57,
+	0xdd, 0xc6, 0x9b, 0xeb, 0xee, 0xdc, 0xe5, 0x75, 0x77, 0xee, 0xaf, 0xeb, 0xee, 0xdc, 0x0f, 0x9f,
+	0xd5, 0xda, 0x2d, 0xa4, 0x2c, 0xa1, 0x4c, 0xff, 0x3d, 0x61, 0xd1, 0xa9, 0x7d, 0x6e, 0x8b, 0xef,
+	0x82, 0xa7, 0x9f, 0x3f, 0xb9, 0xfd, 0xad, 0x12, 0x2c, 0xca, 0xc9, 0xf3, 0xec, 0xbf, 0x01, 0x00,
+	0x36, 0x49, 0xd4, 0xbf, 0x19, 0x09, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -393,6 +402,16 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelayBlockPeriod != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.DelayBlockPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DelayTimePeriod != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.DelayTimePeriod))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.ProofSpecs) > 0 {
 		for iNdEx := len(m.ProofSpecs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -731,6 +750,12 @@ func (m *ClientState) Size() (n int) {
 			n += 1 + l + sovTendermint(uint64(l))
 		}
 	}
+	if m.DelayTimePeriod != 0 {
+		n += 1 + sovTendermint(uint64(m.DelayTimePeriod))
+	}
+	if m.DelayBlockPeriod != 0 {
+		n += 1 + sovTendermint(uint64(m.DelayBlockPeriod))
+	}
 	return n
 }
 
@@ -1115,6 +1140,44 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayTimePeriod", wireType)
+			}
+			m.DelayTimePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayTimePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlockPeriod", wireType)
+			}
+			m.DelayBlockPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlockPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTendermint(dAtA[iNdEx:])
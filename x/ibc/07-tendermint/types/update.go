@@ -2,6 +2,8 @@ package types
 
 import (
 	"bytes"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/light"
@@ -15,6 +17,42 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
+var _ clienttypes.ClientStateUpdateValidator = (*ClientState)(nil)
+
+// ValidateUpdate returns an error if a header update changed any field of the
+// client state other than the latest height. The error lists the changed fields.
+func (cs ClientState) ValidateUpdate(updated exported.ClientState) error {
+	tmUpdated, ok := updated.(*ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientStateUpdate, "invalid client state type %T, expected %T", updated, &ClientState{},
+		)
+	}
+
+	previous := reflect.ValueOf(cs)
+	current := reflect.ValueOf(*tmUpdated)
+
+	var changed []string
+	for i := 0; i < previous.NumField(); i++ {
+		name := previous.Type().Field(i).Name
+		if name == "LatestHeight" {
+			continue
+		}
+		if !reflect.DeepEqual(previous.Field(i).Interface(), current.Field(i).Interface()) {
+			changed = append(changed, name)
+		}
+	}
+
+	if len(changed) != 0 {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientStateUpdate, "only the latest height can be updated, changed fields: %s",
+			strings.Join(changed, ", "),
+		)
+	}
+
+	return nil
+}
+
 // CheckHeaderAndUpdateState checks if the provided header is valid, and if valid it will:
 // create the consensus state for the header.Height
 // and update the client state if the header height is greater than the latest client state height
@@ -57,7 +95,8 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 		return nil, nil, err
 	}
 
-	newClientState, consensusState := update(&cs, tmHeader)
+	// update an independent copy so that the client state the caller holds is never mutated
+	newClientState, consensusState := update(cs.Clone().(*ClientState), tmHeader)
 	return newClientState, consensusState, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	ics23 "github.com/confio/ics23/go"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,7 +19,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState           = (*ClientState)(nil)
+	_ clienttypes.ChainIDClientState = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(chainID string, height clienttypes.Height) *ClientState {
@@ -53,6 +57,11 @@ func (cs ClientState) GetFrozenHeight() uint64 {
 	return 0
 }
 
+// Clone returns a deep copy of the client state.
+func (cs ClientState) Clone() exported.ClientState {
+	return proto.Clone(&cs).(*ClientState)
+}
+
 // Validate performs a basic validation of the client state fields.
 func (cs ClientState) Validate() error {
 	if strings.TrimSpace(cs.ChainId) == "" {
@@ -69,16 +78,28 @@ func (cs ClientState) GetProofSpecs() []*ics23.ProofSpec {
 	return nil
 }
 
+// GetDelayTimePeriod returns 0.
+func (cs ClientState) GetDelayTimePeriod() uint64 {
+	return 0
+}
+
+// GetDelayBlockPeriod returns 0.
+func (cs ClientState) GetDelayBlockPeriod() uint64 {
+	return 0
+}
+
 // CheckHeaderAndUpdateState updates the localhost client. It only needs access to the context
 func (cs *ClientState) CheckHeaderAndUpdateState(
 	ctx sdk.Context, _ codec.BinaryMarshaler, _ sdk.KVStore, _ exported.Header,
 ) (exported.ClientState, exported.ConsensusState, error) {
+	// update an independent copy so that the client state the caller holds is never mutated
+	clientState := cs.Clone().(*ClientState)
 	// use the chain ID from context since the localhost client is from the running chain (i.e self).
-	cs.ChainId = ctx.ChainID()
+	clientState.ChainId = ctx.ChainID()
 	// Hardcode 0 for epoch number for now
 	// TODO: Retrieve epoch number from chain-id
-	cs.Height = clienttypes.NewHeight(0, uint64(ctx.BlockHeight()))
-	return cs, nil, nil
+	clientState.Height = clienttypes.NewHeight(0, uint64(ctx.BlockHeight()))
+	return clientState, nil, nil
 }
 
 // CheckMisbehaviourAndUpdateState implements ClientState
@@ -301,3 +322,23 @@ func (cs ClientState) VerifyNextSequenceRecv(
 
 	return nil
 }
+
+// VerifyStoreValue verifies that the value stored locally at the given key is
+// equal to the given value. It performs the same local store read and comparison
+// as the localhost client verification of packet commitments and acknowledgements,
+// for any key of the store.
+func VerifyStoreValue(store sdk.KVStore, key, value []byte) error {
+	data := store.Get(key)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(ErrFailedStoreValueVerification, "not found for key %s", key)
+	}
+
+	if !bytes.Equal(data, value) {
+		return sdkerrors.Wrapf(
+			ErrFailedStoreValueVerification,
+			"value ≠ stored value: \n%X\n≠\n%X", value, data,
+		)
+	}
+
+	return nil
+}
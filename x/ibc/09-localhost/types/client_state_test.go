@@ -50,6 +50,26 @@ func (suite *LocalhostTestSuite) TestValidate() {
 	}
 }
 
+func (suite *LocalhostTestSuite) TestGetDelayPeriods() {
+	clientState := types.NewClientState("chainID", clienttypes.NewHeight(3, 10))
+
+	suite.Require().Zero(clientState.GetDelayTimePeriod())
+	suite.Require().Zero(clientState.GetDelayBlockPeriod())
+}
+
+func (suite *LocalhostTestSuite) TestClone() {
+	clientState := types.NewClientState("chainID", clientHeight)
+
+	clone, ok := clientState.Clone().(*types.ClientState)
+	suite.Require().True(ok)
+	suite.Require().Equal(clientState, clone)
+
+	clone.ChainId = "otherchain"
+	clone.Height = clientHeight.Increment()
+	suite.Require().Equal("chainID", clientState.ChainId)
+	suite.Require().Equal(clientHeight, clientState.Height)
+}
+
 func (suite *LocalhostTestSuite) TestVerifyClientState() {
 	clientState := types.NewClientState("chainID", clientHeight)
 	invalidClient := types.NewClientState("chainID", clienttypes.NewHeight(0, 12))
@@ -123,7 +143,11 @@ func (suite *LocalhostTestSuite) TestCheckHeaderAndUpdateState() {
 	cs, _, err := clientState.CheckHeaderAndUpdateState(suite.ctx, nil, nil, nil)
 	suite.Require().NoError(err)
 	suite.Require().Equal(suite.ctx.BlockHeight(), int64(cs.GetLatestHeight()))
-	suite.Require().Equal(suite.ctx.BlockHeader().ChainID, clientState.ChainId)
+	suite.Require().Equal(suite.ctx.BlockHeader().ChainID, cs.(*types.ClientState).GetChainID())
+
+	// the client state the update was called on is left unchanged
+	suite.Require().Equal("chainID", clientState.ChainId)
+	suite.Require().Equal(clientHeight, clientState.Height)
 }
 
 func (suite *LocalhostTestSuite) TestVerifyConnectionState() {
@@ -469,3 +493,45 @@ func (suite *LocalhostTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+func (suite *LocalhostTestSuite) TestVerifyStoreValue() {
+	key := []byte("clients/clientidone/clientState")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		value    []byte
+		expPass  bool
+	}{
+		{
+			"matching value", func() {
+				suite.store.Set(key, []byte("value"))
+			}, []byte("value"), true,
+		},
+		{
+			"different value stored", func() {
+				suite.store.Set(key, []byte("different"))
+			}, []byte("value"), false,
+		},
+		{
+			"no value stored", func() {}, []byte("value"), false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := types.VerifyStoreValue(suite.store, key, tc.value)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().True(types.ErrFailedStoreValueVerification.Is(err), err)
+			}
+		})
+	}
+}
@@ -6,5 +6,6 @@ import (
 
 // Localhost sentinel errors
 var (
-	ErrConsensusStatesNotStored = sdkerrors.Register(SubModuleName, 2, "localhost does not store consensus states")
+	ErrConsensusStatesNotStored     = sdkerrors.Register(SubModuleName, 2, "localhost does not store consensus states")
+	ErrFailedStoreValueVerification = sdkerrors.Register(SubModuleName, 3, "failed store value verification")
 )
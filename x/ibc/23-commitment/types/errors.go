@@ -12,4 +12,6 @@ var (
 	ErrInvalidProof       = sdkerrors.Register(SubModuleName, 2, "invalid proof")
 	ErrInvalidPrefix      = sdkerrors.Register(SubModuleName, 3, "invalid prefix")
 	ErrInvalidMerkleProof = sdkerrors.Register(SubModuleName, 4, "invalid merkle proof")
+	ErrKeyPresent         = sdkerrors.Register(SubModuleName, 5, "key unexpectedly present")
+	ErrRootMismatch       = sdkerrors.Register(SubModuleName, 6, "proof did not commit to expected root")
 )
@@ -149,6 +149,26 @@ func (proof MerkleProof) VerifyMembership(specs []*ics23.ProofSpec, root exporte
 	return nil
 }
 
+// StoredValue returns the value committed to by the lowest existence proof, as it
+// is stored on the proven chain. An error is returned if the proof isn't a
+// membership proof.
+func (proof MerkleProof) StoredValue() ([]byte, error) {
+	if proof.Empty() {
+		return nil, sdkerrors.Wrap(ErrInvalidMerkleProof, "proof cannot be empty")
+	}
+
+	proofs, err := convertProofs(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(proofs) == 0 || proofs[0].GetExist() == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidProof, "proof is not a membership proof")
+	}
+
+	return proofs[0].GetExist().Value, nil
+}
+
 // VerifyNonMembership verifies the absence of a merkle proof against the given root and path.
 // VerifyNonMembership verifies a chained proof where the absence of a given path is proven
 // at the lowest subtree and then each subtree's inclusion is proved up to the final root.
@@ -177,9 +197,18 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	// of all subroots up to final root
 	subroot, err := proofs[0].Calculate()
 	if err != nil {
-		sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0. %v", err)
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for proof index 0. %v", err)
 	}
 	key := mpath.KeyPath.GetKey(-1)
+
+	// an existence proof of the key committed to by the root shows that the key is
+	// present, as opposed to the proof failing to verify against the root
+	if exist := proofs[0].GetExist(); exist != nil && bytes.Equal(exist.Key, key) {
+		if err := verifyChainedMembershipProof(root.GetHash(), specs, proofs, mpath.KeyPath, subroot, 1); err != nil {
+			return err
+		}
+		return sdkerrors.Wrapf(ErrKeyPresent, "proof is an existence proof of key %s", string(key))
+	}
 	if ok := ics23.VerifyNonMembership(specs[0], subroot, proofs[0], key); !ok {
 		return sdkerrors.Wrapf(ErrInvalidProof, "could not verify absence of key %s", string(key))
 	}
@@ -236,7 +265,7 @@ func (proof MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root ex
 		}
 	} else if !bytes.Equal(root.GetHash(), subroot) {
 		// Since we are not chaining proofs, we must check first subroot equals given root
-		return sdkerrors.Wrapf(ErrInvalidProof, "batched proof did not commit to expected root: %X, got: %X", root.GetHash(), subroot)
+		return sdkerrors.Wrapf(ErrRootMismatch, "batched proof: expected root %X, got %X", root.GetHash(), subroot)
 	}
 
 	return nil
@@ -287,7 +316,7 @@ func (proof MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root
 		}
 	} else if !bytes.Equal(root.GetHash(), subroot) {
 		// Since we are not chaining proofs, we must check first subroot equals given root
-		return sdkerrors.Wrapf(ErrInvalidProof, "batched proof did not commit to expected root: %X, got: %X", root.GetHash(), subroot)
+		return sdkerrors.Wrapf(ErrRootMismatch, "batched proof: expected root %X, got %X", root.GetHash(), subroot)
 	}
 
 	return nil
@@ -324,7 +353,7 @@ func verifyChainedMembershipProof(root []byte, specs []*ics23.ProofSpec, proofs
 	}
 	// Check that chained proof root equals passed-in root
 	if !bytes.Equal(root, subroot) {
-		return sdkerrors.Wrapf(ErrInvalidProof, "proof did not commit to expected root: %X, got: %X", root, subroot)
+		return sdkerrors.Wrapf(ErrRootMismatch, "expected root %X, got %X", root, subroot)
 	}
 	return nil
 }
@@ -79,6 +79,36 @@ func (suite *MerkleTestSuite) TestVerifyMembership() {
 
 }
 
+func (suite *MerkleTestSuite) TestStoredValue() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	suite.store.Commit()
+
+	res := suite.store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	suite.Require().NotNil(res.ProofOps)
+
+	value, err := types.MerkleProof{Proof: res.ProofOps}.StoredValue()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("MYVALUE"), value)
+
+	// non-membership proof
+	res = suite.store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()),
+		Data:  []byte("MYABSENTKEY"),
+		Prove: true,
+	})
+	suite.Require().NotNil(res.ProofOps)
+
+	_, err = types.MerkleProof{Proof: res.ProofOps}.StoredValue()
+	suite.Require().Error(err)
+
+	_, err = types.MerkleProof{}.StoredValue()
+	suite.Require().Error(err)
+}
+
 func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
 	cid := suite.store.Commit()
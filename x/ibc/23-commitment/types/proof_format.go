@@ -0,0 +1,84 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
+)
+
+// ProofFormat discriminates the encodings a commitment proof can be decoded from.
+type ProofFormat int
+
+const (
+	// ProofFormatICS23 is a MerkleProof whose proof ops are ICS23 commitment proofs.
+	ProofFormatICS23 ProofFormat = iota
+	// ProofFormatLegacy is the JSON encoding of a MerkleProof, accepted by a
+	// LegacyProofCodec.
+	ProofFormatLegacy
+)
+
+// String implements the Stringer interface.
+func (f ProofFormat) String() string {
+	switch f {
+	case ProofFormatICS23:
+		return "ics23"
+	case ProofFormatLegacy:
+		return "legacy"
+	default:
+		return ""
+	}
+}
+
+// LegacyProofCodec is the codec passed to the light client verification when
+// proofs that don't parse as ICS23 are accepted.
+type LegacyProofCodec struct {
+	codec.BinaryMarshaler
+}
+
+// NewLegacyProofCodec wraps the given codec to accept legacy proofs.
+func NewLegacyProofCodec(cdc codec.BinaryMarshaler) LegacyProofCodec {
+	return LegacyProofCodec{
+		BinaryMarshaler: cdc,
+	}
+}
+
+// DecodeProof decodes the proof bytes submitted for a verification. The ICS23
+// format always takes precedence: a proof is decoded as a MerkleProof of ICS23
+// commitment proofs whenever it parses as one. Only if it doesn't, and the codec
+// is a LegacyProofCodec, it is decoded from the legacy format. An error is
+// returned if the proof doesn't parse as ICS23 and the codec isn't a
+// LegacyProofCodec.
+func DecodeProof(cdc codec.BinaryMarshaler, proof []byte) (exported.Proof, ProofFormat, error) {
+	var merkleProof MerkleProof
+	err := cdc.UnmarshalBinaryBare(proof, &merkleProof)
+	if err == nil {
+		err = validateICS23Proof(merkleProof)
+	}
+	if err == nil {
+		return merkleProof, ProofFormatICS23, nil
+	}
+
+	if _, ok := cdc.(LegacyProofCodec); !ok {
+		return nil, ProofFormatICS23, sdkerrors.Wrapf(ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof: %s", err)
+	}
+
+	var legacyProof MerkleProof
+	if err := SubModuleCdc.UnmarshalJSON(proof, &legacyProof); err != nil {
+		return nil, ProofFormatLegacy, sdkerrors.Wrapf(ErrInvalidProof, "failed to decode legacy proof: %s", err)
+	}
+	if err := validateICS23Proof(legacyProof); err != nil {
+		return nil, ProofFormatLegacy, sdkerrors.Wrapf(ErrInvalidProof, "invalid legacy proof: %s", err)
+	}
+
+	return legacyProof, ProofFormatLegacy, nil
+}
+
+// validateICS23Proof returns an error if the merkle proof is empty or if any of
+// its proof ops cannot be unmarshalled into an ICS23 commitment proof.
+func validateICS23Proof(proof MerkleProof) error {
+	if proof.Proof == nil || len(proof.Proof.Ops) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMerkleProof, "proof has no proof ops")
+	}
+	_, err := convertProofs(proof)
+	return err
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+func (suite *MerkleTestSuite) TestDecodeProof() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := suite.store.Commit()
+
+	res := suite.store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	suite.Require().NotNil(res.ProofOps)
+
+	cdc := types.SubModuleCdc
+	ics23Proof, err := cdc.MarshalBinaryBare(&types.MerkleProof{Proof: res.ProofOps})
+	suite.Require().NoError(err)
+
+	// the legacy format is the JSON encoding of the merkle proof
+	legacyProof, err := cdc.MarshalJSON(&types.MerkleProof{Proof: res.ProofOps})
+	suite.Require().NoError(err)
+
+	cases := []struct {
+		name      string
+		proof     []byte
+		cdc       codec.BinaryMarshaler
+		expFormat types.ProofFormat
+		expPass   bool
+	}{
+		{"ICS23 proof", ics23Proof, cdc, types.ProofFormatICS23, true},
+		{"ICS23 proof takes precedence over legacy format", ics23Proof, types.NewLegacyProofCodec(cdc), types.ProofFormatICS23, true},
+		{"legacy proof", legacyProof, types.NewLegacyProofCodec(cdc), types.ProofFormatLegacy, true},
+		{"legacy proof not allowed", legacyProof, cdc, types.ProofFormatICS23, false},
+		{"invalid legacy proof", []byte("{invalid"), types.NewLegacyProofCodec(cdc), types.ProofFormatLegacy, false},
+		{"empty legacy proof", []byte("{}"), types.NewLegacyProofCodec(cdc), types.ProofFormatLegacy, false},
+		{"empty proof", []byte{}, cdc, types.ProofFormatICS23, false},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			proof, format, err := types.DecodeProof(tc.cdc, tc.proof)
+			suite.Require().Equal(tc.expFormat, format, "format mismatch for case %d", i)
+
+			if !tc.expPass {
+				suite.Require().Error(err, "unexpected success for case %d", i)
+				suite.Require().Nil(proof)
+				return
+			}
+
+			suite.Require().NoError(err, "unexpected error for case %d", i)
+
+			root := types.NewMerkleRoot(cid.Hash)
+			path := types.NewMerklePath([]string{suite.storeKey.Name(), "MYKEY"})
+			suite.Require().NoError(proof.VerifyMembership(types.GetSDKSpecs(), &root, path, []byte("MYVALUE")))
+		})
+	}
+}
@@ -22,6 +22,19 @@ const (
 var (
 	KeyClientStorePrefix = []byte("clients")
 	KeyConnectionPrefix  = []byte("connections")
+
+	// KeyHeightOrderedClientsPrefix is the prefix of the index of clients ordered
+	// by latest height. It isn't part of the ICS path space.
+	KeyHeightOrderedClientsPrefix = []byte("heightOrderedClients")
+
+	// KeyIndexedClientHeightPrefix is the prefix of the heights at which clients
+	// are stored in the height ordered client index. It isn't part of the ICS
+	// path space.
+	KeyIndexedClientHeightPrefix = []byte("indexedClientHeights")
+
+	// KeyConsensusHeightPrefix is the prefix of the index of the client consensus
+	// states ordered by height. It isn't part of the ICS path space.
+	KeyConsensusHeightPrefix = []byte("consensusHeights")
 )
 
 // KVStore key prefixes for IBC
@@ -89,6 +102,40 @@ func KeyConsensusState(height uint64) []byte {
 	return []byte(ConsensusStatePath(height))
 }
 
+// KeyConsensusHeight returns the store key of a consensus state in the index of
+// the consensus states of a client ordered by height, given the binary encoding
+// of its epoch-aware height. The index isn't part of the ICS path space.
+func KeyConsensusHeight(clientID string, heightBz []byte) []byte {
+	return append(KeyConsensusHeightsPrefix(clientID), heightBz...)
+}
+
+// KeyConsensusHeightsPrefix returns the prefix of the index of the consensus
+// states of a client ordered by height.
+func KeyConsensusHeightsPrefix(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", KeyConsensusHeightPrefix, clientID))
+}
+
+// KeyHeightOrderedClient returns the store key of a client in the index of
+// clients ordered by latest height, given the binary encoding of its epoch-aware
+// latest height. The encoding is stored inverted so that the index iterates from
+// the highest to the lowest height, and clients with the same height are ordered
+// by identifier.
+func KeyHeightOrderedClient(heightBz []byte, clientID string) []byte {
+	key := make([]byte, len(KeyHeightOrderedClientsPrefix)+len(heightBz)+len(clientID))
+	n := copy(key, KeyHeightOrderedClientsPrefix)
+	for i, b := range heightBz {
+		key[n+i] = ^b
+	}
+	copy(key[n+len(heightBz):], clientID)
+	return key
+}
+
+// KeyIndexedClientHeight returns the store key of the height at which a client
+// is stored in the height ordered client index.
+func KeyIndexedClientHeight(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyIndexedClientHeightPrefix, clientID))
+}
+
 // ICS03
 // The following paths are the keys to the store as defined in https://github.com/cosmos/ics/tree/master/spec/ics-003-connection-semantics#store-paths
 
@@ -102,6 +149,12 @@ func ConnectionPath(connectionID string) string {
 	return fmt.Sprintf("%s/%s", KeyConnectionPrefix, connectionID)
 }
 
+// ConnectionVerifiedHeightPath defines the path under which the height at which
+// the counterparty state of a connection was last verified is stored
+func ConnectionVerifiedHeightPath(connectionID string) string {
+	return fmt.Sprintf("connectionVerifiedHeights/%s", connectionID)
+}
+
 // KeyClientConnections returns the store key for the connectios of a given client
 func KeyClientConnections(clientID string) []byte {
 	return []byte(ClientConnectionsPath(clientID))
@@ -112,6 +165,12 @@ func KeyConnection(connectionID string) []byte {
 	return []byte(ConnectionPath(connectionID))
 }
 
+// KeyConnectionVerifiedHeight returns the store key for the verified height of a
+// particular connection
+func KeyConnectionVerifiedHeight(connectionID string) []byte {
+	return []byte(ConnectionVerifiedHeightPath(connectionID))
+}
+
 // ICS04
 // The following paths are the keys to the store as defined in https://github.com/cosmos/ics/tree/master/spec/ics-004-channel-and-packet-semantics#store-paths
 
@@ -20,6 +20,15 @@ type ClientState interface {
 	Validate() error
 	GetProofSpecs() []*ics23.ProofSpec
 
+	// Clone returns a deep copy of the client state, which can be mutated without
+	// affecting the original.
+	Clone() ClientState
+
+	// Delay periods that must elapse after a consensus state is stored before it
+	// can be used for verification. Clients without delay support return zero.
+	GetDelayTimePeriod() uint64
+	GetDelayBlockPeriod() uint64
+
 	// Update and Misbehaviour functions
 
 	CheckHeaderAndUpdateState(sdk.Context, codec.BinaryMarshaler, sdk.KVStore, Header) (ClientState, ConsensusState, error)
@@ -128,6 +137,26 @@ type ConsensusState interface {
 	ValidateBasic() error
 }
 
+// ValidatorSetConsensusState is implemented by the consensus states of clients
+// tracking a validator set, such as Tendermint clients.
+type ValidatorSetConsensusState interface {
+	ConsensusState
+
+	// GetNextValidatorsHash returns the hash of the validator set of the next block
+	GetNextValidatorsHash() []byte
+}
+
+// NextValidatorsHash returns the next validators hash of a consensus state. It
+// returns false if the consensus state doesn't track a validator set.
+func NextValidatorsHash(cs ConsensusState) ([]byte, bool) {
+	vcs, ok := cs.(ValidatorSetConsensusState)
+	if !ok {
+		return nil, false
+	}
+
+	return vcs.GetNextValidatorsHash(), true
+}
+
 // TypeClientMisbehaviour is the shared evidence misbehaviour type
 const TypeClientMisbehaviour string = "client_misbehaviour"
 
@@ -146,6 +175,9 @@ type Misbehaviour interface {
 type Header interface {
 	ClientType() ClientType
 	GetHeight() uint64
+	GetChainID() string
+	// GetTimestamp returns the timestamp (in nanoseconds) of the header
+	GetTimestamp() uint64
 	ValidateBasic() error
 }
 
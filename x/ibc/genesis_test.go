@@ -62,6 +62,9 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]connectiontypes.ConnectionPaths{
 						connectiontypes.NewConnectionPaths(clientID, []string{host.ConnectionPath(connectionID)}),
 					},
+					[]connectiontypes.ConnectionVerifiedHeight{
+						connectiontypes.NewConnectionVerifiedHeight(connectionID, suite.header.GetHeight()),
+					},
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
 					[]channeltypes.IdentifiedChannel{
@@ -121,6 +124,9 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					[]connectiontypes.ConnectionPaths{
 						connectiontypes.NewConnectionPaths(clientID, []string{host.ConnectionPath(connectionID)}),
 					},
+					[]connectiontypes.ConnectionVerifiedHeight{
+						connectiontypes.NewConnectionVerifiedHeight(connectionID, suite.header.GetHeight()),
+					},
 				),
 			},
 			expPass: false,
@@ -191,6 +197,9 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 					[]connectiontypes.ConnectionPaths{
 						connectiontypes.NewConnectionPaths(clientID, []string{host.ConnectionPath(connectionID)}),
 					},
+					[]connectiontypes.ConnectionVerifiedHeight{
+						connectiontypes.NewConnectionVerifiedHeight(connectionID, suite.header.GetHeight()),
+					},
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
 					[]channeltypes.IdentifiedChannel{
@@ -277,3 +286,32 @@ func (suite *HandlerTestSuite) TestExportGenesis() {
 		})
 	}
 }
+
+func (suite *HandlerTestSuite) TestConnectionVerifiedHeightsGenesis() {
+	suite.SetupTest()
+
+	_, _, connA, _ := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+
+	verifiedHeight, found := suite.chainA.App.IBCKeeper.ConnectionKeeper.GetConnectionVerifiedHeight(suite.chainA.GetContext(), connA.ID)
+	suite.Require().True(found)
+
+	gs := ibc.ExportGenesis(suite.chainA.GetContext(), *suite.chainA.App.IBCKeeper)
+	suite.Require().Equal(
+		[]connectiontypes.ConnectionVerifiedHeight{connectiontypes.NewConnectionVerifiedHeight(connA.ID, verifiedHeight)},
+		gs.ConnectionGenesis.ConnectionVerifiedHeights,
+	)
+
+	// the verified heights are imported by a new chain from the JSON genesis
+	cdc := codec.NewProtoCodec(suite.chainA.App.InterfaceRegistry())
+	var imported types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(gs), &imported)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	ibc.InitGenesis(ctx, *app.IBCKeeper, false, &imported)
+
+	height, found := app.IBCKeeper.ConnectionKeeper.GetConnectionVerifiedHeight(ctx, connA.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(verifiedHeight, height)
+	suite.Require().Equal(gs.ConnectionGenesis, ibc.ExportGenesis(ctx, *app.IBCKeeper).ConnectionGenesis)
+}
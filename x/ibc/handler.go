@@ -29,6 +29,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *clienttypes.MsgSubmitMisbehaviour:
 			return client.HandleMsgSubmitMisbehaviour(ctx, k.ClientKeeper, msg)
 
+		case *clienttypes.MsgFreezeClient:
+			return client.HandleMsgFreezeClient(ctx, k.ClientKeeper, msg)
+
 		// IBC connection msgs
 		case *connectiontypes.MsgConnectionOpenInit:
 			return connection.HandleMsgConnectionOpenInit(ctx, k.ConnectionKeeper, msg)
@@ -23,11 +23,51 @@ func (q Keeper) ConsensusState(c context.Context, req *clienttypes.QueryConsensu
 	return q.ClientKeeper.ConsensusState(c, req)
 }
 
+// ConsensusStateWithPredecessor implements the IBC QueryServer interface
+func (q Keeper) ConsensusStateWithPredecessor(c context.Context, req *clienttypes.QueryConsensusStateWithPredecessorRequest) (*clienttypes.QueryConsensusStateWithPredecessorResponse, error) {
+	return q.ClientKeeper.ConsensusStateWithPredecessor(c, req)
+}
+
+// OldestValidConsensusState implements the IBC QueryServer interface
+func (q Keeper) OldestValidConsensusState(c context.Context, req *clienttypes.QueryOldestValidConsensusStateRequest) (*clienttypes.QueryOldestValidConsensusStateResponse, error) {
+	return q.ClientKeeper.OldestValidConsensusState(c, req)
+}
+
 // ConsensusStates implements the IBC QueryServer interface
 func (q Keeper) ConsensusStates(c context.Context, req *clienttypes.QueryConsensusStatesRequest) (*clienttypes.QueryConsensusStatesResponse, error) {
 	return q.ClientKeeper.ConsensusStates(c, req)
 }
 
+// RecentConsensusStates implements the IBC QueryServer interface
+func (q Keeper) RecentConsensusStates(c context.Context, req *clienttypes.QueryRecentConsensusStatesRequest) (*clienttypes.QueryRecentConsensusStatesResponse, error) {
+	return q.ClientKeeper.RecentConsensusStates(c, req)
+}
+
+// ClientTypeCounts implements the IBC QueryServer interface
+func (q Keeper) ClientTypeCounts(c context.Context, req *clienttypes.QueryClientTypeCountsRequest) (*clienttypes.QueryClientTypeCountsResponse, error) {
+	return q.ClientKeeper.ClientTypeCounts(c, req)
+}
+
+// ClientStorageUsage implements the IBC QueryServer interface
+func (q Keeper) ClientStorageUsage(c context.Context, req *clienttypes.QueryClientStorageUsageRequest) (*clienttypes.QueryClientStorageUsageResponse, error) {
+	return q.ClientKeeper.ClientStorageUsage(c, req)
+}
+
+// Params implements the IBC QueryServer interface
+func (q Keeper) Params(c context.Context, req *clienttypes.QueryParamsRequest) (*clienttypes.QueryParamsResponse, error) {
+	return q.ClientKeeper.Params(c, req)
+}
+
+// ConsensusStatesAround implements the IBC QueryServer interface
+func (q Keeper) ConsensusStatesAround(c context.Context, req *clienttypes.QueryConsensusStatesAroundRequest) (*clienttypes.QueryConsensusStatesAroundResponse, error) {
+	return q.ClientKeeper.ConsensusStatesAround(c, req)
+}
+
+// ConsensusStateMetadata implements the IBC QueryServer interface
+func (q Keeper) ConsensusStateMetadata(c context.Context, req *clienttypes.QueryConsensusStateMetadataRequest) (*clienttypes.QueryConsensusStateMetadataResponse, error) {
+	return q.ClientKeeper.ConsensusStateMetadata(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (q Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return q.ConnectionKeeper.Connection(c, req)
@@ -53,6 +93,11 @@ func (q Keeper) ConnectionConsensusState(c context.Context, req *connectiontypes
 	return q.ConnectionKeeper.ConnectionConsensusState(c, req)
 }
 
+// ConnectionVerifiedConsensusState implements the IBC QueryServer interface
+func (q Keeper) ConnectionVerifiedConsensusState(c context.Context, req *connectiontypes.QueryConnectionVerifiedConsensusStateRequest) (*connectiontypes.QueryConnectionVerifiedConsensusStateResponse, error) {
+	return q.ConnectionKeeper.ConnectionVerifiedConsensusState(c, req)
+}
+
 // Channel implements the IBC QueryServer interface
 func (q Keeper) Channel(c context.Context, req *channeltypes.QueryChannelRequest) (*channeltypes.QueryChannelResponse, error) {
 	return q.ChannelKeeper.Channel(c, req)
@@ -102,3 +147,8 @@ func (q Keeper) UnrelayedPackets(c context.Context, req *channeltypes.QueryUnrel
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// ConsensusStatePrunable implements the IBC QueryServer interface
+func (q Keeper) ConsensusStatePrunable(c context.Context, req *channeltypes.QueryConsensusStatePrunableRequest) (*channeltypes.QueryConsensusStatePrunableResponse, error) {
+	return q.ChannelKeeper.ConsensusStatePrunable(c, req)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	ics23 "github.com/confio/ics23/go"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,7 +13,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState             = (*ClientState)(nil)
+	_ clienttypes.FreezableClientState = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance.
 func NewClientState(consensusState *ConsensusState) *ClientState {
@@ -47,6 +51,29 @@ func (cs ClientState) GetProofSpecs() []*ics23.ProofSpec {
 	return nil
 }
 
+// GetDelayTimePeriod returns 0 since solo machine clients don't support delays.
+func (cs ClientState) GetDelayTimePeriod() uint64 {
+	return 0
+}
+
+// GetDelayBlockPeriod returns 0 since solo machine clients don't support delays.
+func (cs ClientState) GetDelayBlockPeriod() uint64 {
+	return 0
+}
+
+// Clone returns a deep copy of the client state.
+func (cs ClientState) Clone() exported.ClientState {
+	return proto.Clone(&cs).(*ClientState)
+}
+
+// Freeze returns a copy of the client state frozen at the given height. The
+// frozen sequence of a solo machine client is the epoch height.
+func (cs ClientState) Freeze(frozenHeight clienttypes.Height) exported.ClientState {
+	frozen := cs.Clone().(*ClientState)
+	frozen.FrozenSequence = frozenHeight.EpochHeight
+	return frozen
+}
+
 // Validate performs basic validation of the client state fields.
 func (cs ClientState) Validate() error {
 	if cs.ConsensusState == nil {
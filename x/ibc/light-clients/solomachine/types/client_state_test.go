@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
@@ -70,6 +71,43 @@ func (suite *SoloMachineTestSuite) TestClientStateValidateBasic() {
 	}
 }
 
+func (suite *SoloMachineTestSuite) TestGetDelayPeriods() {
+	clientState := suite.solomachine.ClientState()
+
+	suite.Require().Zero(clientState.GetDelayTimePeriod())
+	suite.Require().Zero(clientState.GetDelayBlockPeriod())
+}
+
+func (suite *SoloMachineTestSuite) TestClone() {
+	clientState := suite.solomachine.ClientState()
+	sequence := clientState.ConsensusState.Sequence
+
+	clone, ok := clientState.Clone().(*types.ClientState)
+	suite.Require().True(ok)
+	suite.Require().Equal(clientState, clone)
+
+	// mutating the consensus state of the clone doesn't affect the original
+	clone.FrozenSequence = 1
+	clone.ConsensusState.Sequence++
+	clone.ConsensusState.PublicKey = nil
+
+	suite.Require().Zero(clientState.FrozenSequence)
+	suite.Require().Equal(sequence, clientState.ConsensusState.Sequence)
+	suite.Require().NotNil(clientState.ConsensusState.PublicKey)
+}
+
+func (suite *SoloMachineTestSuite) TestFreeze() {
+	clientState := suite.solomachine.ClientState()
+
+	frozen, ok := clientState.Freeze(clienttypes.NewHeight(0, 5)).(*types.ClientState)
+	suite.Require().True(ok)
+	suite.Require().True(frozen.IsFrozen())
+	suite.Require().Equal(uint64(5), frozen.FrozenSequence)
+
+	// the original client state is left unchanged
+	suite.Require().False(clientState.IsFrozen())
+}
+
 func (suite *SoloMachineTestSuite) TestVerifyClientState() {
 	// create client for tendermint so we can use client state for verification
 	clientA, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
@@ -58,3 +58,9 @@ func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
 		})
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestNextValidatorsHash() {
+	nextValsHash, ok := exported.NextValidatorsHash(suite.solomachine.ConsensusState())
+	suite.Require().False(ok)
+	suite.Require().Nil(nextValsHash)
+}
@@ -21,6 +21,16 @@ func (h Header) GetHeight() uint64 {
 	return h.Sequence
 }
 
+// GetChainID returns an empty string since solo machines don't have a chain-id.
+func (Header) GetChainID() string {
+	return ""
+}
+
+// GetTimestamp returns the timestamp of the new consensus state.
+func (h Header) GetTimestamp() uint64 {
+	return h.Timestamp
+}
+
 // GetPubKey unmarshals the new public key into a tmcrypto.PubKey type.
 func (h Header) GetPubKey() tmcrypto.PubKey {
 	publicKey, err := std.DefaultPublicKeyCodec{}.Decode(h.NewPublicKey)
@@ -38,6 +48,10 @@ func (h Header) ValidateBasic() error {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "sequence number cannot be zero")
 	}
 
+	if h.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "timestamp cannot be zero")
+	}
+
 	if len(h.Signature) == 0 {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "signature cannot be empty")
 	}
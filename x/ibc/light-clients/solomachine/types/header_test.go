@@ -24,6 +24,17 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 				Sequence:     0,
 				Signature:    header.Signature,
 				NewPublicKey: header.NewPublicKey,
+				Timestamp:    header.Timestamp,
+			},
+			false,
+		},
+		{
+			"timestamp is zero",
+			&types.Header{
+				Sequence:     header.Sequence,
+				Signature:    header.Signature,
+				NewPublicKey: header.NewPublicKey,
+				Timestamp:    0,
 			},
 			false,
 		},
@@ -33,6 +44,7 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 				Sequence:     header.Sequence,
 				Signature:    []byte{},
 				NewPublicKey: header.NewPublicKey,
+				Timestamp:    header.Timestamp,
 			},
 			false,
 		},
@@ -42,6 +54,7 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 				Sequence:     header.Sequence,
 				Signature:    header.Signature,
 				NewPublicKey: nil,
+				Timestamp:    header.Timestamp,
 			},
 			false,
 		},
@@ -63,3 +76,8 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 		})
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestHeaderGetTimestamp() {
+	var header exported.Header = suite.solomachine.CreateHeader()
+	suite.Require().Equal(suite.solomachine.Time, header.GetTimestamp())
+}
@@ -113,6 +113,8 @@ type Header struct {
 	Sequence     uint64           `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Signature    []byte           `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
 	NewPublicKey *types.PublicKey `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty" yaml:"new_public_key"`
+	// timestamp (in nanoseconds) of the new consensus state
+	Timestamp uint64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (m *Header) Reset()         { *m = Header{} }
@@ -283,45 +285,45 @@ func init() {
 }
 
 var fileDescriptor_6cc2ee18f7f86d4e = []byte{
-	// 597 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x8a, 0xd3, 0x5e,
-	0x14, 0x6e, 0x3a, 0x65, 0x68, 0xef, 0xf4, 0xd7, 0x99, 0x5f, 0xe8, 0x48, 0x2d, 0x43, 0x32, 0x04,
-	0xc4, 0xd9, 0x4c, 0x42, 0x74, 0xd7, 0x9d, 0xa9, 0x0b, 0xff, 0x20, 0x4a, 0x3a, 0x0b, 0x51, 0x21,
-	0xdc, 0x24, 0x77, 0xda, 0xcb, 0x34, 0xf7, 0xc6, 0xdc, 0x9b, 0xd6, 0xfa, 0x04, 0x2e, 0x5d, 0xba,
-	0xf4, 0x05, 0x04, 0x1f, 0x43, 0x10, 0x64, 0x96, 0xae, 0x8a, 0xb4, 0x6f, 0xd0, 0x27, 0x90, 0x26,
-	0xb7, 0x69, 0x12, 0x06, 0x8b, 0xe0, 0x2a, 0xe7, 0x9c, 0x9c, 0xfb, 0x9d, 0xef, 0x3b, 0xe7, 0x70,
-	0x80, 0x89, 0x5d, 0xcf, 0x18, 0xe3, 0xe1, 0x88, 0x7b, 0x63, 0x8c, 0x08, 0x67, 0x06, 0xa3, 0x63,
-	0x1a, 0x40, 0x6f, 0x84, 0x09, 0x32, 0x26, 0x66, 0xde, 0xd5, 0xc3, 0x88, 0x72, 0x2a, 0xab, 0xd8,
-	0xf5, 0xf4, 0xfc, 0x13, 0x3d, 0x9f, 0x33, 0x31, 0xbb, 0x77, 0x3d, 0xca, 0x02, 0xca, 0x0c, 0x17,
-	0x32, 0x64, 0x78, 0xd1, 0x2c, 0xe4, 0xd4, 0x98, 0x98, 0x2e, 0xe2, 0xd0, 0x14, 0x6e, 0x8a, 0xd4,
-	0x6d, 0x0f, 0xe9, 0x90, 0x26, 0xa6, 0xb1, 0xb6, 0xd2, 0xa8, 0xf6, 0x43, 0x02, 0x07, 0xfd, 0x04,
-	0x79, 0xc0, 0x21, 0x47, 0x72, 0x1f, 0x1c, 0x5e, 0x46, 0xf4, 0x3d, 0x22, 0x0e, 0x43, 0x6f, 0x63,
-	0x44, 0x3c, 0xd4, 0x91, 0x4e, 0xa5, 0xb3, 0x9a, 0xd5, 0x5d, 0xcd, 0xd5, 0x5b, 0x33, 0x18, 0x8c,
-	0x7b, 0x5a, 0x29, 0x41, 0xb3, 0x5b, 0x69, 0x64, 0x20, 0x02, 0x32, 0x07, 0x87, 0x1e, 0x25, 0x0c,
-	0x11, 0x16, 0x33, 0x87, 0xad, 0x71, 0x3b, 0xd5, 0x53, 0xe9, 0xec, 0xe0, 0x9e, 0xa1, 0xef, 0x90,
-	0xa3, 0xf7, 0x37, 0xef, 0x12, 0x3a, 0xf9, 0xaa, 0x25, 0x44, 0xcd, 0x6e, 0x79, 0x85, 0xdc, 0x5e,
-	0xed, 0xc3, 0x67, 0xb5, 0xa2, 0x7d, 0x91, 0x40, 0xab, 0x08, 0x22, 0x77, 0x41, 0xbd, 0x28, 0xc6,
-	0xce, 0x7c, 0xf9, 0x35, 0x00, 0x61, 0xec, 0x8e, 0xb1, 0xe7, 0x5c, 0xa1, 0x99, 0x60, 0x79, 0x47,
-	0x4f, 0x7b, 0xaa, 0xaf, 0x7b, 0xaa, 0x8b, 0x26, 0x8a, 0x9e, 0xea, 0x2f, 0x92, 0xec, 0xa7, 0x68,
-	0x66, 0x1d, 0xaf, 0xe6, 0xea, 0xff, 0x29, 0xb7, 0x2d, 0x84, 0x66, 0x37, 0xc2, 0x4d, 0x86, 0x7c,
-	0x02, 0x1a, 0x1c, 0x07, 0x88, 0x71, 0x18, 0x84, 0x9d, 0xbd, 0xa4, 0xf2, 0x36, 0x20, 0xf8, 0x7e,
-	0x95, 0xc0, 0xfe, 0x23, 0x04, 0x7d, 0x14, 0xfd, 0x91, 0xe7, 0x09, 0x68, 0x30, 0x3c, 0x24, 0x90,
-	0xc7, 0x51, 0xda, 0xcc, 0xa6, 0xbd, 0x0d, 0xc8, 0x97, 0xa0, 0x45, 0xd0, 0xd4, 0xc9, 0x29, 0xd9,
-	0xfb, 0x1b, 0x25, 0xb7, 0x57, 0x73, 0xf5, 0x38, 0x55, 0x52, 0x84, 0xd1, 0xec, 0x26, 0x41, 0xd3,
-	0x2c, 0x51, 0x50, 0xfe, 0x5e, 0x05, 0xcd, 0x67, 0x98, 0xb9, 0x68, 0x04, 0x27, 0x98, 0xc6, 0x91,
-	0x6c, 0x82, 0x46, 0x3a, 0x4e, 0x07, 0xfb, 0x09, 0xf3, 0x86, 0xd5, 0x5e, 0xcd, 0xd5, 0x23, 0x31,
-	0xb8, 0xcd, 0x2f, 0xcd, 0xae, 0xa7, 0xf6, 0x63, 0xbf, 0xa0, 0xb5, 0x5a, 0xd2, 0x1a, 0x82, 0xff,
-	0x32, 0x69, 0x0e, 0x25, 0x48, 0x88, 0x31, 0x77, 0x2e, 0xcf, 0x60, 0xf3, 0xea, 0x01, 0xf1, 0x1f,
-	0x42, 0x0e, 0xad, 0xce, 0x6a, 0xae, 0xb6, 0x53, 0x16, 0x05, 0x44, 0xcd, 0x6e, 0x66, 0xfe, 0x73,
-	0x52, 0xaa, 0xc8, 0xa7, 0xb4, 0x53, 0xfb, 0xa7, 0x15, 0xf9, 0x94, 0xe6, 0x2b, 0x5e, 0x4c, 0x69,
-	0xaf, 0xbe, 0xee, 0xe4, 0xa7, 0x75, 0x37, 0x9f, 0x80, 0xa3, 0x32, 0x4a, 0x71, 0xda, 0x52, 0x79,
-	0xda, 0x32, 0xa8, 0xf9, 0x90, 0x43, 0xb1, 0x06, 0x89, 0x2d, 0x26, 0xf3, 0x12, 0xb4, 0x2f, 0x36,
-	0xfb, 0x85, 0xfc, 0x0c, 0x76, 0x07, 0x5e, 0x61, 0x4d, 0xab, 0x37, 0xae, 0xa9, 0xf5, 0xe6, 0xdb,
-	0x42, 0x91, 0xae, 0x17, 0x8a, 0xf4, 0x6b, 0xa1, 0x48, 0x1f, 0x97, 0x4a, 0xe5, 0x7a, 0xa9, 0x54,
-	0x7e, 0x2e, 0x95, 0xca, 0x2b, 0x6b, 0x88, 0xf9, 0x28, 0x76, 0x75, 0x8f, 0x06, 0x86, 0xb8, 0x45,
-	0xe9, 0xe7, 0x9c, 0xf9, 0x57, 0xc6, 0x3b, 0x23, 0xbb, 0x79, 0xe7, 0x37, 0x1d, 0x3d, 0x3e, 0x0b,
-	0x11, 0x73, 0xf7, 0x93, 0x63, 0x74, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x13, 0x3a, 0xae,
-	0x05, 0x21, 0x05, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xd3, 0xa8, 0x4a, 0xb6, 0x21, 0x2d, 0x56, 0x8a, 0x42, 0x54, 0xd9, 0x95, 0x25, 0x44,
+	0x2f, 0xb5, 0x65, 0xb8, 0xe5, 0x86, 0xc3, 0x81, 0x1f, 0x21, 0x2a, 0xa7, 0x07, 0x04, 0x48, 0xd6,
+	0xda, 0xde, 0x26, 0xab, 0xc6, 0xbb, 0xc6, 0xbb, 0x4e, 0x08, 0x4f, 0xc0, 0x91, 0x23, 0x47, 0x5e,
+	0x80, 0xf7, 0x40, 0x42, 0xaa, 0x7a, 0xe4, 0x14, 0xa1, 0xe4, 0x0d, 0xf2, 0x04, 0x28, 0xf6, 0x26,
+	0xb1, 0xad, 0x88, 0x08, 0x89, 0x93, 0x67, 0xc6, 0xb3, 0xdf, 0xcc, 0xf7, 0xcd, 0x68, 0x80, 0x89,
+	0x5d, 0xcf, 0x18, 0xe2, 0xfe, 0x80, 0x7b, 0x43, 0x8c, 0x08, 0x67, 0x06, 0xa3, 0x43, 0x1a, 0x40,
+	0x6f, 0x80, 0x09, 0x32, 0x46, 0x66, 0xd6, 0xd5, 0xc3, 0x88, 0x72, 0x2a, 0xab, 0xd8, 0xf5, 0xf4,
+	0xec, 0x13, 0x3d, 0x9b, 0x33, 0x32, 0xdb, 0x0f, 0x3d, 0xca, 0x02, 0xca, 0x0c, 0x17, 0x32, 0x64,
+	0x78, 0xd1, 0x24, 0xe4, 0xd4, 0x18, 0x99, 0x2e, 0xe2, 0xd0, 0x14, 0x6e, 0x8a, 0xd4, 0x6e, 0xf6,
+	0x69, 0x9f, 0x26, 0xa6, 0xb1, 0xb4, 0xd2, 0xa8, 0x76, 0x23, 0x81, 0x83, 0x6e, 0x82, 0xdc, 0xe3,
+	0x90, 0x23, 0xb9, 0x0b, 0x0e, 0xaf, 0x22, 0xfa, 0x09, 0x11, 0x87, 0xa1, 0x0f, 0x31, 0x22, 0x1e,
+	0x6a, 0x49, 0xa7, 0xd2, 0x59, 0xc5, 0x6a, 0x2f, 0xa6, 0xea, 0xbd, 0x09, 0x0c, 0x86, 0x1d, 0xad,
+	0x90, 0xa0, 0xd9, 0x8d, 0x34, 0xd2, 0x13, 0x01, 0x99, 0x83, 0x43, 0x8f, 0x12, 0x86, 0x08, 0x8b,
+	0x99, 0xc3, 0x96, 0xb8, 0xad, 0xf2, 0xa9, 0x74, 0x76, 0xf0, 0xc8, 0xd0, 0x77, 0xd0, 0xd1, 0xbb,
+	0xab, 0x77, 0x49, 0x3b, 0xd9, 0xaa, 0x05, 0x44, 0xcd, 0x6e, 0x78, 0xb9, 0xdc, 0x4e, 0xe5, 0xf3,
+	0x37, 0xb5, 0xa4, 0x7d, 0x97, 0x40, 0x23, 0x0f, 0x22, 0xb7, 0x41, 0x35, 0x4f, 0xc6, 0x5e, 0xfb,
+	0xf2, 0x3b, 0x00, 0xc2, 0xd8, 0x1d, 0x62, 0xcf, 0xb9, 0x46, 0x13, 0xd1, 0xe5, 0x03, 0x3d, 0xd5,
+	0x54, 0x5f, 0x6a, 0xaa, 0x0b, 0x11, 0x85, 0xa6, 0xfa, 0x45, 0x92, 0xfd, 0x12, 0x4d, 0xac, 0xe3,
+	0xc5, 0x54, 0xbd, 0x9b, 0xf6, 0xb6, 0x81, 0xd0, 0xec, 0x5a, 0xb8, 0xca, 0x90, 0x4f, 0x40, 0x8d,
+	0xe3, 0x00, 0x31, 0x0e, 0x83, 0xb0, 0xb5, 0x97, 0x54, 0xde, 0x04, 0x44, 0xbf, 0x37, 0x12, 0xd8,
+	0x7f, 0x86, 0xa0, 0x8f, 0xa2, 0xbf, 0xf6, 0x79, 0x02, 0x6a, 0x0c, 0xf7, 0x09, 0xe4, 0x71, 0x94,
+	0x8a, 0x59, 0xb7, 0x37, 0x01, 0xf9, 0x0a, 0x34, 0x08, 0x1a, 0x3b, 0x19, 0x26, 0x7b, 0xff, 0xc2,
+	0xe4, 0xfe, 0x62, 0xaa, 0x1e, 0xa7, 0x4c, 0xf2, 0x30, 0x9a, 0x5d, 0x27, 0x68, 0x7c, 0xb1, 0x9d,
+	0x50, 0x65, 0x3b, 0xa1, 0x9f, 0x65, 0x50, 0x7f, 0x85, 0x99, 0x8b, 0x06, 0x70, 0x84, 0x69, 0x1c,
+	0xc9, 0x26, 0xa8, 0xa5, 0xc3, 0x76, 0xb0, 0x9f, 0xf0, 0xaa, 0x59, 0xcd, 0xc5, 0x54, 0x3d, 0x12,
+	0x63, 0x5d, 0xfd, 0xd2, 0xec, 0x6a, 0x6a, 0x3f, 0xf7, 0x73, 0x4a, 0x94, 0x0b, 0x4a, 0x84, 0xe0,
+	0xce, 0x9a, 0xb8, 0x43, 0x09, 0x12, 0x54, 0xcd, 0x9d, 0xab, 0xd5, 0x5b, 0xbd, 0x7a, 0x42, 0xfc,
+	0xa7, 0x90, 0x43, 0xab, 0xb5, 0x98, 0xaa, 0xcd, 0xb4, 0x8b, 0x1c, 0xa2, 0x66, 0xd7, 0xd7, 0xfe,
+	0x6b, 0x52, 0xa8, 0xc8, 0xc7, 0xb4, 0x55, 0xf9, 0xaf, 0x15, 0xf9, 0x98, 0x66, 0x2b, 0x5e, 0x8e,
+	0x69, 0xa7, 0xba, 0x54, 0xf2, 0xeb, 0x52, 0xcd, 0x17, 0xe0, 0xa8, 0x88, 0x92, 0xdf, 0x05, 0xa9,
+	0xb8, 0x0b, 0x32, 0xa8, 0xf8, 0x90, 0x43, 0xb1, 0x24, 0x89, 0x2d, 0x26, 0xf3, 0x06, 0x34, 0x2f,
+	0x57, 0xc3, 0x42, 0xfe, 0x1a, 0x76, 0x07, 0x5e, 0x6e, 0xe6, 0xe5, 0xad, 0x33, 0xb7, 0xde, 0xff,
+	0x98, 0x29, 0xd2, 0xed, 0x4c, 0x91, 0x7e, 0xcf, 0x14, 0xe9, 0xcb, 0x5c, 0x29, 0xdd, 0xce, 0x95,
+	0xd2, 0xaf, 0xb9, 0x52, 0x7a, 0x6b, 0xf5, 0x31, 0x1f, 0xc4, 0xae, 0xee, 0xd1, 0xc0, 0x10, 0x97,
+	0x2a, 0xfd, 0x9c, 0x33, 0xff, 0xda, 0xf8, 0x68, 0xac, 0x2f, 0xe2, 0xf9, 0xb6, 0x93, 0xc8, 0x27,
+	0x21, 0x62, 0xee, 0x7e, 0x72, 0xaa, 0x1e, 0xff, 0x19, 0x00, 0x6c, 0xd5, 0xfd, 0xb3, 0x3f, 0x05,
+	0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -429,6 +431,11 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.NewPublicKey != nil {
 		{
 			size, err := m.NewPublicKey.MarshalToSizedBuffer(dAtA[:i])
@@ -650,6 +657,9 @@ func (m *Header) Size() (n int) {
 		l = m.NewPublicKey.Size()
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.Timestamp != 0 {
+		n += 1 + sovSolomachine(uint64(m.Timestamp))
+	}
 	return n
 }
 
@@ -1069,6 +1079,25 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
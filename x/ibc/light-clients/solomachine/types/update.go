@@ -27,7 +27,8 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 		return nil, nil, err
 	}
 
-	clientState, consensusState := update(&cs, smHeader)
+	// update an independent copy so that the client state the caller holds is never mutated
+	clientState, consensusState := update(cs.Clone().(*ClientState), smHeader)
 	return clientState, consensusState, nil
 }
 
@@ -56,6 +57,7 @@ func update(clientState *ClientState, header *Header) (*ClientState, *ConsensusS
 		// increment sequence number
 		Sequence:  clientState.ConsensusState.Sequence + 1,
 		PublicKey: header.NewPublicKey,
+		Timestamp: header.Timestamp,
 	}
 
 	clientState.ConsensusState = consensusState
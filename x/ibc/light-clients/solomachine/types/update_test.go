@@ -116,6 +116,7 @@ func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateState() {
 				suite.Require().Equal(header.(*types.Header).NewPublicKey, clientState.(*types.ClientState).ConsensusState.PublicKey)
 				suite.Require().Equal(uint64(0), clientState.(*types.ClientState).FrozenSequence)
 				suite.Require().Equal(header.(*types.Header).Sequence+1, clientState.(*types.ClientState).ConsensusState.Sequence)
+				suite.Require().Equal(header.GetTimestamp(), clientState.(*types.ClientState).ConsensusState.Timestamp)
 				suite.Require().Equal(consensusState, clientState.(*types.ClientState).ConsensusState)
 			} else {
 				suite.Require().Error(err)
@@ -55,23 +55,26 @@ func (solo *Solomachine) ConsensusState() *solomachinetypes.ConsensusState {
 }
 
 // CreateHeader generates a new private/public key pair and creates the
-// necessary signature to construct a valid solo machine header.
+// necessary signature to construct a valid solo machine header. The time of the
+// solo machine is advanced so that the header timestamp is greater than the
+// timestamp of the current consensus state.
 func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
+	solo.Time++
+
 	// generate new private key and signature for header
 	newPrivKey := ed25519.GenPrivKey()
-	data := append(sdk.Uint64ToBigEndian(solo.Sequence), newPrivKey.PubKey().Bytes()...)
-	signature, err := solo.PrivateKey.Sign(data)
-	require.NoError(solo.t, err)
-
 	publicKey, err := std.DefaultPublicKeyCodec{}.Encode(newPrivKey.PubKey())
 	require.NoError(solo.t, err)
 
 	header := &solomachinetypes.Header{
 		Sequence:     solo.Sequence,
-		Signature:    signature,
 		NewPublicKey: publicKey,
+		Timestamp:    solo.Time,
 	}
 
+	header.Signature, err = solo.PrivateKey.Sign(solomachinetypes.HeaderSignBytes(header))
+	require.NoError(solo.t, err)
+
 	// assumes successful header update
 	solo.Sequence++
 	solo.PrivateKey = newPrivKey